@@ -28,6 +28,7 @@ import (
 	ipfsutil "github.com/textileio/go-textile/ipfs"
 	m "github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
+	"google.golang.org/grpc"
 )
 
 // apiVersion is the api version
@@ -41,13 +42,15 @@ var Host *Api
 
 // Gateway is a HTTP API for getting files and links from IPFS
 type Api struct {
-	Node     *core.Textile
-	Bots     *bots.Service
-	PinCode  string
-	RepoPath string
-	server   *http.Server
-	addr     string
-	docs     bool
+	Node       *core.Textile
+	Bots       *bots.Service
+	PinCode    string
+	RepoPath   string
+	server     *http.Server
+	grpcServer *grpc.Server
+	router     *gin.Engine
+	addr       string
+	docs       bool
 }
 
 // pbUnmarshaler is used to unmarshal JSON protobufs
@@ -92,6 +95,7 @@ func (a *Api) Run() {
 	docs.SwaggerInfo.Host = a.addr
 
 	router := gin.Default()
+	a.router = router
 
 	conf := a.Node.Config()
 
@@ -121,39 +125,40 @@ func (a *Api) Run() {
 		router.GET("/docs/*any", swagger.WrapHandler(sfiles.Handler))
 	}
 
-	// If given a passcode use it, else leave API wide open
-	var auth gin.HandlerFunc
-	if a.PinCode != "" {
-		auth = gin.BasicAuth(gin.Accounts{a.Node.Account().Address(): a.PinCode})
-	} else {
-		auth = func(c *gin.Context) {
-			// noop handler function
-			c.Next()
-		}
-	}
+	// Accept scoped api keys, else use the passcode if given, else leave API wide open
+	auth := a.authenticate()
+
+	// Scopes required by each route group for reads and writes
+	read := a.scoped(pb.ApiKey_READ, pb.ApiKey_ADMIN, nil)
+	admin := a.scoped(pb.ApiKey_ADMIN, pb.ApiKey_ADMIN, nil)
+	cafe := a.scoped(pb.ApiKey_CAFE, pb.ApiKey_CAFE, nil)
+	threadWrite := a.scoped(pb.ApiKey_READ, pb.ApiKey_THREAD_WRITE, threadParam)
+	blockWrite := a.scoped(pb.ApiKey_READ, pb.ApiKey_THREAD_WRITE, a.blockThread)
+	fileWrite := a.scoped(pb.ApiKey_READ, pb.ApiKey_THREAD_WRITE, nil)
 
 	// v0 routes
 	v0 := router.Group("/api/v0", auth)
 	{
-		v0.GET("/summary", a.nodeSummary)
-		v0.GET("/ping", a.ping)
-		v0.POST("/publish", a.publish)
+		v0.GET("/summary", read, a.nodeSummary)
+		v0.GET("/ping", read, a.ping)
+		v0.POST("/publish", admin, a.publish)
+		v0.GET("/ws", read, a.ws)
 
-		account := v0.Group("/account")
+		account := v0.Group("/account", admin)
 		{
 			account.GET("", a.accountGet)
 			account.GET("/seed", a.accountSeed)
 			account.GET("/address", a.accountAddress)
 		}
 
-		profile := v0.Group("/profile")
+		profile := v0.Group("/profile", read)
 		{
 			profile.GET("", a.getProfile)
 			profile.POST("/name", a.setName)
 			profile.POST("/avatar", a.setAvatar)
 		}
 
-		contacts := v0.Group("/contacts")
+		contacts := v0.Group("/contacts", read)
 		{
 			contacts.PUT(":address", a.addContacts)
 			contacts.GET("", a.lsContacts)
@@ -162,7 +167,7 @@ func (a *Api) Run() {
 			contacts.POST("/search", a.searchContacts)
 		}
 
-		mills := v0.Group("/mills")
+		mills := v0.Group("/mills", fileWrite)
 		{
 			mills.POST("/schema", a.schemaMill)
 			mills.POST("/blob", a.blobMill)
@@ -171,7 +176,7 @@ func (a *Api) Run() {
 			mills.POST("/json", a.jsonMill)
 		}
 
-		threads := v0.Group("/threads")
+		threads := v0.Group("/threads", threadWrite)
 		{
 			threads.POST("", a.addThreads)
 			threads.PUT(":id", a.addOrUpdateThreads)
@@ -179,18 +184,26 @@ func (a *Api) Run() {
 			threads.GET("", a.lsThreads)
 			threads.GET("/:id", a.getThreads)
 			threads.GET("/:id/peers", a.peersThreads)
-			threads.DELETE("/:id", a.rmThreads)
+			// Exports contain the thread secret key, and removal is not limited to the
+			// key's own writes, so both need ADMIN rather than THREAD_WRITE.
+			threads.DELETE("/:id", admin, a.rmThreads)
+			threads.GET("/:id/export", admin, a.exportThreads)
+			threads.POST("/:id/import", a.importThreads)
+			threads.POST("/:id/fork", a.forkThreads)
+			threads.POST("/:id/verify", a.verifyThreads)
+			threads.GET("/:id/graph", a.graphThreads)
 			threads.POST("/:id/messages", a.addThreadMessages)
 			threads.POST("/:id/files", a.addThreadFiles)
+			threads.POST("/:id/files/batch", a.addThreadFilesBatch)
 		}
 
-		snapshots := v0.Group("/snapshots")
+		snapshots := v0.Group("/snapshots", admin)
 		{
 			snapshots.POST("", a.createThreadSnapshots)
 			snapshots.POST("/search", a.searchThreadSnapshots)
 		}
 
-		blocks := v0.Group("/blocks")
+		blocks := v0.Group("/blocks", blockWrite)
 		{
 			blocks.GET("", a.lsBlocks)
 
@@ -204,6 +217,7 @@ func (a *Api) Run() {
 					{
 						file.GET("/meta", a.getBlockFileMeta)
 						file.GET("/content", a.getBlockFileContent)
+						file.POST("/share", a.shareBlockFile)
 					}
 				}
 
@@ -229,13 +243,13 @@ func (a *Api) Run() {
 			}
 		}
 
-		messages := v0.Group("/messages")
+		messages := v0.Group("/messages", read)
 		{
 			messages.GET("", a.lsThreadMessages)
 			messages.GET("/:block", a.getThreadMessages)
 		}
 
-		files := v0.Group("/files")
+		files := v0.Group("/files", read)
 		{
 			files.GET("", a.lsThreadFiles)
 			files.GET("/:block", func(g *gin.Context) {
@@ -243,7 +257,7 @@ func (a *Api) Run() {
 			})
 		}
 
-		file := v0.Group("/file")
+		file := v0.Group("/file", read)
 		{
 			hash := file.Group("/:hash")
 			{
@@ -258,23 +272,23 @@ func (a *Api) Run() {
 			}
 		}
 
-		feed := v0.Group("/feed")
+		feed := v0.Group("/feed", read)
 		{
 			feed.GET("", a.lsThreadFeed)
 		}
 
-		keys := v0.Group("/keys")
+		keys := v0.Group("/keys", read)
 		{
 			keys.GET("/:target", a.lsThreadFileTargetKeys)
 		}
 
-		observe := v0.Group("/observe")
+		observe := v0.Group("/observe", read)
 		{
 			observe.GET("", a.getThreadsObserve)
 			observe.GET("/:thread", a.getThreadsObserve)
 		}
 		// alias
-		subscribe := v0.Group("/subscribe")
+		subscribe := v0.Group("/subscribe", read)
 		{
 			subscribe.GET("", func(g *gin.Context) {
 				g.Redirect(http.StatusPermanentRedirect, "/api/v0/observe")
@@ -284,7 +298,7 @@ func (a *Api) Run() {
 			})
 		}
 
-		invites := v0.Group("/invites")
+		invites := v0.Group("/invites", read)
 		{
 			invites.POST("", a.createInvites)
 			invites.GET("", a.lsInvites)
@@ -292,30 +306,72 @@ func (a *Api) Run() {
 			invites.POST("/:id/ignore", a.ignoreInvites)
 		}
 
-		notifs := v0.Group("/notifications")
+		notifs := v0.Group("/notifications", read)
 		{
 			notifs.GET("", a.lsNotifications)
 			notifs.POST("/:id/read", a.readNotifications)
 		}
 
-		cafes := v0.Group("/cafes")
+		cafes := v0.Group("/cafes", cafe)
 		{
 			cafes.POST("", a.addCafes)
 			cafes.GET("", a.lsCafes)
 			cafes.GET("/:id", a.getCafes)
 			cafes.DELETE("/:id", a.rmCafes)
+			cafes.PUT("/:id/webhook", a.setCafeWebhook)
 			cafes.POST("/messages", a.checkCafeMessages)
 		}
 
-		tokens := v0.Group("/tokens")
+		tokens := v0.Group("/tokens", cafe)
 		{
 			tokens.POST("", a.createTokens)
 			tokens.GET("", a.lsTokens)
 			tokens.GET("/:token", a.validateTokens)
 			tokens.DELETE("/:token", a.rmTokens)
+			tokens.POST("/:token/disable", a.disableTokens)
+			tokens.POST("/:token/enable", a.enableTokens)
+		}
+
+		clients := v0.Group("/clients", cafe)
+		{
+			clients.GET("", a.lsClients)
+			clients.GET("/:id", a.getClients)
+			clients.DELETE("/:id/sessions", a.revokeClients)
+			clients.DELETE("/:id/data", a.purgeClients)
 		}
 
-		ipfs := v0.Group("/ipfs")
+		gc := v0.Group("/gc", cafe)
+		{
+			gc.GET("", a.getGC)
+			gc.POST("", a.runGC)
+		}
+
+		usage := v0.Group("/usage", cafe)
+		{
+			usage.GET("", a.lsUsage)
+			usage.GET("/:id", a.getUsage)
+		}
+
+		v0.GET("/metrics", read, a.getMetrics)
+
+		queues := v0.Group("/queues", read)
+		{
+			queues.GET("", a.lsQueues)
+			queues.GET("/:queue", a.getQueues)
+			queues.POST("/:queue/flush", a.flushQueues)
+		}
+
+		deadLetters := v0.Group("/deadletters", read)
+		{
+			deadLetters.GET("", a.lsDeadLetters)
+			deadLetters.GET("/:id", a.getDeadLetters)
+			deadLetters.POST("", a.retryDeadLetters)
+			deadLetters.POST("/:id", a.retryDeadLetter)
+			deadLetters.DELETE("", a.purgeDeadLetters)
+			deadLetters.DELETE("/:id", a.purgeDeadLetter)
+		}
+
+		ipfs := v0.Group("/ipfs", admin)
 		{
 			ipfs.GET("/id", a.ipfsId)
 			ipfs.GET("/cat/*path", a.ipfsCat)
@@ -333,7 +389,7 @@ func (a *Api) Run() {
 			}
 		}
 
-		bots := v0.Group("/bots")
+		bots := v0.Group("/bots", admin)
 		{
 			bots.GET("/list", a.botsList)
 			bots.POST("/disable", a.botsDisable)
@@ -344,7 +400,7 @@ func (a *Api) Run() {
 			bots.PUT("/id/:id", a.botsPut)
 		}
 
-		logs := v0.Group("/logs")
+		logs := v0.Group("/logs", admin)
 		{
 			logs.POST("", a.logsCall)
 			logs.GET("", a.logsCall)
@@ -352,7 +408,14 @@ func (a *Api) Run() {
 			logs.GET("/:subsystem", a.logsCall)
 		}
 
-		conf := v0.Group("/config")
+		apiKeys := v0.Group("/apikeys", admin)
+		{
+			apiKeys.POST("", a.createApiKeys)
+			apiKeys.GET("", a.lsApiKeys)
+			apiKeys.DELETE("/:id", a.revokeApiKeys)
+		}
+
+		conf := v0.Group("/config", admin)
 		{
 			conf.GET("", a.getConfig)
 			conf.PUT("", a.setConfig)
@@ -387,12 +450,22 @@ func (a *Api) Run() {
 		}
 	}()
 	log.Infof("api listening at %s", a.server.Addr)
+
+	if conf.Addresses.GRPC != "" {
+		if err := a.startGrpc(conf.Addresses.GRPC); err != nil {
+			log.Errorf("error starting grpc api: %s", err)
+		}
+	}
 }
 
 // Stop stops the http api
 func (a *Api) Stop() error {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
 	defer cancel()
+	if a.grpcServer != nil {
+		a.grpcServer.Stop()
+		a.grpcServer = nil
+	}
 	if err := a.server.Shutdown(ctx); err != nil {
 		log.Errorf("error shutting down api: %s", err)
 		return err
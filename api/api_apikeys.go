@@ -0,0 +1,107 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+)
+
+// createApiKeys godoc
+// @Summary Create an API key
+// @Description Generates an API key w/ the given scopes and saves a bcrypt hashed version for
+// @Description future lookup. The response contains the key, which is not available again.
+// @Description Keys are used w/ an 'Authorization: Bearer <key>' header. Scopes are read,
+// @Description thread_write (limited to the listed threads), admin, and cafe.
+// @Tags apikeys
+// @Produce application/json
+// @Param X-Textile-Opts header string false "name: Key name, scopes: Pipe separated scopes, threads: Pipe separated thread IDs writable w/ thread_write, expires: Duration after which the key expires, e.g., 720h" default(name=,scopes=read,threads=,expires=)
+// @Success 201 {object} pb.ApiKey "key"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /apikeys [post]
+func (a *Api) createApiKeys(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	var scopes []pb.ApiKey_Scope
+	for _, s := range splitOpt(opts["scopes"]) {
+		scope, ok := pb.ApiKey_Scope_value[strings.ToUpper(s)]
+		if !ok {
+			g.String(http.StatusBadRequest, "invalid scope: "+s)
+			return
+		}
+		scopes = append(scopes, pb.ApiKey_Scope(scope))
+	}
+
+	var expires time.Duration
+	if opts["expires"] != "" {
+		expires, err = time.ParseDuration(opts["expires"])
+		if err != nil || expires <= 0 {
+			g.String(http.StatusBadRequest, "invalid expires duration")
+			return
+		}
+	}
+
+	key, err := a.Node.CreateApiKey(opts["name"], scopes, splitOpt(opts["threads"]), expires)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, key)
+}
+
+// lsApiKeys godoc
+// @Summary List API keys
+// @Description Lists info about all API keys, newest first
+// @Tags apikeys
+// @Produce application/json
+// @Success 200 {object} pb.ApiKeyList "keys"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /apikeys [get]
+func (a *Api) lsApiKeys(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.Node.ApiKeys())
+}
+
+// revokeApiKeys godoc
+// @Summary Revoke an API key
+// @Description Revokes an API key so that it can no longer be used
+// @Tags apikeys
+// @Produce text/plain
+// @Param id path string true "key id"
+// @Success 200 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /apikeys/{id} [delete]
+func (a *Api) revokeApiKeys(g *gin.Context) {
+	err := a.Node.RevokeApiKey(g.Param("id"))
+	if err != nil {
+		if err == core.ErrApiKeyNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+
+	g.String(http.StatusOK, "ok")
+}
+
+// splitOpt returns the non-empty values of a pipe separated option
+func splitOpt(opt string) []string {
+	var vals []string
+	for _, v := range strings.Split(opt, "|") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			vals = append(vals, v)
+		}
+	}
+	return vals
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestApi_ApiKeyScopes(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+	other := testThread(t, a, "")
+
+	read := testApiKey(t, a, pb.ApiKey_READ)
+	write := testApiKey(t, a, pb.ApiKey_THREAD_WRITE, thrd.Id)
+	admin := testApiKey(t, a, pb.ApiKey_ADMIN)
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		key    string
+		status int
+	}{
+		{"no key", http.MethodGet, "/api/v0/threads/" + thrd.Id, "", http.StatusOK},
+		{"bad key", http.MethodGet, "/api/v0/threads/" + thrd.Id, "nope", http.StatusUnauthorized},
+		{"read get", http.MethodGet, "/api/v0/threads/" + thrd.Id, read, http.StatusOK},
+		{"read message", http.MethodPost, "/api/v0/threads/" + thrd.Id + "/messages", read, http.StatusForbidden},
+		{"read export", http.MethodGet, "/api/v0/threads/" + thrd.Id + "/export", read, http.StatusForbidden},
+		{"read delete", http.MethodDelete, "/api/v0/threads/" + thrd.Id, read, http.StatusForbidden},
+		{"read config", http.MethodGet, "/api/v0/config", read, http.StatusForbidden},
+		{"write message", http.MethodPost, "/api/v0/threads/" + thrd.Id + "/messages", write, http.StatusCreated},
+		{"write other message", http.MethodPost, "/api/v0/threads/" + other.Id + "/messages", write, http.StatusForbidden},
+		{"write export", http.MethodGet, "/api/v0/threads/" + thrd.Id + "/export", write, http.StatusForbidden},
+		{"write delete", http.MethodDelete, "/api/v0/threads/" + thrd.Id, write, http.StatusForbidden},
+		{"admin export", http.MethodGet, "/api/v0/threads/" + thrd.Id + "/export", admin, http.StatusOK},
+		{"admin delete", http.MethodDelete, "/api/v0/threads/" + other.Id, admin, http.StatusNoContent},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := httptest.NewRequest(test.method, test.path, nil)
+			req.Header.Set("X-Textile-Args", "hi")
+			res := testRequest(a, req, test.key)
+			if res.Code != test.status {
+				t.Fatalf("expected status %d, got %d: %s", test.status, res.Code, res.Body.String())
+			}
+		})
+	}
+}
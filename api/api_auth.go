@@ -0,0 +1,114 @@
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+)
+
+// apiKeyContextKey holds the validated api key of a request
+const apiKeyContextKey = "apikey"
+
+// authenticate returns middleware that accepts a bearer api key, falling back to basic auth
+// w/ the account address and pin code. Without a pin code, requests w/o a key are not limited.
+func (a *Api) authenticate() gin.HandlerFunc {
+	basic := func(g *gin.Context) {
+		g.Next()
+	}
+	if a.PinCode != "" {
+		basic = gin.BasicAuth(gin.Accounts{a.Node.Account().Address(): a.PinCode})
+	}
+
+	return func(g *gin.Context) {
+		header := g.GetHeader("Authorization")
+		if !strings.HasPrefix(header, "Bearer ") {
+			basic(g)
+			return
+		}
+
+		key, err := a.authorize(header)
+		if err != nil {
+			g.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			g.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+		g.Set(apiKeyContextKey, key)
+		g.Next()
+	}
+}
+
+// errUnauthorized indicates missing or wrong basic auth credentials
+var errUnauthorized = fmt.Errorf("unauthorized")
+
+// authorize checks an Authorization header value, returning the api key of bearer credentials.
+// Basic credentials must match the account address and pin code, if set.
+// A nil key w/o an error means the caller is not limited by scopes.
+func (a *Api) authorize(header string) (*pb.ApiKey, error) {
+	if strings.HasPrefix(header, "Bearer ") {
+		return a.Node.ValidateApiKey(strings.TrimSpace(strings.TrimPrefix(header, "Bearer ")))
+	}
+	if a.PinCode == "" {
+		return nil, nil
+	}
+
+	expected := "Basic " + base64.StdEncoding.EncodeToString([]byte(a.Node.Account().Address()+":"+a.PinCode))
+	if subtle.ConstantTimeCompare([]byte(header), []byte(expected)) != 1 {
+		return nil, errUnauthorized
+	}
+	return nil, nil
+}
+
+// scoped returns middleware that requires the request's api key, if any, to grant read
+// for GET requests and write otherwise. thread resolves the thread of write requests
+// for keys w/ the THREAD_WRITE scope. Without thread, write access to any thread is enough,
+// e.g., for mills, which only prepare file data for a later thread write.
+func (a *Api) scoped(read pb.ApiKey_Scope, write pb.ApiKey_Scope, thread func(g *gin.Context) string) gin.HandlerFunc {
+	return func(g *gin.Context) {
+		val, ok := g.Get(apiKeyContextKey)
+		if !ok {
+			g.Next()
+			return
+		}
+		key := val.(*pb.ApiKey)
+
+		scope := write
+		if g.Request.Method == http.MethodGet || g.Request.Method == http.MethodHead {
+			scope = read
+		}
+		var threadId string
+		if scope == pb.ApiKey_THREAD_WRITE {
+			if thread != nil {
+				threadId = thread(g)
+			} else if len(key.Threads) > 0 {
+				threadId = key.Threads[0]
+			}
+		}
+		if !core.ApiKeyAllows(key, scope, threadId) {
+			g.AbortWithStatusJSON(http.StatusForbidden, gin.H{
+				"error": "api key does not have the " + strings.ToLower(scope.String()) + " scope",
+			})
+			return
+		}
+		g.Next()
+	}
+}
+
+// threadParam resolves the thread of a request from the id path param
+func threadParam(g *gin.Context) string {
+	return g.Param("id")
+}
+
+// blockThread resolves the thread of a request from the block in the id path param
+func (a *Api) blockThread(g *gin.Context) string {
+	block, err := a.Node.Block(g.Param("id"))
+	if err != nil {
+		return ""
+	}
+	return block.Thread
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/textileio/go-textile/core"
@@ -36,6 +37,29 @@ func getBlockThread(node *core.Textile, id string) (*core.Thread, error, int) {
 	return getThread(node, block.Thread)
 }
 
+// listBlocks paginates blocks in a thread
+func listBlocks(node *core.Textile, threadId string, offset string, limit int, sort pb.Block_BlockSort) (*pb.BlockList, error, int) {
+	if threadId == "" {
+		return nil, fmt.Errorf("missing thread id"), http.StatusBadRequest
+	}
+	thread := node.Thread(threadId)
+	if thread == nil {
+		return nil, core.ErrThreadNotFound, http.StatusNotFound
+	}
+
+	query := fmt.Sprintf("threadId='%s'", thread.Id)
+	var blocks *pb.BlockList
+	if sort == pb.Block_CAUSAL {
+		blocks = node.Datastore().Blocks().ListCausal(offset, limit, query)
+	} else {
+		blocks = node.Datastore().Blocks().List(offset, limit, query)
+	}
+	for _, block := range blocks.Items {
+		block.User = node.PeerUser(block.Author)
+	}
+	return blocks, nil, http.StatusOK
+}
+
 func getFiles(node *core.Textile, id string) (*pb.Files, error, int) {
 	files, err := node.File(id) // despite naming, this is files
 	if err != nil {
@@ -45,35 +69,15 @@ func getFiles(node *core.Textile, id string) (*pb.Files, error, int) {
 }
 
 func getFile(files *pb.Files, indexStr string, path string) (*pb.FileIndex, error, int) {
-	var f *pb.File
-	var fi *pb.FileIndex
-
-	// index conversion
 	index, err := strconv.Atoi(indexStr)
 	if err != nil {
 		return nil, fmt.Errorf("invalid file index %s with error %s", indexStr, err), http.StatusBadRequest
 	}
 
-	// index
-	f = files.Files[index]
-	if f == nil {
-		return nil, fmt.Errorf("failed to get the file at index %d, did not exist", index), http.StatusNotFound
-	}
-
-	// path
-	if path == "" || path == "." {
-		fi = f.File
-		if fi == nil {
-			return nil, fmt.Errorf("failed to get the file at index %d, no file content", index), http.StatusNotFound
-		}
-	} else {
-		fi := f.Links[path]
-		if fi == nil {
-			return nil, fmt.Errorf("failed to get the file at index %d path %s, did not exist", index, path), http.StatusNotFound
-		}
+	fi, err := core.FilesFile(files, index, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the file at index %d path %s, did not exist", index, path), http.StatusNotFound
 	}
-
-	// return
 	return fi, nil, http.StatusOK
 }
 
@@ -93,7 +97,7 @@ func getFilesFile(node *core.Textile, id string, indexStr string, path string) (
 // @Description traversing the hash tree.
 // @Tags blocks
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID, offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)" default(thread=,offset=,limit=5)
+// @Param X-Textile-Opts header string false "thread: Thread ID, offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), sort: Sort order (one of 'date' or 'causal')" default(thread=,offset=,limit=5,sort="date")
 // @Success 200 {object} pb.BlockList "blocks"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -106,18 +110,6 @@ func (a *Api) lsBlocks(g *gin.Context) {
 		return
 	}
 
-	threadId := opts["thread"]
-	if threadId == "" {
-		g.String(http.StatusBadRequest, "missing thread id")
-		return
-	}
-
-	thread := a.Node.Thread(threadId)
-	if thread == nil {
-		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
-		return
-	}
-
 	limit := 5
 	if opts["limit"] != "" {
 		limit, err = strconv.Atoi(opts["limit"])
@@ -127,10 +119,12 @@ func (a *Api) lsBlocks(g *gin.Context) {
 		}
 	}
 
-	query := fmt.Sprintf("threadId='%s'", thread.Id)
-	blocks := a.Node.Datastore().Blocks().List(opts["offset"], limit, query)
-	for _, block := range blocks.Items {
-		block.User = a.Node.PeerUser(block.Author)
+	threadId := opts["thread"]
+	sort := pb.Block_BlockSort(pbValForEnumString(pb.Block_BlockSort_value, opts["sort"]))
+	blocks, err, code := listBlocks(a.Node, threadId, opts["offset"], limit, sort)
+	if err != nil {
+		sendError(g, err, code)
+		return
 	}
 
 	var dots bool
@@ -152,6 +146,7 @@ func (a *Api) lsBlocks(g *gin.Context) {
 		nextOffset = blocks.Items[len(blocks.Items)-1].Id
 
 		// see if there's actually more
+		query := fmt.Sprintf("threadId='%s'", threadId)
 		if len(a.Node.Datastore().Blocks().List(nextOffset, 1, query).Items) == 0 {
 			nextOffset = ""
 		}
@@ -234,21 +229,80 @@ func (a *Api) getBlockFileMeta(g *gin.Context) {
 // @Param id path string true "block id"
 // @Param index path string true "file index"
 // @Param path path string true "file path"
+// @Param Range header string false "byte range, e.g., bytes=0-1023"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {array} byte
+// @Success 206 {array} byte
+// @Success 304 {string} string "Not Modified"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
+// @Failure 416 {string} string "Requested Range Not Satisfiable"
 func (a *Api) getBlockFileContent(g *gin.Context) {
 	file, err, code := getFilesFile(a.Node, g.Param("id"), g.Param("index"), g.Param("path"))
 	if err != nil {
 		sendError(g, err, code)
 		return
 	}
-	reader, err := a.Node.FileIndexContent(file)
+
+	a.serveFileContent(g, file)
+}
+
+// shareBlockFile godoc
+// @Summary Creates a signed gateway link to a file within a files block
+// @Description Signs a short-lived gateway link granting access to the decrypted content of
+// @Description a single file. The file's key is not part of the link.
+// @Tags files
+// @Produce text/plain
+// @Param id path string true "block id"
+// @Param index path string true "file index"
+// @Param path path string true "file path"
+// @Param X-Textile-Opts header string false "expires: How long the link is valid, e.g., 30m (default: 1h, max: 168h)" default(expires=1h)
+// @Success 201 {string} string "link"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /blocks/{id}/files/{index}/{path}/share [post]
+func (a *Api) shareBlockFile(g *gin.Context) {
+	opts, err := a.readOpts(g)
 	if err != nil {
-		sendError(g, err, http.StatusNotFound)
+		a.abort500(g, err)
 		return
 	}
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+
+	expires := time.Hour
+	if opts["expires"] != "" {
+		expires, err = time.ParseDuration(opts["expires"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	block, err, code := getBlock(a.Node, g.Param("id"))
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+	if _, err, code := getFilesFile(a.Node, block.Id, g.Param("index"), g.Param("path")); err != nil {
+		sendError(g, err, code)
+		return
+	}
+	index, _ := strconv.Atoi(g.Param("index"))
+
+	pth := core.FileLinkPath(block.Thread, block.Id, index, g.Param("path"))
+	query, err := a.Node.SignFileLink(pth, expires)
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	conf := a.Node.Config()
+	url := "http://" + conf.Addresses.Gateway
+	if conf.Cafe.Host.URL != "" {
+		url = strings.TrimRight(conf.Cafe.Host.URL, "/")
+	}
+
+	g.String(http.StatusCreated, url+pth+"?"+query.Encode())
 }
 
 // rmBlocks godoc
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+)
+
+// lsClients godoc
+// @Summary List cafe clients
+// @Description Lists clients registered with this cafe, most recently seen first, along with
+// @Description their storage usage
+// @Tags clients
+// @Produce application/json
+// @Success 200 {object} pb.CafeClientList "clients"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /clients [get]
+func (a *Api) lsClients(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.Node.CafeClients())
+}
+
+// getClients godoc
+// @Summary Get a cafe client
+// @Description Gets a client registered with this cafe, along with its storage usage
+// @Tags clients
+// @Produce application/json
+// @Param id path string true "client id"
+// @Success 200 {object} pb.CafeClient "client"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /clients/{id} [get]
+func (a *Api) getClients(g *gin.Context) {
+	client, err := a.Node.CafeClient(g.Param("id"))
+	if err != nil {
+		a.abortClient(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, client)
+}
+
+// revokeClients godoc
+// @Summary Revoke a cafe client's sessions
+// @Description Invalidates all sessions issued to a client. The client must register again
+// @Description with a valid token to get a new session.
+// @Tags clients
+// @Param id path string true "client id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /clients/{id}/sessions [delete]
+func (a *Api) revokeClients(g *gin.Context) {
+	err := a.Node.RevokeCafeClientSessions(g.Param("id"))
+	if err != nil {
+		a.abortClient(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// purgeClients godoc
+// @Summary Purge a cafe client's data
+// @Description Deletes a client's stored threads and inbox messages, and unpins objects
+// @Description no other client references. The client remains registered.
+// @Tags clients
+// @Param id path string true "client id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /clients/{id}/data [delete]
+func (a *Api) purgeClients(g *gin.Context) {
+	err := a.Node.PurgeCafeClient(g.Param("id"))
+	if err != nil {
+		a.abortClient(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
+// abortClient aborts w/ not found for unknown clients, otherwise internal error
+func (a *Api) abortClient(g *gin.Context, err error) {
+	if err == core.ErrCafeClientNotFound {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+	a.abort500(g, err)
+}
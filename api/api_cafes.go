@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
 )
 
 // addCafes godoc
@@ -115,6 +116,40 @@ func (a *Api) rmCafes(g *gin.Context) {
 	g.Status(http.StatusNoContent)
 }
 
+// setCafeWebhook godoc
+// @Summary Sets a webhook for new messages at a cafe
+// @Description Registers a URL with a cafe session to receive signed "you have mail" events
+// @Description when inbox messages arrive, e.g., an operator-run push relay. Event bodies are
+// @Description signed with the secret (hex HMAC-SHA256 in the X-Textile-Signature header).
+// @Description An empty URL removes the webhook.
+// @Tags cafes
+// @Produce text/plain
+// @Param id path string true "cafe id"
+// @Param X-Textile-Opts header string false "url: Webhook URL, secret: Signing secret" default(url=,secret=)
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /cafes/{id}/webhook [put]
+func (a *Api) setCafeWebhook(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	err = a.Node.SetCafeWebhook(g.Param("id"), opts["url"], opts["secret"])
+	if err != nil {
+		if err == core.ErrInvalidWebhook {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+
+	g.Status(http.StatusNoContent)
+}
+
 // checkCafeMessages godoc
 // @Summary Check for messages at all cafes
 // @Description Check for messages at all cafes. New messages are downloaded and processed
@@ -0,0 +1,188 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+)
+
+// lsDeadLetters godoc
+// @Summary List dead letters
+// @Description Lists queue items that exhausted their attempts, newest first, optionally
+// @Description only from the given comma-separated queues (one of 'block_downloads',
+// @Description 'block_posts', 'cafe_inbox', or 'cafe_requests')
+// @Tags dead letters
+// @Produce application/json
+// @Param X-Textile-Opts header string false "queue: Comma-separated queues to list from (omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 10)" default(queue=,offset=,limit=10)
+// @Success 200 {object} pb.DeadLetterList "dead letters"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /deadletters [get]
+func (a *Api) lsDeadLetters(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	queues, err := readQueues(opts["queue"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	limit := 10
+	if opts["limit"] != "" {
+		limit, err = strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	pbJSON(g, http.StatusOK, a.Node.DeadLetters(opts["offset"], limit, queues...))
+}
+
+// getDeadLetters godoc
+// @Summary Get a dead letter
+// @Description Gets a dead letter, including its original queue item
+// @Tags dead letters
+// @Produce application/json
+// @Param id path string true "dead letter id"
+// @Success 200 {object} pb.DeadLetter "dead letter"
+// @Failure 404 {string} string "Not Found"
+// @Router /deadletters/{id} [get]
+func (a *Api) getDeadLetters(g *gin.Context) {
+	letter, err := a.Node.DeadLetter(g.Param("id"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+	pbJSON(g, http.StatusOK, letter)
+}
+
+// retryDeadLetters godoc
+// @Summary Retry dead letters
+// @Description Returns all dead letters, optionally only from the given comma-separated
+// @Description queues, to their original queues with a fresh attempt count
+// @Tags dead letters
+// @Produce text/plain
+// @Param X-Textile-Opts header string false "queue: Comma-separated queues to retry (omit for all)" default(queue=)
+// @Success 200 {string} string "number of retried items"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /deadletters [post]
+func (a *Api) retryDeadLetters(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	queues, err := readQueues(opts["queue"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	count, err := a.Node.RetryDeadLetters(queues...)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	g.String(http.StatusOK, strconv.Itoa(count))
+}
+
+// retryDeadLetter godoc
+// @Summary Retry a dead letter
+// @Description Returns a dead letter to its original queue with a fresh attempt count
+// @Tags dead letters
+// @Produce text/plain
+// @Param id path string true "dead letter id"
+// @Success 200 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /deadletters/{id} [post]
+func (a *Api) retryDeadLetter(g *gin.Context) {
+	err := a.Node.RetryDeadLetter(g.Param("id"))
+	if err != nil {
+		if err == core.ErrDeadLetterNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+	g.String(http.StatusOK, "ok")
+}
+
+// purgeDeadLetters godoc
+// @Summary Purge dead letters
+// @Description Permanently deletes all dead letters, optionally only from the given
+// @Description comma-separated queues
+// @Tags dead letters
+// @Param X-Textile-Opts header string false "queue: Comma-separated queues to purge (omit for all)" default(queue=)
+// @Success 204 {string} string "ok"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /deadletters [delete]
+func (a *Api) purgeDeadLetters(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	queues, err := readQueues(opts["queue"])
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := a.Node.PurgeDeadLetters(queues...); err != nil {
+		a.abort500(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// purgeDeadLetter godoc
+// @Summary Purge a dead letter
+// @Description Permanently deletes a dead letter
+// @Tags dead letters
+// @Param id path string true "dead letter id"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /deadletters/{id} [delete]
+func (a *Api) purgeDeadLetter(g *gin.Context) {
+	err := a.Node.PurgeDeadLetter(g.Param("id"))
+	if err != nil {
+		if err == core.ErrDeadLetterNotFound {
+			g.String(http.StatusNotFound, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
+
+// readQueues parses a comma-separated list of case-insensitive queue names
+func readQueues(str string) ([]pb.DeadLetter_Queue, error) {
+	var queues []pb.DeadLetter_Queue
+	for _, name := range strings.Split(str, ",") {
+		name = strings.ToUpper(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		q, ok := pb.DeadLetter_Queue_value[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid queue: %s", name)
+		}
+		queues = append(queues, pb.DeadLetter_Queue(q))
+	}
+	return queues, nil
+}
@@ -28,7 +28,7 @@ import (
 // @Description Newer annotations may have already been listed in the case as well.
 // @Tags feed
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), mode: Feed mode (one of 'chrono', 'annotated', or 'stacks')" default(thread=,offset=,limit=5,mode="chrono")
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default'), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), mode: Feed mode (one of 'chrono', 'annotated', or 'stacks'), sort: Sort order (one of 'date' or 'causal')" default(thread=,offset=,limit=5,mode="chrono",sort="date")
 // @Success 200 {object} pb.FeedItemList "feed"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -47,6 +47,7 @@ func (a *Api) lsThreadFeed(g *gin.Context) {
 		Offset: opts["offset"],
 		Thread: opts["thread"],
 		Mode:   pb.FeedRequest_Mode(pb.FeedRequest_Mode_value[mode]),
+		Sort:   pb.Block_BlockSort(pbValForEnumString(pb.Block_BlockSort_value, opts["sort"])),
 		Limit:  5,
 	}
 	if req.Thread != "" {
@@ -1,6 +1,7 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 	"strconv"
 
@@ -41,19 +42,31 @@ func (a *Api) addThreadFiles(g *gin.Context) {
 		return
 	}
 
-	var node ipld.Node
-	var keys *pb.Keys
-
 	dirs := new(pb.DirectoryList)
 	if err := pbUnmarshaler.Unmarshal(g.Request.Body, dirs); err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
 	}
-	if len(dirs.Items) == 0 {
-		g.String(http.StatusBadRequest, "no files found")
+
+	files, err, code := addFileDirs(a.Node, thrd, dirs, opts["caption"])
+	if err != nil {
+		sendError(g, err, code)
 		return
 	}
 
+	pbJSON(g, http.StatusCreated, files)
+}
+
+// addFileDirs adds a files block w/ milled dirs to a thread
+func addFileDirs(node *core.Textile, thrd *core.Thread, dirs *pb.DirectoryList, caption string) (*pb.Files, error, int) {
+	if len(dirs.Items) == 0 {
+		return nil, fmt.Errorf("no files found"), http.StatusBadRequest
+	}
+
+	var inode ipld.Node
+	var keys *pb.Keys
+	var err error
+
 	if dirs.Items[0].Files[schema.SingleFileTag] != nil {
 		var files []*pb.FileIndex
 		for _, dir := range dirs.Items {
@@ -61,40 +74,32 @@ func (a *Api) addThreadFiles(g *gin.Context) {
 				files = append(files, dir.Files[schema.SingleFileTag])
 			}
 		}
-		node, keys, err = a.Node.AddNodeFromFiles(files)
-		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
-		}
+		inode, keys, err = node.AddNodeFromFiles(files)
 	} else {
-		node, keys, err = a.Node.AddNodeFromDirs(dirs)
-		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
-		}
+		inode, keys, err = node.AddNodeFromDirs(dirs)
+	}
+	if err != nil {
+		return nil, err, http.StatusBadRequest
 	}
 
-	if node == nil {
-		g.String(http.StatusBadRequest, "no files found")
-		return
+	if inode == nil {
+		return nil, fmt.Errorf("no files found"), http.StatusBadRequest
 	}
 
 	// @todo Allow the setting of the target in 0.5.0
-	hash, err := thrd.AddFiles(node, "", opts["caption"], keys.Files)
+	hash, err := thrd.AddFiles(inode, "", caption, keys.Files)
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusBadRequest
 	}
 
-	files, err := a.Node.File(hash.B58String())
+	files, err := node.File(hash.B58String())
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusBadRequest
 	}
 
-	a.Node.FlushCafes()
+	node.FlushCafes()
 
-	pbJSON(g, http.StatusCreated, files)
+	return files, nil, http.StatusCreated
 }
 
 // lsThreadFiles godoc
@@ -102,7 +107,7 @@ func (a *Api) addThreadFiles(g *gin.Context) {
 // @Description Paginates thread files. If thread id not provided, paginate all files.
 // @Tags files
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID. Omit for all, offset: Offset ID to start listing from. Omit for latest, limit: List page size. (default: 5)" default(thread=,offset=,limit=5)
+// @Param X-Textile-Opts header string false "thread: Thread ID. Omit for all, offset: Offset ID to start listing from. Omit for latest, limit: List page size. (default: 5), sort: Sort order (one of 'date' or 'causal')" default(thread=,offset=,limit=5,sort="date")
 // @Success 200 {object} pb.FilesList "files"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -133,7 +138,8 @@ func (a *Api) lsThreadFiles(g *gin.Context) {
 		}
 	}
 
-	list, err := a.Node.Files(opts["offset"], limit, threadId)
+	sort := pb.Block_BlockSort(pbValForEnumString(pb.Block_BlockSort_value, opts["sort"]))
+	list, err := a.Node.Files(opts["offset"], limit, threadId, sort)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -190,19 +196,37 @@ func (a *Api) getFileMeta(g *gin.Context) {
 
 // getFileContent godoc
 // @Summary File content at hash
-// @Description Returns decrypted raw content for file
+// @Description Returns decrypted raw content for file. Supports byte range requests and
+// @Description conditional requests w/ the file hash as ETag.
 // @Tags files
 // @Produce application/octet-stream
 // @Param hash path string true "file hash"
+// @Param Range header string false "byte range, e.g., bytes=0-1023"
+// @Param If-None-Match header string false "ETag from a previous response"
 // @Success 200 {string} byte
+// @Success 206 {string} byte
+// @Success 304 {string} string "Not Modified"
 // @Failure 404 {string} string "Not Found"
+// @Failure 416 {string} string "Requested Range Not Satisfiable"
 // @Router /file/{hash}/content [get]
 func (a *Api) getFileContent(g *gin.Context) {
-	reader, file, err := a.Node.FileContent(g.Param("hash"))
+	file, err := a.Node.FileMeta(g.Param("hash"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	a.serveFileContent(g, file)
+}
+
+// serveFileContent writes decrypted file content
+func (a *Api) serveFileContent(g *gin.Context, file *pb.FileIndex) {
+	reader, err := a.Node.FileIndexReader(file)
 	if err != nil {
 		g.String(http.StatusNotFound, err.Error())
 		return
 	}
+	defer reader.Close()
 
-	g.DataFromReader(http.StatusOK, file.Size, file.Media, reader, map[string]string{})
+	core.ServeFileContent(g.Writer, g.Request, reader, file.Hash, file.Media, file.Name, core.CachePrivate)
 }
@@ -0,0 +1,369 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	m "github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema"
+)
+
+// fileBatchWorkers is the default number of files milled concurrently by a batch add
+const fileBatchWorkers = 4
+
+// maxFileBatchWorkers limits the workers option of a batch add
+const maxFileBatchWorkers = 16
+
+// batchFile is a file part of a batch add
+type batchFile struct {
+	index int
+	group string
+	name  string
+	data  []byte
+}
+
+// batchResult is a milled file of a batch add
+type batchResult struct {
+	file   *batchFile
+	update *pb.FileBatchUpdate
+}
+
+// addThreadFilesBatch godoc
+// @Summary Adds a batch of files to a thread
+// @Description Mills a multipart batch of files w/ the thread schema using a pool of workers,
+// @Description streaming a newline-delimited JSON update for each file as it is queued, milled,
+// @Description skipped (media type not supported by the schema), or errors. Files are grouped by
+// @Description their multipart form field name. Once all files are milled, one files block is
+// @Description added per group, followed by pinned updates for its files and an added update.
+// @Tags threads
+// @Accept multipart/form-data
+// @Produce application/x-ndjson
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "caption: Caption to add to each group (numbered if more than one group), workers: Number of files to mill concurrently (default: 4, max: 16)" default(caption=,workers=4)
+// @Success 201 {object} pb.FileBatchUpdate "stream of updates"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/files/batch [post]
+func (a *Api) addThreadFilesBatch(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	thrd := a.Node.Thread(g.Param("id"))
+	if thrd == nil {
+		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
+		return
+	}
+	if thrd.Schema == nil {
+		g.String(http.StatusBadRequest, core.ErrThreadSchemaRequired.Error())
+		return
+	}
+
+	workers := fileBatchWorkers
+	if opts["workers"] != "" {
+		workers, err = strconv.Atoi(opts["workers"])
+		if err != nil || workers < 1 {
+			g.String(http.StatusBadRequest, "invalid workers")
+			return
+		}
+		if workers > maxFileBatchWorkers {
+			workers = maxFileBatchWorkers
+		}
+	}
+
+	reader, err := g.Request.MultipartReader()
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	jobs := make(chan *batchFile)
+	results := make(chan *batchResult)
+	received := make(chan struct{})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range jobs {
+				results <- &batchResult{
+					file:   file,
+					update: a.millBatchFile(thrd.Schema, file),
+				}
+			}
+		}()
+	}
+
+	// parts are read one at a time so that at most workers+1 files are held in memory
+	go func() {
+		defer func() {
+			close(received)
+			close(jobs)
+			wg.Wait()
+			close(results)
+		}()
+
+		for index := 0; ; {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				results <- &batchResult{update: &pb.FileBatchUpdate{
+					Status: pb.FileBatchUpdate_ERROR,
+					Error:  err.Error(),
+				}}
+				return
+			}
+			if part.FileName() == "" {
+				_ = part.Close()
+				continue
+			}
+
+			file := &batchFile{
+				index: index,
+				group: part.FormName(),
+				name:  part.FileName(),
+			}
+			file.data, err = ioutil.ReadAll(part)
+			_ = part.Close()
+			if err != nil {
+				results <- &batchResult{file: file, update: &pb.FileBatchUpdate{
+					Group:  file.group,
+					Name:   file.name,
+					Status: pb.FileBatchUpdate_ERROR,
+					Error:  err.Error(),
+				}}
+				return
+			}
+			results <- &batchResult{file: file, update: &pb.FileBatchUpdate{
+				Group:  file.group,
+				Name:   file.name,
+				Status: pb.FileBatchUpdate_QUEUED,
+			}}
+			jobs <- file
+			index++
+		}
+	}()
+
+	// the request body can't be read once the response is written, so updates are held
+	// until all parts are received
+	var pending []*pb.FileBatchUpdate
+	send := func(update *pb.FileBatchUpdate) {
+		select {
+		case <-received:
+			for _, p := range pending {
+				a.writeBatchUpdate(g, p)
+			}
+			pending = nil
+			a.writeBatchUpdate(g, update)
+		default:
+			pending = append(pending, update)
+		}
+	}
+
+	var count int
+	milled := make(map[string][]*batchResult)
+	for res := range results {
+		if res.update.Status == pb.FileBatchUpdate_MILLED {
+			milled[res.file.group] = append(milled[res.file.group], res)
+		}
+		send(res.update)
+		count++
+	}
+	if count == 0 {
+		g.String(http.StatusBadRequest, "no files found")
+		return
+	}
+	for _, p := range pending {
+		a.writeBatchUpdate(g, p)
+	}
+
+	// add groups in upload order
+	var groups [][]*batchResult
+	for _, group := range milled {
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].file.index < group[j].file.index
+		})
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0].file.index < groups[j][0].file.index
+	})
+
+	for i, group := range groups {
+		caption := opts["caption"]
+		if len(groups) > 1 {
+			caption = strings.TrimSpace(fmt.Sprintf("%s (%d)", caption, i+1))
+		}
+
+		dirs := &pb.DirectoryList{}
+		for _, res := range group {
+			dirs.Items = append(dirs.Items, res.update.Dir)
+		}
+
+		name := group[0].file.group
+		files, err, _ := addFileDirs(a.Node, thrd, dirs, caption)
+		if err != nil {
+			a.writeBatchUpdate(g, &pb.FileBatchUpdate{
+				Group:  name,
+				Status: pb.FileBatchUpdate_ERROR,
+				Error:  err.Error(),
+			})
+			continue
+		}
+
+		for _, res := range group {
+			a.writeBatchUpdate(g, &pb.FileBatchUpdate{
+				Group:  name,
+				Name:   res.file.name,
+				Status: pb.FileBatchUpdate_PINNED,
+			})
+		}
+		a.writeBatchUpdate(g, &pb.FileBatchUpdate{
+			Group:  name,
+			Status: pb.FileBatchUpdate_ADDED,
+			Files:  files,
+		})
+	}
+}
+
+// millBatchFile mills a batch file w/ a thread schema
+func (a *Api) millBatchFile(node *pb.Node, file *batchFile) *pb.FileBatchUpdate {
+	update := &pb.FileBatchUpdate{
+		Group: file.group,
+		Name:  file.name,
+	}
+
+	dir, err := a.millSchema(node, file.name, file.data)
+	switch {
+	case err == m.ErrMediaTypeNotSupported:
+		update.Status = pb.FileBatchUpdate_SKIPPED
+	case err != nil:
+		update.Status = pb.FileBatchUpdate_ERROR
+		update.Error = err.Error()
+	default:
+		update.Status = pb.FileBatchUpdate_MILLED
+		update.Dir = dir
+	}
+	return update
+}
+
+// millSchema mills data w/ each step of a thread schema
+func (a *Api) millSchema(node *pb.Node, name string, data []byte) (*pb.Directory, error) {
+	dir := &pb.Directory{Files: make(map[string]*pb.FileIndex)}
+
+	if node.Mill != "" {
+		added, err := a.millData(node.Mill, node.Opts, node.Plaintext, name, data, "")
+		if err != nil {
+			return nil, err
+		}
+		dir.Files[schema.SingleFileTag] = added
+		return dir, nil
+	}
+	if len(node.Links) == 0 {
+		return nil, schema.ErrEmptySchema
+	}
+
+	steps, err := schema.Steps(node.Links)
+	if err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		var added *pb.FileIndex
+		if step.Link.Use == schema.FileTag {
+			added, err = a.millData(step.Link.Mill, step.Link.Opts, step.Link.Plaintext, name, data, "")
+		} else {
+			from := dir.Files[step.Link.Use]
+			if from == nil {
+				return nil, fmt.Errorf(step.Link.Use + " not found")
+			}
+			reader, file, err := a.Node.FileContent(from.Hash)
+			if err != nil {
+				return nil, err
+			}
+			input, err := ioutil.ReadAll(reader)
+			if err != nil {
+				return nil, err
+			}
+			added, err = a.millData(step.Link.Mill, step.Link.Opts, step.Link.Plaintext, "", input, file.Checksum)
+		}
+		if err != nil {
+			return nil, err
+		}
+		dir.Files[step.Name] = added
+	}
+
+	return dir, nil
+}
+
+// millData mills data w/ a schema mill
+func (a *Api) millData(id string, opts map[string]string, plaintext bool, name string, data []byte, use string) (*pb.FileIndex, error) {
+	var mill m.Mill
+	switch id {
+	case "/blob":
+		mill = &m.Blob{}
+	case "/image/resize":
+		if opts["width"] == "" {
+			return nil, fmt.Errorf("missing width")
+		}
+		mill = &m.ImageResize{
+			Opts: m.ImageResizeOpts{
+				Width:   opts["width"],
+				Quality: "75",
+			},
+		}
+		if opts["quality"] != "" {
+			mill.(*m.ImageResize).Opts.Quality = opts["quality"]
+		}
+	case "/image/exif":
+		mill = &m.ImageExif{}
+	case "/json":
+		mill = &m.Json{}
+	default:
+		return nil, fmt.Errorf("mill not found: %s", id)
+	}
+
+	conf := core.AddFileConfig{
+		Input:     data,
+		Use:       use,
+		Name:      name,
+		Plaintext: plaintext,
+	}
+	if id == "/json" {
+		conf.Media = "application/json"
+	} else {
+		media, err := a.Node.GetMillMedia(bytes.NewReader(data), mill)
+		if err != nil {
+			return nil, err
+		}
+		conf.Media = media
+	}
+
+	return a.Node.AddFileIndex(mill, conf)
+}
+
+// writeBatchUpdate writes a batch update as a line of JSON
+func (a *Api) writeBatchUpdate(g *gin.Context, update *pb.FileBatchUpdate) {
+	str, err := pbMarshaler.MarshalToString(update)
+	if err != nil {
+		log.Errorf("error marshaling batch update: %s", err)
+		return
+	}
+	g.Data(http.StatusCreated, "application/x-ndjson", []byte(str+"\n"))
+	g.Writer.Flush()
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestApi_AddThreadFilesBatch(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, testJsonSchema(t, a))
+
+	body, media := testBatchBody(t, [][2]string{
+		{"a.json", `{"a":1}`},
+		{"b.json", `{"b":`},
+		{"c.json", `{"c":3}`},
+	})
+	req := httptest.NewRequest(http.MethodPost, "/api/v0/threads/"+thrd.Id+"/files/batch", body)
+	req.Header.Set("Content-Type", media)
+	res := testRequest(a, req, "")
+	if res.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", res.Code, res.Body.String())
+	}
+
+	statuses := make(map[string][]pb.FileBatchUpdate_Status)
+	var added *pb.FileBatchUpdate
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		update := new(pb.FileBatchUpdate)
+		if err := pbUnmarshaler.Unmarshal(strings.NewReader(scanner.Text()), update); err != nil {
+			t.Fatalf("bad update %s: %s", scanner.Text(), err)
+		}
+		if update.Status == pb.FileBatchUpdate_ADDED {
+			added = update
+			continue
+		}
+		statuses[update.Name] = append(statuses[update.Name], update.Status)
+	}
+
+	expected := map[string][]pb.FileBatchUpdate_Status{
+		"a.json": {pb.FileBatchUpdate_QUEUED, pb.FileBatchUpdate_MILLED, pb.FileBatchUpdate_PINNED},
+		"b.json": {pb.FileBatchUpdate_QUEUED, pb.FileBatchUpdate_ERROR},
+		"c.json": {pb.FileBatchUpdate_QUEUED, pb.FileBatchUpdate_MILLED, pb.FileBatchUpdate_PINNED},
+	}
+	for name, exp := range expected {
+		got := statuses[name]
+		if len(got) != len(exp) {
+			t.Fatalf("expected %s updates %v, got %v", name, exp, got)
+		}
+		for i := range exp {
+			if got[i] != exp[i] {
+				t.Fatalf("expected %s updates %v, got %v", name, exp, got)
+			}
+		}
+	}
+
+	// the bad file is reported, but doesn't stop the others from being added
+	if added == nil || added.Files == nil {
+		t.Fatal("expected an added update")
+	}
+	if len(added.Files.Files) != 2 {
+		t.Fatalf("expected 2 added files, got %d", len(added.Files.Files))
+	}
+}
+
+func TestApi_AddThreadFilesBatchScopes(t *testing.T) {
+	a := testApi(t)
+	schema := testJsonSchema(t, a)
+	thrd := testThread(t, a, schema)
+	other := testThread(t, a, schema)
+
+	tests := []struct {
+		name   string
+		key    string
+		status int
+	}{
+		{"read", testApiKey(t, a, pb.ApiKey_READ), http.StatusForbidden},
+		{"write other", testApiKey(t, a, pb.ApiKey_THREAD_WRITE, other.Id), http.StatusForbidden},
+		{"write", testApiKey(t, a, pb.ApiKey_THREAD_WRITE, thrd.Id), http.StatusCreated},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			body, media := testBatchBody(t, [][2]string{{"a.json", `{"a":1}`}})
+			req := httptest.NewRequest(http.MethodPost, "/api/v0/threads/"+thrd.Id+"/files/batch", body)
+			req.Header.Set("Content-Type", media)
+			res := testRequest(a, req, test.key)
+			if res.Code != test.status {
+				t.Fatalf("expected status %d, got %d: %s", test.status, res.Code, res.Body.String())
+			}
+		})
+	}
+}
+
+// testJsonSchema adds a single file json schema, returning its hash
+func testJsonSchema(t *testing.T, a *Api) string {
+	file, err := a.Node.AddSchema(`{"name":"json","mill":"/json","json_schema":{"type":"object"}}`, "json")
+	if err != nil {
+		t.Fatalf("add schema failed: %s", err)
+	}
+	return file.Hash
+}
+
+// testBatchBody returns a multipart body w/ named files in a single group
+func testBatchBody(t *testing.T, files [][2]string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	w := multipart.NewWriter(body)
+	for _, file := range files {
+		part, err := w.CreateFormFile("files", file[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := part.Write([]byte(file[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return body, w.FormDataContentType()
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// runGC godoc
+// @Summary Run cafe garbage collection
+// @Description Garbage collects objects no longer pinned for any cafe client and reports
+// @Description how many blocks were removed and bytes freed
+// @Tags gc
+// @Produce application/json
+// @Success 200 {object} pb.CafeGCReport "report"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /gc [post]
+func (a *Api) runGC(g *gin.Context) {
+	report, err := a.Node.CafeGC()
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, report)
+}
+
+// getGC godoc
+// @Summary Get the last cafe garbage collection report
+// @Description Gets the report from the last cafe garbage collection, scheduled or manual
+// @Tags gc
+// @Produce application/json
+// @Success 200 {object} pb.CafeGCReport "report"
+// @Failure 404 {string} string "Not Found"
+// @Router /gc [get]
+func (a *Api) getGC(g *gin.Context) {
+	report := a.Node.LastCafeGC()
+	if report == nil {
+		g.String(http.StatusNotFound, "no gc report")
+		return
+	}
+
+	pbJSON(g, http.StatusOK, report)
+}
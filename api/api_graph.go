@@ -0,0 +1,195 @@
+package api
+
+import (
+	"fmt"
+	"html"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// graph drawing dimensions
+const (
+	graphPad       = 16
+	graphLaneWidth = 18
+	graphRowHeight = 26
+	graphRadius    = 5
+	graphTextWidth = 420
+)
+
+// graphColors are assigned to authors in order of appearance
+var graphColors = []string{
+	"#2935ff", "#ff1c3f", "#00b378", "#ff9f00", "#8e44ad", "#00a3d9", "#c0392b", "#7f8c8d",
+}
+
+// graphThreads godoc
+// @Summary Draws a thread's block graph
+// @Description Lays out a page of a thread's blocks, newest first, returning an SVG drawing
+// @Description or a JSON graph of nodes, edges, authors, and merge points. Merge nodes
+// @Description have multiple parents, fork nodes have multiple children.
+// @Tags threads
+// @Produce application/json
+// @Produce image/svg+xml
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 100), format: Output format (one of 'json' or 'svg')" default(offset=,limit=100,format="json")
+// @Success 200 {object} pb.ThreadGraph "graph"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/graph [get]
+func (a *Api) graphThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if a.Node.Thread(id) == nil {
+		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
+		return
+	}
+
+	// allow query params so the svg can be embedded directly
+	for _, k := range []string{"offset", "limit", "format"} {
+		if opts[k] == "" {
+			opts[k] = g.Query(k)
+		}
+	}
+
+	limit := 100
+	if opts["limit"] != "" {
+		limit, err = strconv.Atoi(opts["limit"])
+		if err != nil {
+			g.String(http.StatusBadRequest, err.Error())
+			return
+		}
+	}
+
+	graph, err := a.Node.ThreadGraph(id, opts["offset"], limit)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	format := opts["format"]
+	if format == "" && strings.Contains(g.GetHeader("Accept"), "image/svg+xml") {
+		format = "svg"
+	}
+	switch format {
+	case "", "json":
+		pbJSON(g, http.StatusOK, graph)
+	case "svg":
+		g.Data(http.StatusOK, "image/svg+xml", []byte(toSVG(graph)))
+	default:
+		g.String(http.StatusBadRequest, "invalid format: "+format)
+	}
+}
+
+// toSVG draws a laid out graph
+func toSVG(graph *pb.ThreadGraph) string {
+	lanes := int(graph.Lanes)
+	if lanes == 0 {
+		lanes = 1
+	}
+	rows := len(graph.Nodes)
+	textX := graphPad + lanes*graphLaneWidth
+	width := textX + graphTextWidth
+	height := 2*graphPad + rows*graphRowHeight
+
+	colors := make(map[string]string)
+	for i, a := range graph.Authors {
+		colors[a] = graphColors[i%len(graphColors)]
+	}
+
+	x := func(lane int32) int {
+		return graphPad + int(lane)*graphLaneWidth + graphLaneWidth/2
+	}
+	y := func(row int32) int {
+		return graphPad + int(row)*graphRowHeight + graphRowHeight/2
+	}
+
+	nodes := make(map[string]*pb.ThreadGraph_Node)
+	for _, n := range graph.Nodes {
+		nodes[n.Id] = n
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="monospace" font-size="11">`,
+		width, height, width, height)
+	b.WriteString("\n")
+
+	for _, e := range graph.Edges {
+		from := nodes[e.From]
+		if from == nil {
+			continue
+		}
+		x1, y1 := x(from.Lane), y(from.Row)
+		switch {
+		case e.Missing:
+			fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#ff1c3f" stroke-dasharray="2,2"><title>missing %s</title></line>`,
+				x1, y1, x1, y1+graphRowHeight/2, html.EscapeString(e.Node))
+		case e.Outside:
+			fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#999" stroke-dasharray="4,2"><title>%s</title></line>`,
+				x1, y1, x1, height, html.EscapeString(e.To))
+		default:
+			to := nodes[e.To]
+			x2, y2 := x(to.Lane), y(to.Row)
+			if x1 == x2 {
+				fmt.Fprintf(&b, `<line x1="%d" y1="%d" x2="%d" y2="%d" stroke="#999"/>`, x1, y1, x2, y2)
+			} else {
+				my := y1 + graphRowHeight/2
+				fmt.Fprintf(&b, `<path d="M%d %d C%d %d %d %d %d %d L%d %d" fill="none" stroke="#999"/>`,
+					x1, y1, x1, my, x2, y1, x2, my, x2, y2)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	for _, n := range graph.Nodes {
+		cx, cy := x(n.Lane), y(n.Row)
+		color, ok := colors[n.Author]
+		if !ok || n.Type == pb.Block_MERGE {
+			color = "#555"
+		}
+		stroke := "none"
+		if n.Head {
+			stroke = "#000"
+		}
+		if n.Merge {
+			fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%d" height="%d" fill="%s" stroke="%s" stroke-width="2">`,
+				cx-graphRadius, cy-graphRadius, 2*graphRadius, 2*graphRadius, color, stroke)
+			fmt.Fprintf(&b, `<title>%s</title></rect>`, n.Id)
+		} else {
+			fmt.Fprintf(&b, `<circle cx="%d" cy="%d" r="%d" fill="%s" stroke="%s" stroke-width="2">`,
+				cx, cy, graphRadius, color, stroke)
+			fmt.Fprintf(&b, `<title>%s</title></circle>`, n.Id)
+		}
+
+		label := n.Type.String() + " " + pre(n.Id)
+		if n.Author != "" {
+			label += " " + ipfs.ShortenID(n.Author)
+		}
+		if n.Date != nil {
+			label += " " + util.ProtoTime(n.Date).Format("2006-01-02 15:04:05")
+		}
+		if n.Status != pb.Block_READY {
+			label += " " + n.Status.String()
+		}
+		if n.Head {
+			label += " HEAD"
+		}
+		fmt.Fprintf(&b, `<text x="%d" y="%d" fill="%s">%s</text>`,
+			textX, cy+4, color, html.EscapeString(label))
+		b.WriteString("\n")
+	}
+
+	b.WriteString("</svg>\n")
+	return b.String()
+}
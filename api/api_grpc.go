@@ -0,0 +1,390 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// grpcScope is the api key scope required by a gRPC method.
+// thread resolves the thread of THREAD_WRITE requests.
+type grpcScope struct {
+	scope  pb.ApiKey_Scope
+	thread func(req interface{}) string
+}
+
+// grpcScopes maps gRPC method names to required scopes.
+// Methods not listed require the ADMIN scope.
+var grpcScopes = map[string]grpcScope{
+	"ListThreads": {scope: pb.ApiKey_READ},
+	"GetThread":   {scope: pb.ApiKey_READ},
+	"ListBlocks":  {scope: pb.ApiKey_READ},
+	"GetBlock":    {scope: pb.ApiKey_READ},
+	"AddMessage": {scope: pb.ApiKey_THREAD_WRITE, thread: func(req interface{}) string {
+		return req.(*pb.AddMessageRequest).Thread
+	}},
+	"ListFiles":         {scope: pb.ApiKey_READ},
+	"GetFiles":          {scope: pb.ApiKey_READ},
+	"Feed":              {scope: pb.ApiKey_READ},
+	"Observe":           {scope: pb.ApiKey_READ},
+	"ListCafes":         {scope: pb.ApiKey_CAFE},
+	"RegisterCafe":      {scope: pb.ApiKey_CAFE},
+	"DeregisterCafe":    {scope: pb.ApiKey_CAFE},
+	"ListContacts":      {scope: pb.ApiKey_READ},
+	"GetContact":        {scope: pb.ApiKey_READ},
+	"ListInvites":       {scope: pb.ApiKey_READ},
+	"ListNotifications": {scope: pb.ApiKey_READ},
+}
+
+// startGrpc starts the gRPC api on addr
+func (a *Api) startGrpc(addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	server := a.newGrpcServer()
+	a.grpcServer = server
+
+	go func() {
+		if err := server.Serve(lis); err != nil {
+			log.Errorf("grpc api error: %s", err)
+		}
+		log.Info("grpc api was shutdown")
+	}()
+	log.Infof("grpc api listening at %s", lis.Addr().String())
+	return nil
+}
+
+// newGrpcServer returns a gRPC server for the api w/ its auth interceptors
+func (a *Api) newGrpcServer() *grpc.Server {
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(a.grpcUnaryAuth),
+		grpc.StreamInterceptor(a.grpcStreamAuth),
+	)
+	pb.RegisterApiServer(server, &grpcService{api: a})
+	return server
+}
+
+// grpcUnaryAuth checks the credentials and scope of unary calls
+func (a *Api) grpcUnaryAuth(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := a.grpcAuthorize(ctx, info.FullMethod, req); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// grpcStreamAuth checks the credentials and scope of streaming calls
+func (a *Api) grpcStreamAuth(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := a.grpcAuthorize(ss.Context(), info.FullMethod, nil); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// grpcAuthorize authorizes a call w/ the same credentials as the REST api,
+// sent as "authorization" metadata
+func (a *Api) grpcAuthorize(ctx context.Context, method string, req interface{}) error {
+	var header string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("authorization"); len(vals) > 0 {
+			header = vals[0]
+		}
+	}
+
+	key, err := a.authorize(header)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if key == nil {
+		return nil
+	}
+
+	required, ok := grpcScopes[path.Base(method)]
+	if !ok {
+		required = grpcScope{scope: pb.ApiKey_ADMIN}
+	}
+	var threadId string
+	if required.thread != nil && req != nil {
+		threadId = required.thread(req)
+	}
+	if !core.ApiKeyAllows(key, required.scope, threadId) {
+		return status.Errorf(codes.PermissionDenied,
+			"api key does not have the %s scope", strings.ToLower(required.scope.String()))
+	}
+	return nil
+}
+
+// grpcError converts an error w/ an http status code to a gRPC status error
+func grpcError(err error, code int) error {
+	var c codes.Code
+	switch code {
+	case http.StatusBadRequest:
+		c = codes.InvalidArgument
+	case http.StatusUnauthorized:
+		c = codes.Unauthenticated
+	case http.StatusForbidden:
+		c = codes.PermissionDenied
+	case http.StatusNotFound:
+		c = codes.NotFound
+	case http.StatusConflict:
+		c = codes.AlreadyExists
+	default:
+		c = codes.Internal
+	}
+	return status.Error(c, err.Error())
+}
+
+// grpcService implements pb.ApiServer w/ the same handlers as the REST api
+type grpcService struct {
+	api *Api
+}
+
+func (s *grpcService) ListThreads(ctx context.Context, req *pb.ThreadsRequest) (*pb.ThreadList, error) {
+	return listThreads(s.api.Node), nil
+}
+
+func (s *grpcService) GetThread(ctx context.Context, req *pb.ThreadRequest) (*pb.Thread, error) {
+	view, err := s.api.Node.ThreadView(req.Id)
+	if err != nil {
+		return nil, grpcError(core.ErrThreadNotFound, http.StatusNotFound)
+	}
+	return view, nil
+}
+
+func (s *grpcService) AddThread(ctx context.Context, req *pb.AddThreadConfig) (*pb.Thread, error) {
+	view, err, code := addThread(s.api.Node, *req)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	return view, nil
+}
+
+func (s *grpcService) RemoveThread(ctx context.Context, req *pb.ThreadRequest) (*pb.Ack, error) {
+	if s.api.Node.Thread(req.Id) == nil {
+		return nil, grpcError(core.ErrThreadNotFound, http.StatusNotFound)
+	}
+	if _, err := s.api.Node.RemoveThread(req.Id); err != nil {
+		return nil, grpcError(err, http.StatusInternalServerError)
+	}
+
+	s.api.Node.FlushCafes()
+
+	return &pb.Ack{}, nil
+}
+
+func (s *grpcService) ListBlocks(ctx context.Context, req *pb.BlocksRequest) (*pb.BlockList, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 5
+	}
+	blocks, err, code := listBlocks(s.api.Node, req.Thread, req.Offset, limit, req.Sort)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	return blocks, nil
+}
+
+func (s *grpcService) GetBlock(ctx context.Context, req *pb.BlockRequest) (*pb.Block, error) {
+	block, err, code := getBlock(s.api.Node, req.Id)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	return block, nil
+}
+
+func (s *grpcService) AddMessage(ctx context.Context, req *pb.AddMessageRequest) (*pb.Text, error) {
+	msg, err, code := addMessage(s.api.Node, req.Thread, req.Body)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	return msg, nil
+}
+
+func (s *grpcService) ListFiles(ctx context.Context, req *pb.FilesRequest) (*pb.FilesList, error) {
+	if req.Thread != "" && s.api.Node.Thread(req.Thread) == nil {
+		return nil, grpcError(core.ErrThreadNotFound, http.StatusNotFound)
+	}
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 5
+	}
+	list, err := s.api.Node.Files(req.Offset, limit, req.Thread, req.Sort)
+	if err != nil {
+		return nil, grpcError(err, http.StatusBadRequest)
+	}
+	return list, nil
+}
+
+func (s *grpcService) GetFiles(ctx context.Context, req *pb.BlockRequest) (*pb.Files, error) {
+	files, err, code := getFiles(s.api.Node, req.Id)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	return files, nil
+}
+
+func (s *grpcService) Feed(ctx context.Context, req *pb.FeedRequest) (*pb.FeedItemList, error) {
+	if req.Thread != "" && s.api.Node.Thread(req.Thread) == nil {
+		return nil, grpcError(core.ErrThreadNotFound, http.StatusNotFound)
+	}
+	if req.Limit <= 0 {
+		req.Limit = 5
+	}
+	list, err := s.api.Node.Feed(req)
+	if err != nil {
+		return nil, grpcError(err, http.StatusBadRequest)
+	}
+	return list, nil
+}
+
+func (s *grpcService) Observe(req *pb.ObserveRequest, stream pb.Api_ObserveServer) error {
+	types := make([]string, len(req.Types))
+	for i, t := range req.Types {
+		types[i] = strings.ToUpper(strings.TrimSpace(t))
+	}
+	err := s.api.observe(stream.Context(), req.Thread, types, req.Since, stream.Send)
+	if err == core.ErrBlockNotFound {
+		return grpcError(err, http.StatusNotFound)
+	}
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return grpcError(err, http.StatusInternalServerError)
+	}
+	return nil
+}
+
+func (s *grpcService) ListCafes(ctx context.Context, req *pb.CafesRequest) (*pb.CafeSessionList, error) {
+	return s.api.Node.CafeSessions(), nil
+}
+
+func (s *grpcService) RegisterCafe(ctx context.Context, req *pb.RegisterCafeRequest) (*pb.CafeSession, error) {
+	if req.Cafe == "" {
+		return nil, grpcError(fmt.Errorf("missing cafe id"), http.StatusBadRequest)
+	}
+	if req.Token == "" {
+		return nil, grpcError(fmt.Errorf("missing access token"), http.StatusBadRequest)
+	}
+	session, err := s.api.Node.RegisterCafe(req.Cafe, req.Token)
+	if err != nil {
+		return nil, grpcError(err, http.StatusInternalServerError)
+	}
+
+	s.api.Node.FlushCafes()
+
+	return session, nil
+}
+
+func (s *grpcService) DeregisterCafe(ctx context.Context, req *pb.DeregisterCafeRequest) (*pb.Ack, error) {
+	if err := s.api.Node.DeregisterCafe(req.Id); err != nil {
+		return nil, grpcError(err, http.StatusInternalServerError)
+	}
+
+	s.api.Node.FlushCafes()
+
+	return &pb.Ack{}, nil
+}
+
+func (s *grpcService) ListContacts(ctx context.Context, req *pb.ContactsRequest) (*pb.ContactList, error) {
+	return s.api.Node.Contacts(), nil
+}
+
+func (s *grpcService) GetContact(ctx context.Context, req *pb.ContactRequest) (*pb.Contact, error) {
+	contact := s.api.Node.Contact(req.Address)
+	if contact == nil {
+		return nil, grpcError(fmt.Errorf("contact not found"), http.StatusNotFound)
+	}
+	return contact, nil
+}
+
+func (s *grpcService) AddContact(ctx context.Context, req *pb.Contact) (*pb.Ack, error) {
+	if req.Address == "" || len(req.Peers) == 0 {
+		return nil, grpcError(fmt.Errorf("invalid contact"), http.StatusBadRequest)
+	}
+	if err := s.api.Node.AddContact(req); err != nil {
+		return nil, grpcError(err, http.StatusBadRequest)
+	}
+
+	s.api.Node.FlushCafes()
+
+	return &pb.Ack{}, nil
+}
+
+func (s *grpcService) RemoveContact(ctx context.Context, req *pb.ContactRequest) (*pb.Ack, error) {
+	if s.api.Node.Contact(req.Address) == nil {
+		return nil, grpcError(fmt.Errorf("contact not found"), http.StatusNotFound)
+	}
+	if err := s.api.Node.RemoveContact(req.Address); err != nil {
+		return nil, grpcError(err, http.StatusInternalServerError)
+	}
+
+	s.api.Node.FlushCafes()
+
+	return &pb.Ack{}, nil
+}
+
+func (s *grpcService) ListInvites(ctx context.Context, req *pb.InvitesRequest) (*pb.InviteViewList, error) {
+	return s.api.Node.Invites(), nil
+}
+
+func (s *grpcService) CreateInvite(ctx context.Context, req *pb.CreateInviteRequest) (*pb.ExternalInvite, error) {
+	invite, err, code := createInvite(s.api.Node, req.Thread, req.Address)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	if invite == nil {
+		invite = &pb.ExternalInvite{}
+	}
+	return invite, nil
+}
+
+func (s *grpcService) AcceptInvite(ctx context.Context, req *pb.AcceptInviteRequest) (*pb.Block, error) {
+	block, err, code := acceptInvite(s.api.Node, req.Id, req.Key)
+	if err != nil {
+		return nil, grpcError(err, code)
+	}
+	return block, nil
+}
+
+func (s *grpcService) IgnoreInvite(ctx context.Context, req *pb.InviteRequest) (*pb.Ack, error) {
+	if err := s.api.Node.IgnoreInvite(req.Id); err != nil {
+		return nil, grpcError(err, http.StatusBadRequest)
+	}
+
+	s.api.Node.FlushCafes()
+
+	return &pb.Ack{}, nil
+}
+
+func (s *grpcService) ListNotifications(ctx context.Context, req *pb.NotificationsRequest) (*pb.NotificationList, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = -1
+	}
+	return s.api.Node.Notifications(req.Offset, limit), nil
+}
+
+func (s *grpcService) ReadNotification(ctx context.Context, req *pb.NotificationRequest) (*pb.Ack, error) {
+	var err error
+	if req.Id == "all" {
+		err = s.api.Node.ReadAllNotifications()
+	} else {
+		err = s.api.Node.ReadNotification(req.Id)
+	}
+	if err != nil {
+		return nil, grpcError(err, http.StatusBadRequest)
+	}
+	return &pb.Ack{}, nil
+}
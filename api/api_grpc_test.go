@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestApi_GrpcScopes(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+	other := testThread(t, a, "")
+
+	client, done := testGrpcClient(t, a)
+	defer done()
+
+	read := testApiKey(t, a, pb.ApiKey_READ)
+	write := testApiKey(t, a, pb.ApiKey_THREAD_WRITE, thrd.Id)
+	cafe := testApiKey(t, a, pb.ApiKey_CAFE)
+
+	tests := []struct {
+		name string
+		key  string
+		call func(ctx context.Context) error
+		code codes.Code
+	}{
+		// threads
+		{"no key list threads", "", func(ctx context.Context) error {
+			_, err := client.ListThreads(ctx, &pb.ThreadsRequest{})
+			return err
+		}, codes.OK},
+		{"bad key list threads", "nope", func(ctx context.Context) error {
+			_, err := client.ListThreads(ctx, &pb.ThreadsRequest{})
+			return err
+		}, codes.Unauthenticated},
+		{"read get thread", read, func(ctx context.Context) error {
+			_, err := client.GetThread(ctx, &pb.ThreadRequest{Id: thrd.Id})
+			return err
+		}, codes.OK},
+		{"read add thread", read, func(ctx context.Context) error {
+			_, err := client.AddThread(ctx, &pb.AddThreadConfig{Name: "nope"})
+			return err
+		}, codes.PermissionDenied},
+		{"write remove thread", write, func(ctx context.Context) error {
+			_, err := client.RemoveThread(ctx, &pb.ThreadRequest{Id: thrd.Id})
+			return err
+		}, codes.PermissionDenied},
+		{"cafe list threads", cafe, func(ctx context.Context) error {
+			_, err := client.ListThreads(ctx, &pb.ThreadsRequest{})
+			return err
+		}, codes.PermissionDenied},
+
+		// blocks and messages
+		{"read list blocks", read, func(ctx context.Context) error {
+			_, err := client.ListBlocks(ctx, &pb.BlocksRequest{Thread: thrd.Id})
+			return err
+		}, codes.OK},
+		{"read add message", read, func(ctx context.Context) error {
+			_, err := client.AddMessage(ctx, &pb.AddMessageRequest{Thread: thrd.Id, Body: "hi"})
+			return err
+		}, codes.PermissionDenied},
+		{"write add message", write, func(ctx context.Context) error {
+			_, err := client.AddMessage(ctx, &pb.AddMessageRequest{Thread: thrd.Id, Body: "hi"})
+			return err
+		}, codes.OK},
+		{"write add other message", write, func(ctx context.Context) error {
+			_, err := client.AddMessage(ctx, &pb.AddMessageRequest{Thread: other.Id, Body: "hi"})
+			return err
+		}, codes.PermissionDenied},
+
+		// files
+		{"read list files", read, func(ctx context.Context) error {
+			_, err := client.ListFiles(ctx, &pb.FilesRequest{Thread: thrd.Id})
+			return err
+		}, codes.OK},
+		{"cafe list files", cafe, func(ctx context.Context) error {
+			_, err := client.ListFiles(ctx, &pb.FilesRequest{Thread: thrd.Id})
+			return err
+		}, codes.PermissionDenied},
+
+		// feed
+		{"read feed", read, func(ctx context.Context) error {
+			_, err := client.Feed(ctx, &pb.FeedRequest{Thread: thrd.Id})
+			return err
+		}, codes.OK},
+		{"cafe observe", cafe, func(ctx context.Context) error {
+			stream, err := client.Observe(ctx, &pb.ObserveRequest{Thread: thrd.Id})
+			if err != nil {
+				return err
+			}
+			_, err = stream.Recv()
+			return err
+		}, codes.PermissionDenied},
+		{"bad key observe", "nope", func(ctx context.Context) error {
+			stream, err := client.Observe(ctx, &pb.ObserveRequest{Thread: thrd.Id})
+			if err != nil {
+				return err
+			}
+			_, err = stream.Recv()
+			return err
+		}, codes.Unauthenticated},
+
+		// cafes
+		{"cafe list cafes", cafe, func(ctx context.Context) error {
+			_, err := client.ListCafes(ctx, &pb.CafesRequest{})
+			return err
+		}, codes.OK},
+		{"read list cafes", read, func(ctx context.Context) error {
+			_, err := client.ListCafes(ctx, &pb.CafesRequest{})
+			return err
+		}, codes.PermissionDenied},
+
+		// contacts
+		{"read list contacts", read, func(ctx context.Context) error {
+			_, err := client.ListContacts(ctx, &pb.ContactsRequest{})
+			return err
+		}, codes.OK},
+		{"read remove contact", read, func(ctx context.Context) error {
+			_, err := client.RemoveContact(ctx, &pb.ContactRequest{Address: "nope"})
+			return err
+		}, codes.PermissionDenied},
+
+		// invites
+		{"read list invites", read, func(ctx context.Context) error {
+			_, err := client.ListInvites(ctx, &pb.InvitesRequest{})
+			return err
+		}, codes.OK},
+		{"write create invite", write, func(ctx context.Context) error {
+			_, err := client.CreateInvite(ctx, &pb.CreateInviteRequest{Thread: thrd.Id})
+			return err
+		}, codes.PermissionDenied},
+
+		// notifications
+		{"read list notifications", read, func(ctx context.Context) error {
+			_, err := client.ListNotifications(ctx, &pb.NotificationsRequest{})
+			return err
+		}, codes.OK},
+		{"read read notification", read, func(ctx context.Context) error {
+			_, err := client.ReadNotification(ctx, &pb.NotificationRequest{Id: "all"})
+			return err
+		}, codes.PermissionDenied},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
+			defer cancel()
+			if test.key != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+test.key)
+			}
+			err := test.call(ctx)
+			if status.Code(err) != test.code {
+				t.Fatalf("expected code %s, got %v", test.code, err)
+			}
+		})
+	}
+}
+
+// testGrpcClient serves the gRPC api in memory and returns a client
+func testGrpcClient(t *testing.T, a *Api) (pb.ApiClient, func()) {
+	lis := bufconn.Listen(1 << 20)
+	server := a.newGrpcServer()
+	go func() {
+		_ = server.Serve(lis)
+	}()
+
+	conn, err := grpc.Dial("bufconn",
+		grpc.WithInsecure(),
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return lis.Dial()
+		}))
+	if err != nil {
+		t.Fatalf("grpc dial failed: %s", err)
+	}
+	return pb.NewApiClient(conn), func() {
+		_ = conn.Close()
+		server.Stop()
+	}
+}
@@ -1,11 +1,14 @@
 package api
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mr-tron/base58/base58"
 	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
 )
 
 // createInvites godoc
@@ -26,28 +29,38 @@ func (a *Api) createInvites(g *gin.Context) {
 		return
 	}
 
-	threadId := opts["thread"]
+	invite, err, code := createInvite(a.Node, opts["thread"], opts["address"])
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+	if invite == nil {
+		g.Status(http.StatusCreated)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, invite)
+}
 
-	if opts["address"] != "" {
-		if err := a.Node.AddInvite(threadId, opts["address"]); err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
+// createInvite creates a direct invite to address or, if empty, an external invite
+func createInvite(node *core.Textile, threadId string, address string) (*pb.ExternalInvite, error, int) {
+	if address != "" {
+		if err := node.AddInvite(threadId, address); err != nil {
+			return nil, err, http.StatusBadRequest
 		}
-		g.Status(http.StatusCreated)
 
-		a.Node.FlushCafes()
-		return
+		node.FlushCafes()
+		return nil, nil, http.StatusCreated
 	}
 
-	invite, err := a.Node.AddExternalInvite(threadId)
+	invite, err := node.AddExternalInvite(threadId)
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusBadRequest
 	}
 
-	a.Node.FlushCafes()
+	node.FlushCafes()
 
-	pbJSON(g, http.StatusCreated, invite)
+	return invite, nil, http.StatusCreated
 }
 
 // lsInvites godoc
@@ -82,39 +95,46 @@ func (a *Api) acceptInvites(g *gin.Context) {
 		return
 	}
 
+	block, err, code := acceptInvite(a.Node, id, opts["key"])
+	if err != nil {
+		sendError(g, err, code)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, block)
+}
+
+// acceptInvite accepts a direct invite or, if a base58 encoded key is given, an external invite
+func acceptInvite(node *core.Textile, id string, key string) (*pb.Block, error, int) {
 	var hash mh.Multihash
-	if opts["key"] != "" {
-		key, err := base58.Decode(opts["key"])
+	if key != "" {
+		keyb, err := base58.Decode(key)
 		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
+			return nil, err, http.StatusBadRequest
 		}
-		hash, err = a.Node.AcceptExternalInvite(id, key)
+		hash, err = node.AcceptExternalInvite(id, keyb)
 		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
+			return nil, err, http.StatusBadRequest
 		}
 	} else {
-		hash, err = a.Node.AcceptInvite(id)
+		var err error
+		hash, err = node.AcceptInvite(id)
 		if err != nil {
-			g.String(http.StatusBadRequest, err.Error())
-			return
+			return nil, err, http.StatusBadRequest
 		}
 	}
 	if hash == nil {
-		g.String(http.StatusConflict, "thread already exists")
-		return
+		return nil, fmt.Errorf("thread already exists"), http.StatusConflict
 	}
 
-	block, err := a.Node.BlockView(hash.B58String())
+	block, err := node.BlockView(hash.B58String())
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusBadRequest
 	}
 
-	a.Node.FlushCafes()
+	node.FlushCafes()
 
-	pbJSON(g, http.StatusCreated, block)
+	return block, nil, http.StatusCreated
 }
 
 // ignoreInvites godoc
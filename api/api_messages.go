@@ -6,6 +6,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
 )
 
 // addThreadMessages godoc
@@ -30,29 +31,36 @@ func (a *Api) addThreadMessages(g *gin.Context) {
 		return
 	}
 
-	threadId := g.Param("id")
-	thrd := a.Node.Thread(threadId)
-	if thrd == nil {
-		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
+	msg, err, code := addMessage(a.Node, g.Param("id"), args[0])
+	if err != nil {
+		sendError(g, err, code)
 		return
 	}
 
+	pbJSON(g, http.StatusCreated, msg)
+}
+
+// addMessage adds a message to a thread
+func addMessage(node *core.Textile, threadId string, body string) (*pb.Text, error, int) {
+	thrd := node.Thread(threadId)
+	if thrd == nil {
+		return nil, core.ErrThreadNotFound, http.StatusNotFound
+	}
+
 	// @todo Allow the setting of the target in 0.5.0, which is the new way to comment
-	hash, err := thrd.AddMessage("", args[0])
+	hash, err := thrd.AddMessage("", body)
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusBadRequest
 	}
 
-	msg, err := a.Node.Message(hash.B58String())
+	msg, err := node.Message(hash.B58String())
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusBadRequest
 	}
 
-	a.Node.FlushCafes()
+	node.FlushCafes()
 
-	pbJSON(g, http.StatusCreated, msg)
+	return msg, nil, http.StatusCreated
 }
 
 // lsThreadMessages godoc
@@ -60,7 +68,7 @@ func (a *Api) addThreadMessages(g *gin.Context) {
 // @Description Paginates thread messages
 // @Tags messages
 // @Produce application/json
-// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default', omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5)" default(thread=,offset=,limit=10)
+// @Param X-Textile-Opts header string false "thread: Thread ID (can also use 'default', omit for all), offset: Offset ID to start listing from (omit for latest), limit: List page size (default: 5), sort: Sort order (one of 'date' or 'causal')" default(thread=,offset=,limit=10,sort="date")
 // @Success 200 {object} pb.TextList "messages"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 404 {string} string "Not Found"
@@ -91,7 +99,8 @@ func (a *Api) lsThreadMessages(g *gin.Context) {
 		}
 	}
 
-	list, err := a.Node.Messages(opts["offset"], limit, threadId)
+	sort := pb.Block_BlockSort(pbValForEnumString(pb.Block_BlockSort_value, opts["sort"]))
+	list, err := a.Node.Messages(opts["offset"], limit, threadId, sort)
 	if err != nil {
 		g.String(http.StatusBadRequest, err.Error())
 		return
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/metrics"
+)
+
+// getMetrics godoc
+// @Summary Get metrics in the Prometheus text format
+// @Description Gets process, ipfs, cafe host, and queue metrics in the Prometheus text
+// @Description exposition format, including cafe request rates and latencies by message type
+// @Tags metrics
+// @Produce text/plain
+// @Success 200 {string} string "metrics"
+// @Router /metrics [get]
+func (a *Api) getMetrics(g *gin.Context) {
+	g.Header("Content-Type", metrics.ContentType)
+	g.Status(http.StatusOK)
+	a.Node.WriteMetrics(g.Writer)
+}
@@ -1,25 +1,36 @@
 package api
 
 import (
-	"io"
+	"context"
+	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/broadcast"
 	"github.com/textileio/go-textile/core"
 	pb "github.com/textileio/go-textile/pb"
 )
 
+// observeReplayPageSize is the number of blocks loaded per page when replaying from a cursor
+const observeReplayPageSize = 100
+
 // getThreadsObserve godoc
 // @Summary Observe thread updates
 // @Description Observes updates in a thread or all threads. An update is generated
 // @Description when a new block is added to a thread. There are several update types:
 // @Description MERGE, IGNORE, FLAG, JOIN, ANNOUNCE, LEAVE, TEXT, FILES, COMMENT, LIKE
+// @Description If a 'since' block id is given, updates for blocks indexed after it are
+// @Description replayed before live updates continue. Server-Sent Events carry the block id
+// @Description as their 'id', so EventSource reconnects resume via the Last-Event-ID header.
 // @Tags observe
 // @Produce application/json
 // @Param thread path string false "thread id, omit to stream all events"
-// @Param X-Textile-Opts header string false "type: Or'd list of event types (e.g., FILES|COMMENTS|LIKES) or empty to include all types, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON" default(type=,events="false")
+// @Param since query string false "block id cursor to replay updates after"
+// @Param X-Textile-Opts header string false "type: Or'd list of event types (e.g., FILES|COMMENTS|LIKES) or empty to include all types, events: Whether to emit Server-Sent Events (SSEvent) or plain JSON, since: Block id cursor to replay updates after" default(type=,events="false",since=)
 // @Success 200 {object} pb.FeedItem "stream of updates"
+// @Failure 404 {string} string "Not Found"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /observe/{id} [get]
 func (a *Api) getThreadsObserve(g *gin.Context) {
@@ -31,52 +42,180 @@ func (a *Api) getThreadsObserve(g *gin.Context) {
 
 	// Expects or'd list of event types (e.g., FILES|COMMENTS|LIKES).
 	types := strings.Split(strings.TrimSpace(strings.ToUpper(opts["type"])), "|")
-	threadId := g.Param("id")
+	threadId := g.Param("thread")
+	events := opts["events"] == "true" || g.Query("events") == "true" ||
+		strings.Contains(g.GetHeader("Accept"), "text/event-stream")
+
+	// a reconnecting EventSource sends the last id it saw
+	since := g.GetHeader("Last-Event-ID")
+	if since == "" {
+		since = g.Query("since")
+	}
+	if since == "" {
+		since = opts["since"]
+	}
+
+	err = a.observe(g.Request.Context(), threadId, types, since, func(update *pb.FeedItem) error {
+		str, err := pbMarshaler.MarshalToString(update)
+		if err != nil {
+			return err
+		}
+
+		if events {
+			writeEvent(g, update.Block, "update", str)
+		} else {
+			g.Data(http.StatusOK, "application/json", []byte(str))
+			g.Writer.Write([]byte("\n"))
+		}
+		g.Writer.Flush()
+		return nil
+	})
+	if err != nil {
+		switch {
+		case g.Writer.Written():
+			log.Errorf("error observing updates: %s", err)
+		case err == core.ErrBlockNotFound:
+			g.String(http.StatusNotFound, err.Error())
+		default:
+			a.abort500(g, err)
+		}
+	}
+}
 
+// observe sends thread updates of the given types, or all types if empty, until ctx is done.
+// If since is set, updates for blocks indexed after it are replayed first.
+func (a *Api) observe(ctx context.Context, threadId string, types []string, since string, send func(*pb.FeedItem) error) error {
+	// listen before replaying so that no updates are missed in between
 	listener := a.Node.ThreadUpdateListener()
-	g.Stream(func(w io.Writer) bool {
-		select {
-		case <-g.Request.Context().Done():
-			return false
+	defer closeListener(listener)
 
-		case value, ok := <-listener.Ch:
-			if !ok {
-				return false
+	filter := func(update *pb.FeedItem) error {
+		btype, err := core.FeedItemType(update)
+		if err != nil {
+			log.Error(err.Error())
+			return nil
+		}
+		for _, t := range types {
+			if t == "" || btype.String() == t {
+				return send(update)
 			}
-			if update, ok := value.(*pb.FeedItem); ok {
-				if threadId != "" && update.Thread != threadId {
-					break
-				}
+		}
+		if len(types) == 0 {
+			return send(update)
+		}
+		return nil
+	}
 
-				btype, err := core.FeedItemType(update)
-				if err != nil {
-					log.Error(err.Error())
-					break
-				}
+	live := func(update *pb.FeedItem) error {
+		if threadId != "" && update.Thread != threadId {
+			return nil
+		}
+		return filter(update)
+	}
+
+	// live updates that arrive while replaying are held until the replay is done
+	var pending []*pb.FeedItem
+	replayed := make(map[string]struct{})
+	if since != "" {
+		stop := holdUpdates(listener)
+		defer stop()
 
-				for _, t := range types {
-					if t == "" || btype.String() == t {
+		for since != "" {
+			if ctx.Err() != nil {
+				return nil
+			}
+			list, err := a.Node.FeedSince(since, threadId, observeReplayPageSize)
+			if err != nil {
+				return err
+			}
+			for _, update := range list.Items {
+				if ctx.Err() != nil {
+					return nil
+				}
+				replayed[update.Block] = struct{}{}
+				if err := filter(update); err != nil {
+					return err
+				}
+			}
+			since = list.Next
+		}
+		pending = stop()
+	}
+	for _, update := range pending {
+		if _, ok := replayed[update.Block]; ok {
+			continue
+		}
+		if err := live(update); err != nil {
+			return err
+		}
+	}
 
-						str, err := pbMarshaler.MarshalToString(update)
-						if err != nil {
-							g.String(http.StatusBadRequest, err.Error())
-							break
-						}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
 
-						if opts["events"] == "true" {
-							g.SSEvent("update", str)
-						} else {
-							g.Data(http.StatusOK, "application/json", []byte(str))
-							g.Writer.Write([]byte("\n"))
-						}
+		case value, ok := <-listener.Ch:
+			if !ok {
+				return nil
+			}
+			update, ok := value.(*pb.FeedItem)
+			if !ok {
+				break
+			}
+			if _, ok := replayed[update.Block]; ok {
+				break
+			}
+			if err := live(update); err != nil {
+				return err
+			}
+		}
+	}
+}
 
-						break
-					}
+// holdUpdates buffers updates from a listener in the background until stopped, so that
+// a replay doesn't hold up the node's other observers. Stop returns the held updates.
+func holdUpdates(listener *broadcast.Listener) (stop func() []*pb.FeedItem) {
+	done := make(chan struct{})
+	held := make(chan []*pb.FeedItem, 1)
+	go func() {
+		var pending []*pb.FeedItem
+		defer func() {
+			held <- pending
+		}()
+		for {
+			select {
+			case <-done:
+				return
+			case value, ok := <-listener.Ch:
+				if !ok {
+					return
+				}
+				if update, ok := value.(*pb.FeedItem); ok {
+					pending = append(pending, update)
 				}
 			}
 		}
-		return true
-	})
+	}()
+
+	var once sync.Once
+	var pending []*pb.FeedItem
+	return func() []*pb.FeedItem {
+		once.Do(func() {
+			close(done)
+			pending = <-held
+		})
+		return pending
+	}
+}
 
-	listener.Close()
+// writeEvent writes a Server-Sent Event w/ an id
+func writeEvent(g *gin.Context, id string, event string, data string) {
+	header := g.Writer.Header()
+	if header.Get("Content-Type") == "" {
+		header.Set("Content-Type", "text/event-stream")
+		header.Set("Cache-Control", "no-cache")
+	}
+	_, _ = fmt.Fprintf(g.Writer, "id: %s\nevent: %s\ndata: %s\n\n",
+		id, event, strings.Replace(data, "\n", "\ndata: ", -1))
 }
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestApi_ObserveReplayCancel(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+	since := testMessages(t, thrd, 4)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var sent int
+	err := a.observe(ctx, thrd.Id, []string{""}, since, func(update *pb.FeedItem) error {
+		sent++
+		cancel()
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent != 1 {
+		t.Fatalf("expected replay to stop after the client left, got %d updates", sent)
+	}
+}
+
+func TestApi_ObserveReplayHoldsUpdates(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+	since := testMessages(t, thrd, 4)
+
+	// more live updates than the node's update buffer
+	live := 15
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	defer cancel()
+	var bodies []string
+	err := a.observe(ctx, thrd.Id, []string{"TEXT"}, since, func(update *pb.FeedItem) error {
+		var text pb.Text
+		if err := ptypes.UnmarshalAny(update.Payload, &text); err != nil {
+			return err
+		}
+		bodies = append(bodies, text.Body)
+
+		if len(bodies) == 1 {
+			// the node keeps publishing while a replay is sending
+			added := make(chan error, 1)
+			go func() {
+				for i := 0; i < live; i++ {
+					if _, err := thrd.AddMessage("", "live"+strconv.Itoa(i)); err != nil {
+						added <- err
+						return
+					}
+				}
+				added <- nil
+			}()
+			select {
+			case err := <-added:
+				if err != nil {
+					return err
+				}
+			case <-time.After(time.Second * 10):
+				return fmt.Errorf("node updates blocked by a replay")
+			}
+		}
+		if len(bodies) == 3+live {
+			cancel()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bodies) != 3+live {
+		t.Fatalf("expected %d updates, got %d", 3+live, len(bodies))
+	}
+	for i, body := range bodies {
+		expected := strconv.Itoa(i + 1)
+		if i >= 3 {
+			expected = "live" + strconv.Itoa(i-3)
+		}
+		if body != expected {
+			t.Fatalf("expected update %d to be %s, got %s", i, expected, body)
+		}
+	}
+}
+
+// testMessages adds n messages, "0" to "n-1", to a thread, returning the first block id
+func testMessages(t *testing.T, thrd *core.Thread, n int) string {
+	var first string
+	for i := 0; i < n; i++ {
+		hash, err := thrd.AddMessage("", strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			first = hash.B58String()
+		}
+	}
+	return first
+}
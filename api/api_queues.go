@@ -0,0 +1,96 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+)
+
+// lsQueues godoc
+// @Summary List queue stats
+// @Description Reports, for each block and cafe queue, counts by status, counts by peer
+// @Description or cafe, the age of the oldest item, and the last error seen
+// @Tags queues
+// @Produce application/json
+// @Success 200 {object} pb.QueueStatsList "queues"
+// @Router /queues [get]
+func (a *Api) lsQueues(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.Node.Queues())
+}
+
+// getQueues godoc
+// @Summary Get queue stats
+// @Description Reports counts by status, counts by peer or cafe, the age of the oldest item,
+// @Description and the last error seen for a single queue (one of 'block_messages',
+// @Description 'block_downloads', 'block_posts', 'cafe_requests', or 'cafe_inbox')
+// @Tags queues
+// @Produce application/json
+// @Param queue path string true "queue name"
+// @Success 200 {object} pb.QueueStats "queue"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /queues/{queue} [get]
+func (a *Api) getQueues(g *gin.Context) {
+	queue, err := readQueue(g.Param("queue"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	stats, err := a.Node.Queue(queue)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	pbJSON(g, http.StatusOK, stats)
+}
+
+// flushQueues godoc
+// @Summary Flush a queue
+// @Description Processes a single queue now, returning its stats. If the queue is already
+// @Description being flushed, the stats may be reported before that flush finishes.
+// @Tags queues
+// @Produce application/json
+// @Param queue path string true "queue name"
+// @Success 200 {object} pb.QueueStats "queue"
+// @Failure 404 {string} string "Not Found"
+// @Failure 503 {string} string "Service Unavailable"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /queues/{queue}/flush [post]
+func (a *Api) flushQueues(g *gin.Context) {
+	queue, err := readQueue(g.Param("queue"))
+	if err != nil {
+		g.String(http.StatusNotFound, err.Error())
+		return
+	}
+
+	err = a.Node.FlushQueue(queue)
+	if err != nil {
+		if err == core.ErrOffline {
+			g.String(http.StatusServiceUnavailable, err.Error())
+		} else {
+			a.abort500(g, err)
+		}
+		return
+	}
+
+	stats, err := a.Node.Queue(queue)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	pbJSON(g, http.StatusOK, stats)
+}
+
+// readQueue parses a case-insensitive queue name
+func readQueue(name string) (pb.QueueStats_Queue, error) {
+	q, ok := pb.QueueStats_Queue_value[strings.ToUpper(name)]
+	if !ok {
+		return 0, fmt.Errorf("queue not found: %s", name)
+	}
+	return pb.QueueStats_Queue(q), nil
+}
@@ -18,23 +18,27 @@ var initConfig = core.InitConfig{
 func TestApi_Creation(t *testing.T) {
 	initConfig.Account = keypair.Random()
 
-	_ = os.RemoveAll(initConfig.RepoPath())
+	repo, err := initConfig.Repo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = os.RemoveAll(repo)
 
-	err := core.InitRepo(initConfig)
+	err = core.InitRepo(initConfig)
 	if err != nil {
 		t.Errorf("init node failed: %s", err)
 		return
 	}
 
 	node, err := core.NewTextile(core.RunConfig{
-		RepoPath: initConfig.RepoPath(),
+		RepoPath: repo,
 	})
 	if err != nil {
 		t.Errorf("create node failed: %s", err)
 		return
 	}
 
-	bots := &bots.NewService(node)
+	bots := bots.NewService(node)
 	bots.RunAll(initConfig.BaseRepoPath, []string{})
 
 	Host = &Api{
@@ -59,5 +63,6 @@ func TestApi_Stop(t *testing.T) {
 	if err != nil {
 		t.Errorf("stop gateway failed: %s", err)
 	}
-	_ = os.RemoveAll(initConfig.RepoPath())
+	repo, _ := initConfig.Repo()
+	_ = os.RemoveAll(repo)
 }
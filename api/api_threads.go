@@ -2,6 +2,7 @@ package api
 
 import (
 	"crypto/rand"
+	"fmt"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -60,27 +61,34 @@ func (a *Api) addThreads(g *gin.Context) {
 	config.Sharing = pb.Thread_Sharing(pbValForEnumString(pb.Thread_Sharing_value, opts["sharing"]))
 	config.Whitelist = util.SplitString(opts["whitelist"], ",")
 
-	// make a new secret
-	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	view, err, code := addThread(a.Node, config)
 	if err != nil {
-		a.abort500(g, err)
+		sendError(g, err, code)
 		return
 	}
 
-	thrd, err := a.Node.AddThread(config, sk, a.Node.Account().Address(), true, true)
+	pbJSON(g, http.StatusCreated, view)
+}
+
+// addThread adds and joins a new thread w/ a new secret, returning its view
+func addThread(node *core.Textile, config pb.AddThreadConfig) (*pb.Thread, error, int) {
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
 	if err != nil {
-		g.String(http.StatusBadRequest, err.Error())
-		return
+		return nil, err, http.StatusInternalServerError
 	}
-	view, err := a.Node.ThreadView(thrd.Id)
+
+	thrd, err := node.AddThread(config, sk, node.Account().Address(), true, true)
 	if err != nil {
-		a.abort500(g, err)
-		return
+		return nil, err, http.StatusBadRequest
+	}
+	view, err := node.ThreadView(thrd.Id)
+	if err != nil {
+		return nil, err, http.StatusInternalServerError
 	}
 
-	a.Node.FlushCafes()
+	node.FlushCafes()
 
-	pbJSON(g, http.StatusCreated, view)
+	return view, nil, http.StatusCreated
 }
 
 // addOrUpdateThreads godoc
@@ -158,19 +166,23 @@ func (a *Api) renameThreads(g *gin.Context) {
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /threads [get]
 func (a *Api) lsThreads(g *gin.Context) {
+	pbJSON(g, http.StatusOK, listThreads(a.Node))
+}
+
+// listThreads returns views of all threads
+func listThreads(node *core.Textile) *pb.ThreadList {
 	views := &pb.ThreadList{
 		Items: make([]*pb.Thread, 0),
 	}
-	for _, thrd := range a.Node.Threads() {
-		view, err := a.Node.ThreadView(thrd.Id)
+	for _, thrd := range node.Threads() {
+		view, err := node.ThreadView(thrd.Id)
 		if err == nil {
 			views.Items = append(views.Items, view)
 		} else {
 			log.Errorf("error getting thread view %s: %s", thrd.Id, err)
 		}
 	}
-
-	pbJSON(g, http.StatusOK, views)
+	return views
 }
 
 // getThreads godoc
@@ -219,9 +231,11 @@ func (a *Api) peersThreads(g *gin.Context) {
 // rmThreads godoc
 // @Summary Abandons a thread.
 // @Description Abandons a thread, and if no one else is participating, then the thread dissipates.
+// @Description Requires the admin scope when using api keys.
 // @Tags threads
 // @Param id path string true "thread id"
 // @Success 204 {string} string "ok"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 404 {string} string "Not Found"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /threads/{id} [delete]
@@ -243,3 +257,196 @@ func (a *Api) rmThreads(g *gin.Context) {
 
 	g.Status(http.StatusNoContent)
 }
+
+// exportThreads godoc
+// @Summary Exports a thread
+// @Description Exports a thread as a CAR archive containing the thread secret key, schema,
+// @Description blocks, and locally available file data. Treat the archive as top secret.
+// @Description Requires the admin scope when using api keys.
+// @Tags threads
+// @Produce application/vnd.ipld.car
+// @Param id path string true "thread id"
+// @Success 200 {string} byte
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/export [get]
+func (a *Api) exportThreads(g *gin.Context) {
+	id := g.Param("id")
+
+	thrd := a.Node.Thread(id)
+	if thrd == nil {
+		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
+		return
+	}
+
+	g.Header("Content-Type", "application/vnd.ipld.car")
+	g.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.car", id))
+	g.Status(http.StatusOK)
+	if err := a.Node.ExportThread(id, g.Writer); err != nil {
+		if !g.Writer.Written() {
+			g.Writer.Header().Del("Content-Type")
+			g.Writer.Header().Del("Content-Disposition")
+			a.abort500(g, err)
+			return
+		}
+		// the archive is partially sent, make sure the client can't mistake it for a whole one
+		log.Errorf("error exporting thread %s: %s", id, err)
+		core.AbortResponse(g.Writer)
+	}
+}
+
+// importThreads godoc
+// @Summary Imports a thread
+// @Description Imports a thread from a CAR archive created by export. Existing threads are
+// @Description merged with the archive.
+// @Tags threads
+// @Accept application/vnd.ipld.car
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param archive body string true "archive"
+// @Success 201 {object} pb.Thread "thread"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 413 {string} string "Request Entity Too Large"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/import [post]
+func (a *Api) importThreads(g *gin.Context) {
+	body := http.MaxBytesReader(g.Writer, g.Request.Body, core.ThreadArchiveSizeLimit()+1)
+	archive, err := core.ReadThreadArchive(body)
+	if err == core.ErrThreadArchiveTooLarge {
+		g.String(http.StatusRequestEntityTooLarge, err.Error())
+		return
+	} else if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if archive.Thread.Id != g.Param("id") {
+		g.String(http.StatusBadRequest, "thread id mismatch")
+		return
+	}
+
+	thrd, err := a.Node.ImportThread(archive)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	view, err := a.Node.ThreadView(thrd.Id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusCreated, view)
+}
+
+// forkThreads godoc
+// @Summary Forks a thread
+// @Description Adds and joins a new thread seeded with copies of the files and messages of
+// @Description an existing thread, up to a given head block, returning a Thread object.
+// @Description File keys are re-encrypted for the new thread.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Args header string true "name"
+// @Param X-Textile-Opts header string false "key: A locally unique key used by an app to identify this thread on recovery, type: Set the thread type to one of 'private', 'read_only', 'public', or 'open', sharing: Set the thread sharing style to one of 'not_shared','invite_only', or 'shared', whitelist: An array of contact addresses, head: The block to fork from, omit for the current head, blocks: An array of file or message block IDs to copy, omit for all" default(type=private,sharing=not_shared,whitelist=,head=,blocks=)
+// @Success 201 {object} pb.Thread "thread"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/fork [post]
+func (a *Api) forkThreads(g *gin.Context) {
+	args, err := a.readArgs(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+	if len(args) == 0 {
+		g.String(http.StatusBadRequest, "missing thread name")
+		return
+	}
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if a.Node.Thread(id) == nil {
+		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
+		return
+	}
+
+	config := pb.AddThreadConfig{
+		Name: args[0],
+	}
+
+	if opts["key"] != "" {
+		config.Key = opts["key"]
+	} else {
+		config.Key = ksuid.New().String()
+	}
+
+	config.Type = pb.Thread_Type(pbValForEnumString(pb.Thread_Type_value, opts["type"]))
+	config.Sharing = pb.Thread_Sharing(pbValForEnumString(pb.Thread_Sharing_value, opts["sharing"]))
+	config.Whitelist = util.SplitString(opts["whitelist"], ",")
+
+	// make a new secret
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	thrd, err := a.Node.ForkThread(id, config, sk, opts["head"], util.SplitString(opts["blocks"], ","))
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	view, err := a.Node.ThreadView(thrd.Id)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	a.Node.FlushCafes()
+
+	pbJSON(g, http.StatusCreated, view)
+}
+
+// verifyThreads godoc
+// @Summary Verifies a thread
+// @Description Walks a thread's history from its heads, checking node and block availability,
+// @Description decryption, block and file indexes, and pin state. Optionally re-queues missing
+// @Description blocks for download and re-pins missing nodes.
+// @Tags threads
+// @Produce application/json
+// @Param id path string true "thread id"
+// @Param X-Textile-Opts header string false "repair: Whether or not to repair issues" default(repair=false)
+// @Success 200 {object} pb.ThreadVerification "verification"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /threads/{id}/verify [post]
+func (a *Api) verifyThreads(g *gin.Context) {
+	opts, err := a.readOpts(g)
+	if err != nil {
+		a.abort500(g, err)
+		return
+	}
+
+	id := g.Param("id")
+	if a.Node.Thread(id) == nil {
+		g.String(http.StatusNotFound, core.ErrThreadNotFound.Error())
+		return
+	}
+
+	res, err := a.Node.VerifyThread(id, opts["repair"] == "true")
+	if err != nil {
+		g.String(http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pbJSON(g, http.StatusOK, res)
+}
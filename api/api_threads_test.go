@@ -0,0 +1,66 @@
+package api
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/textileio/go-textile/core"
+)
+
+func TestApi_ExportThreads(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v0/threads/"+thrd.Id+"/export", nil)
+	res := testRequest(a, req, "")
+	if res.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", res.Code, res.Body.String())
+	}
+	if res.Header().Get("Content-Type") != "application/vnd.ipld.car" {
+		t.Fatalf("unexpected content type: %s", res.Header().Get("Content-Type"))
+	}
+
+	archive, err := core.ReadThreadArchive(bytes.NewReader(res.Body.Bytes()))
+	if err != nil {
+		t.Fatalf("read archive failed: %s", err)
+	}
+	if archive.Thread.Id != thrd.Id {
+		t.Fatalf("expected thread %s, got %s", thrd.Id, archive.Thread.Id)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/api/v0/threads/nope/export", nil)
+	res = testRequest(a, req, "")
+	if res.Code != http.StatusNotFound {
+		t.Fatalf("expected status 404, got %d", res.Code)
+	}
+}
+
+func TestApi_ImportThreads(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v0/threads/"+thrd.Id+"/import", strings.NewReader("nope"))
+	res := testRequest(a, req, "")
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d: %s", res.Code, res.Body.String())
+	}
+
+	var buf bytes.Buffer
+	if err := a.Node.ExportThread(thrd.Id, &buf); err != nil {
+		t.Fatal(err)
+	}
+	req = httptest.NewRequest(http.MethodPost, "/api/v0/threads/other/import", bytes.NewReader(buf.Bytes()))
+	res = testRequest(a, req, "")
+	if res.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d: %s", res.Code, res.Body.String())
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/api/v0/threads/"+thrd.Id+"/import", bytes.NewReader(buf.Bytes()))
+	res = testRequest(a, req, "")
+	if res.Code != http.StatusCreated {
+		t.Fatalf("expected status 201, got %d: %s", res.Code, res.Body.String())
+	}
+}
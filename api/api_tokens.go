@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
 )
 
 // createTokens godoc
@@ -90,3 +91,50 @@ func (a *Api) rmTokens(g *gin.Context) {
 	}
 	g.Status(http.StatusNoContent)
 }
+
+// disableTokens godoc
+// @Summary Disables a cafe token
+// @Description Disables an existing cafe token so it can't be used for new registrations.
+// @Description Sessions already issued to clients registered with the token remain valid.
+// @Tags tokens
+// @Param token path string true "token"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /tokens/{id}/disable [post]
+func (a *Api) disableTokens(g *gin.Context) {
+	a.setTokenDisabled(g, true)
+}
+
+// enableTokens godoc
+// @Summary Enables a cafe token
+// @Description Enables a disabled cafe token so it can be used for registrations again
+// @Tags tokens
+// @Param token path string true "token"
+// @Success 204 {string} string "ok"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /tokens/{id}/enable [post]
+func (a *Api) enableTokens(g *gin.Context) {
+	a.setTokenDisabled(g, false)
+}
+
+// setTokenDisabled disables or enables the token in the path
+func (a *Api) setTokenDisabled(g *gin.Context, disabled bool) {
+	token := g.Param("token")
+	var err error
+	if disabled {
+		err = a.Node.DisableCafeToken(token)
+	} else {
+		err = a.Node.EnableCafeToken(token)
+	}
+	if err != nil {
+		if err == core.ErrCafeTokenNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+	g.Status(http.StatusNoContent)
+}
@@ -0,0 +1,44 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+)
+
+// lsUsage godoc
+// @Summary List cafe client storage usage
+// @Description Lists storage usage and quotas for all clients registered with this cafe
+// @Tags usage
+// @Produce application/json
+// @Success 200 {object} pb.CafeClientUsageList "usage"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /usage [get]
+func (a *Api) lsUsage(g *gin.Context) {
+	pbJSON(g, http.StatusOK, a.Node.CafeClientUsages())
+}
+
+// getUsage godoc
+// @Summary Get cafe client storage usage
+// @Description Gets storage usage and quotas for a client registered with this cafe
+// @Tags usage
+// @Produce application/json
+// @Param id path string true "client id"
+// @Success 200 {object} pb.CafeClientUsage "usage"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /usage/{id} [get]
+func (a *Api) getUsage(g *gin.Context) {
+	usage, err := a.Node.CafeClientUsage(g.Param("id"))
+	if err != nil {
+		if err == core.ErrCafeClientNotFound {
+			g.String(http.StatusNotFound, err.Error())
+			return
+		}
+		a.abort500(g, err)
+		return
+	}
+
+	pbJSON(g, http.StatusOK, usage)
+}
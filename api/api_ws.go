@@ -0,0 +1,496 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"github.com/gorilla/websocket"
+	"github.com/textileio/go-textile/broadcast"
+)
+
+// websocket timing and limits
+const (
+	wsWriteTimeout     = time.Second * 10
+	wsPongTimeout      = time.Second * 60
+	wsPingInterval     = time.Second * 30
+	wsSendBuffer       = 256
+	wsMaxMessageSize   = 1 << 20
+	wsMaxSubscriptions = 64
+)
+
+// websocket message types
+const (
+	wsSubscribe   = "subscribe"
+	wsUnsubscribe = "unsubscribe"
+	wsCall        = "call"
+	wsAck         = "ack"
+	wsData        = "data"
+	wsEnd         = "end"
+	wsResult      = "result"
+	wsError       = "error"
+)
+
+// wsRequest is a message from a websocket client.
+// Subscriptions and calls are identified by the client's id, which is used for replies.
+type wsRequest struct {
+	Id     string            `json:"id"`
+	Type   string            `json:"type"`
+	Stream string            `json:"stream,omitempty"`
+	Method string            `json:"method,omitempty"`
+	Params map[string]string `json:"params,omitempty"`
+	Args   []string          `json:"args,omitempty"`
+	Opts   map[string]string `json:"opts,omitempty"`
+}
+
+// wsResponse is a message to a websocket client
+type wsResponse struct {
+	Id     string          `json:"id"`
+	Type   string          `json:"type"`
+	Status int             `json:"status,omitempty"`
+	Data   json.RawMessage `json:"data,omitempty"`
+	Error  string          `json:"error,omitempty"`
+}
+
+// wsRoute maps a websocket stream or call to an api route.
+// Path params (e.g., :thread) are filled from request params.
+type wsRoute struct {
+	method string
+	path   string
+}
+
+// wsStreams are subscriptions served by streaming api routes
+var wsStreams = map[string]wsRoute{
+	"threads":   {http.MethodGet, "/observe/:thread"},
+	"pubsub":    {http.MethodGet, "/ipfs/pubsub/sub/:topic"},
+	"contacts":  {http.MethodPost, "/contacts/search"},
+	"snapshots": {http.MethodPost, "/snapshots/search"},
+}
+
+// wsCalls are rpc-style commands served by api routes
+var wsCalls = map[string]wsRoute{
+	"message.add":       {http.MethodPost, "/threads/:thread/messages"},
+	"comment.add":       {http.MethodPost, "/blocks/:block/comments"},
+	"like.add":          {http.MethodPost, "/blocks/:block/likes"},
+	"notification.read": {http.MethodPost, "/notifications/:id/read"},
+}
+
+// ws godoc
+// @Summary Open a websocket
+// @Description Upgrades to a websocket that multiplexes subscriptions and rpc-style calls.
+// @Description Clients send JSON messages w/ an id and a type of subscribe, unsubscribe, or call.
+// @Description Streams are threads, notifications, account, pubsub, contacts, and snapshots.
+// @Description Calls are message.add, comment.add, like.add, and notification.read.
+// @Description Replies carry the request id w/ a type of ack, data, end, result, or error.
+// @Description Streams and calls are served by the matching api routes w/ the same auth.
+// @Tags ws
+// @Success 101 {string} string "Switching Protocols"
+// @Failure 400 {string} string "Bad Request"
+// @Router /ws [get]
+func (a *Api) ws(g *gin.Context) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: a.checkOrigin,
+	}
+	conn, err := upgrader.Upgrade(g.Writer, g.Request, nil)
+	if err != nil {
+		// the upgrader has already replied
+		log.Debugf("websocket upgrade failed: %s", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &wsConn{
+		api:    a,
+		conn:   conn,
+		req:    g.Request,
+		out:    make(chan *wsResponse, wsSendBuffer),
+		subs:   make(map[string]context.CancelFunc),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	go c.writeLoop()
+	c.readLoop()
+}
+
+// checkOrigin allows same host origins and those allowed by the api's CORS headers
+func (a *Api) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err == nil && u.Host == r.Host {
+		return true
+	}
+	for _, allowed := range a.Node.Config().API.HTTPHeaders["Access-Control-Allow-Origin"] {
+		if allowed == "*" || allowed == origin {
+			return true
+		}
+	}
+	return false
+}
+
+// wsConn is a single websocket client
+type wsConn struct {
+	api    *Api
+	conn   *websocket.Conn
+	req    *http.Request
+	out    chan *wsResponse
+	subs   map[string]context.CancelFunc
+	lock   sync.Mutex
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// readLoop handles client messages until the connection closes
+func (c *wsConn) readLoop() {
+	defer c.close()
+
+	c.conn.SetReadLimit(wsMaxMessageSize)
+	_ = c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(wsPongTimeout))
+	})
+
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseNormalClosure) {
+				log.Debugf("websocket read failed: %s", err)
+			}
+			return
+		}
+		req := new(wsRequest)
+		if err := json.Unmarshal(data, req); err != nil {
+			c.send(&wsResponse{Type: wsError, Error: err.Error()})
+			continue
+		}
+		c.handle(req)
+	}
+}
+
+// writeLoop writes replies and keeps the connection alive until it closes
+func (c *wsConn) writeLoop() {
+	ticker := time.NewTicker(wsPingInterval)
+	defer func() {
+		ticker.Stop()
+		c.close()
+	}()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			_ = c.conn.WriteMessage(websocket.CloseMessage, []byte{})
+			return
+
+		case res := <-c.out:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteJSON(res); err != nil {
+				return
+			}
+
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			if err := c.conn.WriteMessage(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// close cancels all subscriptions and closes the connection
+func (c *wsConn) close() {
+	c.cancel()
+	_ = c.conn.Close()
+}
+
+// send queues a reply w/o blocking, so that a slow client can't hold up node listeners.
+// Clients that do not keep up w/ the send buffer are disconnected.
+func (c *wsConn) send(res *wsResponse) {
+	select {
+	case <-c.ctx.Done():
+		return
+	default:
+	}
+	select {
+	case c.out <- res:
+	default:
+		log.Warningf("closing slow websocket client %s", c.req.RemoteAddr)
+		c.close()
+	}
+}
+
+// handle dispatches a client message
+func (c *wsConn) handle(req *wsRequest) {
+	if req.Id == "" {
+		c.send(&wsResponse{Type: wsError, Error: "missing id"})
+		return
+	}
+
+	switch req.Type {
+	case wsSubscribe:
+		c.subscribe(req)
+
+	case wsUnsubscribe:
+		c.lock.Lock()
+		cancel, ok := c.subs[req.Id]
+		c.lock.Unlock()
+		if !ok {
+			c.send(&wsResponse{Id: req.Id, Type: wsError, Error: "subscription not found"})
+			return
+		}
+		cancel()
+
+	case wsCall:
+		route, ok := wsCalls[req.Method]
+		if !ok {
+			c.send(&wsResponse{Id: req.Id, Type: wsError, Error: "unknown method: " + req.Method})
+			return
+		}
+		go c.call(route, req)
+
+	default:
+		c.send(&wsResponse{Id: req.Id, Type: wsError, Error: "unknown type: " + req.Type})
+	}
+}
+
+// subscribe starts a stream for the client
+func (c *wsConn) subscribe(req *wsRequest) {
+	var run func(ctx context.Context)
+	switch req.Stream {
+	case "notifications":
+		run = func(ctx context.Context) {
+			c.listen(ctx, req.Id, c.api.Node.NotificationListener())
+		}
+	case "account":
+		run = func(ctx context.Context) {
+			c.listen(ctx, req.Id, c.api.Node.AccountUpdateListener())
+		}
+	default:
+		route, ok := wsStreams[req.Stream]
+		if !ok {
+			c.send(&wsResponse{Id: req.Id, Type: wsError, Error: "unknown stream: " + req.Stream})
+			return
+		}
+		run = func(ctx context.Context) {
+			c.stream(ctx, route, req)
+		}
+	}
+
+	c.lock.Lock()
+	if _, ok := c.subs[req.Id]; ok {
+		c.lock.Unlock()
+		c.send(&wsResponse{Id: req.Id, Type: wsError, Error: "duplicate id"})
+		return
+	}
+	if len(c.subs) >= wsMaxSubscriptions {
+		c.lock.Unlock()
+		c.send(&wsResponse{Id: req.Id, Type: wsError, Error: "too many subscriptions"})
+		return
+	}
+	ctx, cancel := context.WithCancel(c.ctx)
+	c.subs[req.Id] = cancel
+	c.lock.Unlock()
+
+	c.send(&wsResponse{Id: req.Id, Type: wsAck})
+	go func() {
+		run(ctx)
+		cancel()
+
+		c.lock.Lock()
+		delete(c.subs, req.Id)
+		c.lock.Unlock()
+	}()
+}
+
+// listen relays messages from a node listener
+func (c *wsConn) listen(ctx context.Context, id string, listener *broadcast.Listener) {
+	defer closeListener(listener)
+
+	for {
+		select {
+		case <-ctx.Done():
+			c.send(&wsResponse{Id: id, Type: wsEnd})
+			return
+
+		case value, ok := <-listener.Ch:
+			if !ok {
+				c.send(&wsResponse{Id: id, Type: wsEnd})
+				return
+			}
+			msg, ok := value.(proto.Message)
+			if !ok {
+				continue
+			}
+			str, err := pbMarshaler.MarshalToString(msg)
+			if err != nil {
+				log.Errorf("error marshaling websocket message: %s", err)
+				continue
+			}
+			c.send(&wsResponse{Id: id, Type: wsData, Data: json.RawMessage(str)})
+		}
+	}
+}
+
+// stream relays each line written by a streaming route
+func (c *wsConn) stream(ctx context.Context, route wsRoute, req *wsRequest) {
+	w := newWsWriter(ctx, func(line []byte) {
+		c.send(&wsResponse{Id: req.Id, Type: wsData, Data: jsonOrString(line)})
+	})
+	c.serve(ctx, route, req, w)
+
+	if w.status >= http.StatusBadRequest {
+		c.send(&wsResponse{Id: req.Id, Type: wsError, Status: w.status, Error: strings.TrimSpace(w.buf.String())})
+		return
+	}
+	c.send(&wsResponse{Id: req.Id, Type: wsEnd})
+}
+
+// call replies w/ the response of a route
+func (c *wsConn) call(route wsRoute, req *wsRequest) {
+	ctx, cancel := context.WithCancel(c.ctx)
+	defer cancel()
+
+	w := newWsWriter(ctx, nil)
+	c.serve(ctx, route, req, w)
+
+	res := &wsResponse{Id: req.Id, Type: wsResult, Status: w.status}
+	if w.status >= http.StatusBadRequest {
+		res.Type = wsError
+		res.Error = strings.TrimSpace(w.buf.String())
+	} else if w.buf.Len() > 0 {
+		res.Data = jsonOrString(w.buf.Bytes())
+	}
+	c.send(res)
+}
+
+// serve runs a route w/ the auth of the websocket request
+func (c *wsConn) serve(ctx context.Context, route wsRoute, req *wsRequest, w *wsWriter) {
+	var segments []string
+	for _, seg := range strings.Split(route.path, "/") {
+		if strings.HasPrefix(seg, ":") {
+			seg = url.PathEscape(req.Params[seg[1:]])
+		}
+		segments = append(segments, seg)
+	}
+	pth := strings.TrimSuffix(strings.Join(segments, "/"), "/")
+
+	r, err := http.NewRequest(route.method, "/api/v0"+pth, nil)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(err.Error()))
+		return
+	}
+	r = r.WithContext(ctx)
+	r.RemoteAddr = c.req.RemoteAddr
+	if auth := c.req.Header.Get("Authorization"); auth != "" {
+		r.Header.Set("Authorization", auth)
+	}
+	if len(req.Args) > 0 {
+		var items []string
+		for _, arg := range req.Args {
+			items = append(items, url.PathEscape(arg))
+		}
+		r.Header.Set("X-Textile-Args", strings.Join(items, ","))
+	}
+	if len(req.Opts) > 0 {
+		var items []string
+		for k, v := range req.Opts {
+			items = append(items, k+"="+url.PathEscape(v))
+		}
+		r.Header.Set("X-Textile-Opts", strings.Join(items, ","))
+	}
+
+	c.api.router.ServeHTTP(w, r)
+}
+
+// wsWriter is a response writer for routes served over a websocket.
+// If line is set, each complete line is passed to it as it's written.
+type wsWriter struct {
+	header http.Header
+	status int
+	buf    bytes.Buffer
+	line   func([]byte)
+	closed chan bool
+}
+
+func newWsWriter(ctx context.Context, line func([]byte)) *wsWriter {
+	w := &wsWriter{
+		header: make(http.Header),
+		line:   line,
+		closed: make(chan bool, 1),
+	}
+	go func() {
+		<-ctx.Done()
+		w.closed <- true
+	}()
+	return w
+}
+
+func (w *wsWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *wsWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *wsWriter) Write(p []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	w.buf.Write(p)
+	if w.line == nil || w.status >= http.StatusBadRequest {
+		return len(p), nil
+	}
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			break
+		}
+		line := bytes.TrimSpace(w.buf.Next(i + 1))
+		if len(line) > 0 {
+			w.line(append([]byte{}, line...))
+		}
+	}
+	return len(p), nil
+}
+
+func (w *wsWriter) Flush() {}
+
+func (w *wsWriter) CloseNotify() <-chan bool {
+	return w.closed
+}
+
+// jsonOrString returns data as is if it's valid JSON, or as a JSON string
+func jsonOrString(data []byte) json.RawMessage {
+	if json.Valid(data) {
+		return json.RawMessage(data)
+	}
+	str, _ := json.Marshal(string(data))
+	return json.RawMessage(str)
+}
+
+// closeListener closes a listener while draining it so that a blocked send can't deadlock
+func closeListener(listener *broadcast.Listener) {
+	done := make(chan struct{})
+	go func() {
+		listener.Close()
+		close(done)
+	}()
+	for {
+		select {
+		case <-listener.Ch:
+		case <-done:
+			return
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/textileio/go-textile/pb"
+)
+
+func TestWsWriter_Lines(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var lines []string
+	w := newWsWriter(ctx, func(line []byte) {
+		lines = append(lines, string(line))
+	})
+	_, _ = w.Write([]byte("a\nb"))
+	_, _ = w.Write([]byte("c\n\n"))
+	_, _ = w.Write([]byte("  d  \ne"))
+
+	if w.status != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", w.status)
+	}
+	if strings.Join(lines, ",") != "a,bc,d" {
+		t.Fatalf("unexpected lines: %v", lines)
+	}
+	if w.buf.String() != "e" {
+		t.Fatalf("expected partial line to be buffered, got %s", w.buf.String())
+	}
+}
+
+func TestWsWriter_Error(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var lines int
+	w := newWsWriter(ctx, func(line []byte) {
+		lines++
+	})
+	w.WriteHeader(http.StatusForbidden)
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("denied\n"))
+
+	if w.status != http.StatusForbidden {
+		t.Fatalf("expected status 403, got %d", w.status)
+	}
+	if lines != 0 {
+		t.Fatal("error bodies should not be split into lines")
+	}
+	if w.buf.String() != "denied\n" {
+		t.Fatalf("unexpected body: %s", w.buf.String())
+	}
+
+	cancel()
+	select {
+	case <-w.CloseNotify():
+	case <-time.After(time.Second):
+		t.Fatal("expected close notification")
+	}
+}
+
+func TestJsonOrString(t *testing.T) {
+	if string(jsonOrString([]byte(`{"a":1}`))) != `{"a":1}` {
+		t.Fatal("expected json to pass through")
+	}
+	if string(jsonOrString([]byte("ok"))) != `"ok"` {
+		t.Fatal("expected text to be quoted")
+	}
+}
+
+func TestApi_WsCall(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+	server := httptest.NewServer(a.router)
+	defer server.Close()
+
+	call := &wsRequest{
+		Id:     "1",
+		Type:   wsCall,
+		Method: "message.add",
+		Params: map[string]string{"thread": thrd.Id},
+		Args:   []string{"hi"},
+	}
+
+	// a read key can't write over the socket
+	conn := testWsDial(t, server, testApiKey(t, a, pb.ApiKey_READ))
+	defer conn.Close()
+	res := testWsRoundTrip(t, conn, call)
+	if res.Type != wsError || res.Status != http.StatusForbidden {
+		t.Fatalf("expected forbidden error, got %s w/ status %d", res.Type, res.Status)
+	}
+
+	conn = testWsDial(t, server, testApiKey(t, a, pb.ApiKey_THREAD_WRITE, thrd.Id))
+	defer conn.Close()
+	res = testWsRoundTrip(t, conn, call)
+	if res.Type != wsResult || res.Status != http.StatusCreated {
+		t.Fatalf("expected result, got %s w/ status %d: %s", res.Type, res.Status, res.Error)
+	}
+	var text pb.Text
+	if err := pbUnmarshaler.Unmarshal(strings.NewReader(string(res.Data)), &text); err != nil {
+		t.Fatal(err)
+	}
+	if text.Body != "hi" {
+		t.Fatalf("unexpected message body: %s", text.Body)
+	}
+
+	res = testWsRoundTrip(t, conn, &wsRequest{Id: "2", Type: wsCall, Method: "nope"})
+	if res.Id != "2" || res.Type != wsError {
+		t.Fatalf("expected unknown method error, got %s", res.Type)
+	}
+}
+
+func TestApi_WsSubscribe(t *testing.T) {
+	a := testApi(t)
+	thrd := testThread(t, a, "")
+	server := httptest.NewServer(a.router)
+	defer server.Close()
+
+	conn := testWsDial(t, server, testApiKey(t, a, pb.ApiKey_READ))
+	defer conn.Close()
+
+	res := testWsRoundTrip(t, conn, &wsRequest{
+		Id:     "sub",
+		Type:   wsSubscribe,
+		Stream: "threads",
+		Params: map[string]string{"thread": thrd.Id},
+	})
+	if res.Id != "sub" || res.Type != wsAck {
+		t.Fatalf("expected ack, got %s: %s", res.Type, res.Error)
+	}
+
+	if _, err := thrd.AddMessage("", "hello"); err != nil {
+		t.Fatal(err)
+	}
+	res = testWsRead(t, conn)
+	if res.Id != "sub" || res.Type != wsData {
+		t.Fatalf("expected data, got %s: %s", res.Type, res.Error)
+	}
+	var item pb.FeedItem
+	if err := pbUnmarshaler.Unmarshal(strings.NewReader(string(res.Data)), &item); err != nil {
+		t.Fatal(err)
+	}
+	if item.Thread != thrd.Id {
+		t.Fatalf("expected update from thread %s, got %s", thrd.Id, item.Thread)
+	}
+
+	res = testWsRoundTrip(t, conn, &wsRequest{Id: "sub", Type: wsUnsubscribe})
+	if res.Id != "sub" || res.Type != wsEnd {
+		t.Fatalf("expected end, got %s: %s", res.Type, res.Error)
+	}
+}
+
+func TestWsConn_SendFull(t *testing.T) {
+	conns := make(chan *websocket.Conn, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		conns <- conn
+	}))
+	defer server.Close()
+
+	client := testWsDial(t, server, "")
+	defer client.Close()
+	conn := <-conns
+
+	ctx, cancel := context.WithCancel(context.Background())
+	c := &wsConn{
+		conn:   conn,
+		req:    httptest.NewRequest(http.MethodGet, "/", nil),
+		out:    make(chan *wsResponse, 1),
+		ctx:    ctx,
+		cancel: cancel,
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.send(&wsResponse{Id: "1", Type: wsData})
+		c.send(&wsResponse{Id: "2", Type: wsData})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("send blocked on a full buffer")
+	}
+	if ctx.Err() == nil {
+		t.Fatal("expected a slow client to be disconnected")
+	}
+}
+
+// testWsDial opens a websocket to the api of server w/ an optional bearer api key
+func testWsDial(t *testing.T, server *httptest.Server, key string) *websocket.Conn {
+	header := http.Header{}
+	if key != "" {
+		header.Set("Authorization", "Bearer "+key)
+	}
+	u := "ws" + strings.TrimPrefix(server.URL, "http") + "/api/v0/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(u, header)
+	if err != nil {
+		t.Fatalf("websocket dial failed: %s", err)
+	}
+	return conn
+}
+
+// testWsRoundTrip sends a request and reads the next reply
+func testWsRoundTrip(t *testing.T, conn *websocket.Conn, req *wsRequest) *wsResponse {
+	data, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+		t.Fatalf("websocket write failed: %s", err)
+	}
+	return testWsRead(t, conn)
+}
+
+// testWsRead reads the next reply
+func testWsRead(t *testing.T, conn *websocket.Conn) *wsResponse {
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 10))
+	res := new(wsResponse)
+	if err := conn.ReadJSON(res); err != nil {
+		t.Fatalf("websocket read failed: %s", err)
+	}
+	return res
+}
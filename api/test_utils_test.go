@@ -0,0 +1,113 @@
+package api
+
+import (
+	"crypto/rand"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+)
+
+var vars = struct {
+	initConfig core.InitConfig
+
+	once sync.Once
+	api  *Api
+	err  error
+}{
+	initConfig: core.InitConfig{
+		BaseRepoPath: "testdata/.textile2",
+		ApiAddr:      "127.0.0.1:0",
+		GrpcAddr:     "127.0.0.1:0",
+	},
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if vars.api != nil {
+		_ = vars.api.Stop()
+		_ = vars.api.Node.Stop()
+	}
+	_ = os.RemoveAll(vars.initConfig.BaseRepoPath)
+	os.Exit(code)
+}
+
+// testApi returns an online api shared by the package tests. The router is used directly.
+func testApi(t *testing.T) *Api {
+	vars.once.Do(func() {
+		gin.SetMode(gin.ReleaseMode)
+		gin.DefaultWriter = ioutil.Discard
+
+		node, err := core.CreateAndStartPeer(vars.initConfig, true)
+		if err != nil {
+			vars.err = err
+			return
+		}
+		// nothing else consumes account updates, which would block once the buffer fills
+		go func() {
+			for range node.UpdateCh() {
+			}
+		}()
+
+		vars.api = &Api{
+			Node:     node,
+			RepoPath: node.RepoPath(),
+			addr:     "127.0.0.1:0",
+		}
+		vars.api.Run()
+	})
+	if vars.err != nil {
+		t.Fatalf("start api failed: %s", vars.err)
+	}
+	return vars.api
+}
+
+// testThread adds a thread w/ an optional schema to the api node
+func testThread(t *testing.T, a *Api, schemaId string) *core.Thread {
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := pb.AddThreadConfig{
+		Key:     ksuid.New().String(),
+		Name:    "test",
+		Type:    pb.Thread_OPEN,
+		Sharing: pb.Thread_SHARED,
+	}
+	if schemaId != "" {
+		conf.Schema = &pb.AddThreadConfig_Schema{Id: schemaId}
+	}
+	thrd, err := a.Node.AddThread(conf, sk, a.Node.Account().Address(), true, false)
+	if err != nil {
+		t.Fatalf("add thread failed: %s", err)
+	}
+	return thrd
+}
+
+// testApiKey creates an api key w/ scope and returns its plaintext
+func testApiKey(t *testing.T, a *Api, scope pb.ApiKey_Scope, threads ...string) string {
+	key, err := a.Node.CreateApiKey("test", []pb.ApiKey_Scope{scope}, threads, time.Hour)
+	if err != nil {
+		t.Fatalf("create api key failed: %s", err)
+	}
+	return key.Key
+}
+
+// testRequest serves a request w/ an optional bearer api key
+func testRequest(a *Api, req *http.Request, key string) *httptest.ResponseRecorder {
+	if key != "" {
+		req.Header.Set("Authorization", "Bearer "+key)
+	}
+	res := httptest.NewRecorder()
+	a.router.ServeHTTP(res, req)
+	return res
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"net/http"
+	"strings"
+)
+
+func ApiKeyCreate(name string, scopes []string, threads []string, expires string) error {
+	opts := map[string]string{
+		"name":    name,
+		"scopes":  strings.Join(scopes, "|"),
+		"threads": strings.Join(threads, "|"),
+		"expires": expires,
+	}
+
+	res, err := executeJsonCmd(http.MethodPost, "apikeys", params{opts: opts}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ApiKeyList() error {
+	res, err := executeJsonCmd(http.MethodGet, "apikeys", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ApiKeyRevoke(id string) error {
+	res, err := executeStringCmd(http.MethodDelete, "apikeys/"+id, params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
@@ -7,12 +7,13 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func BlockList(threadID string, offset string, limit int, dots bool) error {
+func BlockList(threadID string, offset string, limit int, sort string, dots bool) error {
 	var nextOffset string
 	opts := map[string]string{
 		"thread": threadID,
 		"offset": offset,
 		"limit":  strconv.Itoa(limit),
+		"sort":   sort,
 		"dots":   strconv.FormatBool(dots),
 	}
 
@@ -51,7 +52,7 @@ func BlockList(threadID string, offset string, limit int, dots bool) error {
 		return err
 	}
 
-	return BlockList(threadID, nextOffset, limit, dots)
+	return BlockList(threadID, nextOffset, limit, sort, dots)
 }
 
 func BlockMeta(blockID string) error {
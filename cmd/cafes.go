@@ -43,6 +43,17 @@ func CafeDelete(cafeID string) error {
 	return nil
 }
 
+func CafeWebhook(cafeID string, url string, secret string) error {
+	res, err := executeStringCmd(http.MethodPut, "cafes/"+cafeID+"/webhook", params{
+		opts: map[string]string{"url": url, "secret": secret},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func CafeMessages() error {
 	res, err := executeStringCmd(http.MethodPost, "cafes/messages", params{})
 	if err != nil {
@@ -51,3 +62,70 @@ func CafeMessages() error {
 	output(res)
 	return nil
 }
+
+func CafeClientsUsage(clientID string) error {
+	url := "usage"
+	if clientID != "" {
+		url += "/" + clientID
+	}
+	res, err := executeJsonCmd(http.MethodGet, url, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientsList() error {
+	res, err := executeJsonCmd(http.MethodGet, "clients", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientsGet(clientID string) error {
+	res, err := executeJsonCmd(http.MethodGet, "clients/"+clientID, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientsRevoke(clientID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "clients/"+clientID+"/sessions", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeClientsPurge(clientID string) error {
+	res, err := executeStringCmd(http.MethodDelete, "clients/"+clientID+"/data", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeGCRun() error {
+	res, err := executeJsonCmd(http.MethodPost, "gc", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func CafeGCLast() error {
+	res, err := executeJsonCmd(http.MethodGet, "gc", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
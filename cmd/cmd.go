@@ -40,9 +40,10 @@ func threadBlocksCommand(cmds cmdsMap, parent *kingpin.CmdClause, names []string
 	blockListThreadID := cmd.Arg("thread", "Thread ID").Required().String()
 	blockListOffset := cmd.Flag("offset", "Offset ID to start listing from").Short('o').String()
 	blockListLimit := cmd.Flag("limit", "List page size").Short('l').Default("5").Int()
+	blockListSort := cmd.Flag("sort", "Sort order, one of: date, causal").Default("date").String()
 	blockListDots := cmd.Flag("dots", "Return GraphViz dots instead of JSON").Short('d').Bool()
 	cmds[cmd.FullCommand()] = func() error {
-		return BlockList(*blockListThreadID, *blockListOffset, *blockListLimit, *blockListDots)
+		return BlockList(*blockListThreadID, *blockListOffset, *blockListLimit, *blockListSort, *blockListDots)
 	}
 
 	return cmd
@@ -57,8 +58,9 @@ func threadFilesCommand(cmds cmdsMap, parent *kingpin.CmdClause, names []string)
 	threadID := cmd.Arg("thread", "Thread ID, omit for all").String()
 	offset := cmd.Flag("offset", "Offset ID to start listing from").Short('o').String()
 	limit := cmd.Flag("limit", "List page size").Short('l').Default("5").Int()
+	sort := cmd.Flag("sort", "Sort order, one of: date, causal").Default("date").String()
 	cmds[cmd.FullCommand()] = func() error {
-		return FileListThread(*threadID, *offset, *limit)
+		return FileListThread(*threadID, *offset, *limit, *sort)
 	}
 
 	return cmd
@@ -85,6 +87,15 @@ func blockFilesCommand(cmds cmdsMap, parent *kingpin.CmdClause, names []string)
 		return FileGetBlock(*getBlockID, *getIndex, *getPath, *getContent)
 	}
 
+	shareCmd := cmd.Command("share", "Create a signed gateway link to a specific file within the File Block")
+	shareBlockID := shareCmd.Arg("block", "File Block ID").Required().String()
+	shareIndex := shareCmd.Flag("index", "The index of the file to share").Default("0").Int()
+	sharePath := shareCmd.Flag("path", "The link path of the file to share").Default(".").String()
+	shareExpires := shareCmd.Flag("expires", "How long the link is valid, e.g., 30m (max: 168h)").Short('e').Default("1h").String()
+	cmds[shareCmd.FullCommand()] = func() error {
+		return FileShareBlock(*shareBlockID, *shareIndex, *sharePath, *shareExpires)
+	}
+
 	return cmd
 }
 
@@ -133,6 +144,7 @@ var (
 	logDebug    = appCmd.Flag("debug", "Set the logging level to debug").Bool()
 	appUsername = appCmd.Flag("username", "Specify the username (address) if required for Basic Auth").Envar("TEXTILE_USERNAME").String()
 	appPassword = appCmd.Flag("password", "Specify the password (pincode) used for datastore encryption and Basic Auth (omit if no auth/encryption is used)").Envar("TEXTILE_PASSWORD").String()
+	appApiKey   = appCmd.Flag("api-key", "Specify an API key to use instead of Basic Auth").Envar("TEXTILE_API_KEY").String()
 )
 
 func Run() error {
@@ -255,10 +267,66 @@ An access token is required to register, and should be obtained separately from
 		return CafeDelete(*cafeDeleteCafeID)
 	}
 
+	// cafe webhook
+	cafeWebhookCmd := cafeCmd.Command("webhook", `Sets a URL to receive signed "you have mail" events when messages arrive at a cafe, e.g., a push relay.
+Events are signed with the secret (hex HMAC-SHA256 in the X-Textile-Signature header). Omit the URL to remove the webhook.`)
+	cafeWebhookCafeID := cafeWebhookCmd.Arg("cafe", "Cafe ID").Required().String()
+	cafeWebhookURL := cafeWebhookCmd.Arg("url", "Webhook URL (omit to remove)").String()
+	cafeWebhookSecret := cafeWebhookCmd.Flag("secret", "Secret used to sign webhook events").Short('s').String()
+	cmds[cafeWebhookCmd.FullCommand()] = func() error {
+		return CafeWebhook(*cafeWebhookCafeID, *cafeWebhookURL, *cafeWebhookSecret)
+	}
+
 	// cafe messages
 	cafeMessagesCmd := cafeCmd.Command("messages", "Check for messages at all cafes. New messages are downloaded and processed opportunistically.")
 	cmds[cafeMessagesCmd.FullCommand()] = CafeMessages
 
+	// cafe clients
+	cafeClientsCmd := cafeCmd.Command("clients", "Commands to manage clients of this cafe (when acting as a cafe host)").Alias("client")
+
+	// cafe clients usage
+	cafeClientsUsageCmd := cafeClientsCmd.Command("usage", "Show storage usage and quotas for all clients, or a single client")
+	cafeClientsUsageClientID := cafeClientsUsageCmd.Arg("client", "Client ID (omit for all)").String()
+	cmds[cafeClientsUsageCmd.FullCommand()] = func() error {
+		return CafeClientsUsage(*cafeClientsUsageClientID)
+	}
+
+	// cafe clients list
+	cafeClientsListCmd := cafeClientsCmd.Command("list", "List clients of this cafe with last-seen dates and storage usage").Alias("ls").Default()
+	cmds[cafeClientsListCmd.FullCommand()] = CafeClientsList
+
+	// cafe clients get
+	cafeClientsGetCmd := cafeClientsCmd.Command("get", "Gets a client of this cafe with storage usage")
+	cafeClientsGetClientID := cafeClientsGetCmd.Arg("client", "Client ID").Required().String()
+	cmds[cafeClientsGetCmd.FullCommand()] = func() error {
+		return CafeClientsGet(*cafeClientsGetClientID)
+	}
+
+	// cafe clients revoke
+	cafeClientsRevokeCmd := cafeClientsCmd.Command("revoke", "Revokes all sessions issued to a client (the client must register again)")
+	cafeClientsRevokeClientID := cafeClientsRevokeCmd.Arg("client", "Client ID").Required().String()
+	cmds[cafeClientsRevokeCmd.FullCommand()] = func() error {
+		return CafeClientsRevoke(*cafeClientsRevokeClientID)
+	}
+
+	// cafe clients purge
+	cafeClientsPurgeCmd := cafeClientsCmd.Command("purge", "Deletes a client's stored threads, inbox messages, and pins not referenced by other clients")
+	cafeClientsPurgeClientID := cafeClientsPurgeCmd.Arg("client", "Client ID").Required().String()
+	cmds[cafeClientsPurgeCmd.FullCommand()] = func() error {
+		return CafeClientsPurge(*cafeClientsPurgeClientID)
+	}
+
+	// cafe gc
+	cafeGCCmd := cafeCmd.Command("gc", "Commands to garbage collect objects no longer stored for clients of this cafe")
+
+	// cafe gc run
+	cafeGCRunCmd := cafeGCCmd.Command("run", "Runs garbage collection and reports the blocks removed and bytes freed").Default()
+	cmds[cafeGCRunCmd.FullCommand()] = CafeGCRun
+
+	// cafe gc last
+	cafeGCLastCmd := cafeGCCmd.Command("last", "Shows the report from the last garbage collection, scheduled or manual")
+	cmds[cafeGCLastCmd.FullCommand()] = CafeGCLast
+
 	// ================================
 
 	// chat
@@ -377,6 +445,44 @@ An access token is required to register, and should be obtained separately from
 
 	// ================================
 
+	// deadletter
+	deadLetterCmd := appCmd.Command("deadletter", `Dead letters are queue items (block downloads, block posts, cafe inbox messages, and cafe requests)
+that exhausted their attempts. Inspect them, return them to their queues, or purge them.`).Alias("deadletters")
+
+	// deadletter list
+	deadLetterListCmd := deadLetterCmd.Command("list", "Lists dead letters, newest first").Alias("ls").Default()
+	deadLetterListQueue := deadLetterListCmd.Flag("queue", "Comma-separated queues to list from: block_downloads, block_posts, cafe_inbox, or cafe_requests (omit for all)").Short('q').String()
+	deadLetterListOffset := deadLetterListCmd.Flag("offset", "Offset ID to start listing from").Short('o').String()
+	deadLetterListLimit := deadLetterListCmd.Flag("limit", "List page size").Short('l').Default("10").Int()
+	cmds[deadLetterListCmd.FullCommand()] = func() error {
+		return DeadLetterList(*deadLetterListQueue, *deadLetterListOffset, *deadLetterListLimit)
+	}
+
+	// deadletter get
+	deadLetterGetCmd := deadLetterCmd.Command("get", "Gets a dead letter, including its original queue item")
+	deadLetterGetID := deadLetterGetCmd.Arg("id", "Dead letter ID").Required().String()
+	cmds[deadLetterGetCmd.FullCommand()] = func() error {
+		return DeadLetterGet(*deadLetterGetID)
+	}
+
+	// deadletter retry
+	deadLetterRetryCmd := deadLetterCmd.Command("retry", "Returns one or all dead letters to their queues with a fresh attempt count")
+	deadLetterRetryID := deadLetterRetryCmd.Arg("id", "Dead letter ID (omit for all)").String()
+	deadLetterRetryQueue := deadLetterRetryCmd.Flag("queue", "Comma-separated queues to retry when no ID is given (omit for all)").Short('q').String()
+	cmds[deadLetterRetryCmd.FullCommand()] = func() error {
+		return DeadLetterRetry(*deadLetterRetryID, *deadLetterRetryQueue)
+	}
+
+	// deadletter purge
+	deadLetterPurgeCmd := deadLetterCmd.Command("purge", "Permanently deletes one or all dead letters").Alias("rm")
+	deadLetterPurgeID := deadLetterPurgeCmd.Arg("id", "Dead letter ID (omit for all)").String()
+	deadLetterPurgeQueue := deadLetterPurgeCmd.Flag("queue", "Comma-separated queues to purge when no ID is given (omit for all)").Short('q').String()
+	cmds[deadLetterPurgeCmd.FullCommand()] = func() error {
+		return DeadLetterPurge(*deadLetterPurgeID, *deadLetterPurgeQueue)
+	}
+
+	// ================================
+
 	// docs
 	docsCmd := appCmd.Command("docs", "Prints the CLI help as HTML")
 	cmds[docsCmd.FullCommand()] = Docs
@@ -403,8 +509,9 @@ Stacks may include:
 	feedLimit := feedCmd.Flag("limit", "List page size").Short('l').Default("3").Int()
 	feedMode := feedCmd.Flag("mode", "Feed mode, one of: chrono, annotated, stacks").Short('m').Default("chrono").String()
 	// ^ when kingpin v2 lands with enumerables, we could move the usage docs to the enum docs
+	feedSort := feedCmd.Flag("sort", "Sort order, one of: date, causal").Default("date").String()
 	cmds[feedCmd.FullCommand()] = func() error {
-		return Feed(*feedThreadID, *feedOffset, *feedLimit, *feedMode)
+		return Feed(*feedThreadID, *feedOffset, *feedLimit, *feedMode, *feedSort)
 	}
 
 	// ================================
@@ -468,6 +575,7 @@ Stacks may include:
 	initIpfsSwarmPorts := initCmd.Flag("swarm-ports", "Set the swarm ports (TCP,WS). A random TCP port is chosen by default").String()
 	initLogFiles := initCmd.Flag("log-files", "If true, writes logs to rolling files, if false, writes logs to stdout").Default("false").Bool()
 	initApiBindAddr := initCmd.Flag("api-bind-addr", "Set the local API address").Default("127.0.0.1:40600").String()
+	initGrpcBindAddr := initCmd.Flag("grpc-bind-addr", "Set the local gRPC API address").Default("127.0.0.1:40602").String()
 	initCafeApiBindAddr := initCmd.Flag("cafe-bind-addr", "Set the cafe REST API address").Default("0.0.0.0:40601").String()
 	initGatewayBindAddr := initCmd.Flag("gateway-bind-addr", "Set the IPFS gateway address").Default("127.0.0.1:5050").String()
 	initProfilingBindAddr := initCmd.Flag("profile-bind-addr", "Set the profiling address").Default("127.0.0.1:6060").String()
@@ -504,6 +612,7 @@ Stacks may include:
 			BaseRepoPath:    baseRepo,
 			SwarmPorts:      *initIpfsSwarmPorts,
 			ApiAddr:         *initApiBindAddr,
+			GrpcAddr:        *initGrpcBindAddr,
 			CafeApiAddr:     *initCafeApiBindAddr,
 			GatewayAddr:     *initGatewayBindAddr,
 			ProfilingAddr:   *initProfilingBindAddr,
@@ -679,8 +788,9 @@ There are two types of invites, direct account-to-account and external:
 	messageListThreadID := messageListCmd.Arg("thread", "Thread ID, omit to paginate all messages").String()
 	messageListOffset := messageListCmd.Flag("offset", "Offset ID to start the listing from").Short('o').String()
 	messageListLimit := messageListCmd.Flag("limit", "List page size").Default("10").Short('l').Int()
+	messageListSort := messageListCmd.Flag("sort", "Sort order, one of: date, causal").Default("date").String()
 	cmds[messageListCmd.FullCommand()] = func() error {
-		return MessageList(*messageListThreadID, *messageListOffset, *messageListLimit)
+		return MessageList(*messageListThreadID, *messageListOffset, *messageListLimit, *messageListSort)
 	}
 
 	// message get
@@ -777,8 +887,35 @@ There are two types of invites, direct account-to-account and external:
 	observeCmd := appCmd.Command("observe", "Observe updates in a thread or all threads. An update is generated when a new block is added to a thread.").Alias("subscribe").Alias("listen").Alias("stream")
 	observeThreadID := observeCmd.Arg("thread", "Thread ID, omit for all").String()
 	observeType := observeCmd.Flag("type", "Only be alerted to specific type of updates, possible values: merge, ignore, flag, join, announce, leave, text, files comment, like. Can be used multiple times, e.g., --type files --type comment").Short('k').Strings()
+	observeSince := observeCmd.Flag("since", "Replay updates for blocks added after this block ID before observing new ones").Short('s').String()
 	cmds[observeCmd.FullCommand()] = func() error {
-		return ObserveCommand(*observeThreadID, *observeType)
+		return ObserveCommand(*observeThreadID, *observeType, *observeSince)
+	}
+
+	// ================================
+
+	// queue
+	queueCmd := appCmd.Command("queue", `Queues hold block messages, block downloads, queued block posts, cafe requests, and cafe inbox messages
+until they are processed. Use these commands to inspect or flush them.`).Alias("queues")
+
+	// queue list
+	queueListCmd := queueCmd.Command("list", "Reports counts by status and peer, oldest item age, and last error for each queue").Alias("ls").Default()
+	cmds[queueListCmd.FullCommand()] = func() error {
+		return QueueList()
+	}
+
+	// queue get
+	queueGetCmd := queueCmd.Command("get", "Reports counts by status and peer, oldest item age, and last error for a single queue")
+	queueGetName := queueGetCmd.Arg("queue", "Queue name: block_messages, block_downloads, block_posts, cafe_requests, or cafe_inbox").Required().String()
+	cmds[queueGetCmd.FullCommand()] = func() error {
+		return QueueGet(*queueGetName)
+	}
+
+	// queue flush
+	queueFlushCmd := queueCmd.Command("flush", "Processes a single queue now")
+	queueFlushName := queueFlushCmd.Arg("queue", "Queue name: block_messages, block_downloads, block_posts, cafe_requests, or cafe_inbox").Required().String()
+	cmds[queueFlushCmd.FullCommand()] = func() error {
+		return QueueFlush(*queueFlushName)
 	}
 
 	// ================================
@@ -865,6 +1002,54 @@ shared      --> initiator: Y, whitelist: Y`).Alias("threads")
 		return ThreadAbandon(*threadAbandonThreadID)
 	}
 
+	// thread export
+	threadExportCmd := threadCmd.Command("export", `Exports a thread to a CAR archive containing the thread secret key, schema, blocks, and locally available file data.
+The archive is not encrypted, treat it as top secret.`)
+	threadExportThreadID := threadExportCmd.Arg("thread", "Thread ID").Required().String()
+	threadExportOut := threadExportCmd.Flag("out", "Path of the archive to write, omit for stdout").Short('o').String()
+	cmds[threadExportCmd.FullCommand()] = func() error {
+		return ThreadExport(*threadExportThreadID, *threadExportOut)
+	}
+
+	// thread import
+	threadImportCmd := threadCmd.Command("import", "Imports a thread from a CAR archive created by export. Existing threads are merged with the archive.")
+	threadImportPath := threadImportCmd.Arg("path", "Path of the archive to import").Required().String()
+	cmds[threadImportCmd.FullCommand()] = func() error {
+		return ThreadImport(*threadImportPath)
+	}
+
+	// thread fork
+	threadForkCmd := threadCmd.Command("fork", "Adds and joins a new thread seeded with copies of the files and messages of an existing thread")
+	threadForkThreadID := threadForkCmd.Arg("thread", "Thread ID").Required().String()
+	threadForkName := threadForkCmd.Arg("name", "The name to use for the new thread").Required().String()
+	threadForkKey := threadForkCmd.Flag("key", "A locally unique key used by an app to identify this thread on recovery").Short('k').String()
+	threadForkType := threadForkCmd.Flag("type", "Set the thread type to one of: private, read_only, public, open").Short('t').Default("private").String()
+	threadForkSharing := threadForkCmd.Flag("sharing", "Set the thread sharing style to one of: not_shared, invite_only, shared").Short('s').Default("not_shared").String()
+	threadForkWhitelist := threadForkCmd.Flag("whitelist", "A contact address. When supplied, the thread will not allow additional peers. Can be used multiple times to include multiple contacts").Short('w').Strings()
+	threadForkHead := threadForkCmd.Flag("head", "The block to fork from, omit for the current head").String()
+	threadForkBlocks := threadForkCmd.Flag("block", "A file or message block ID to copy, omit for all. Can be used multiple times to include multiple blocks").Short('b').Strings()
+	cmds[threadForkCmd.FullCommand()] = func() error {
+		return ThreadFork(*threadForkThreadID, *threadForkName, *threadForkKey, *threadForkType, *threadForkSharing, *threadForkWhitelist, *threadForkHead, *threadForkBlocks)
+	}
+
+	// thread verify
+	threadVerifyCmd := threadCmd.Command("verify", "Walks a thread's history, checking block availability, decryption, indexes, and pin state")
+	threadVerifyThreadID := threadVerifyCmd.Arg("thread", "Thread ID").Required().String()
+	threadVerifyRepair := threadVerifyCmd.Flag("repair", "Re-queue missing blocks for download and re-pin missing nodes").Short('r').Bool()
+	cmds[threadVerifyCmd.FullCommand()] = func() error {
+		return ThreadVerify(*threadVerifyThreadID, *threadVerifyRepair)
+	}
+
+	// thread graph
+	threadGraphCmd := threadCmd.Command("graph", "Draws a page of a thread's block graph as JSON or SVG, without requiring GraphViz")
+	threadGraphThreadID := threadGraphCmd.Arg("thread", "Thread ID").Required().String()
+	threadGraphOffset := threadGraphCmd.Flag("offset", "Offset ID to start listing from").Short('o').String()
+	threadGraphLimit := threadGraphCmd.Flag("limit", "List page size").Short('l').Default("100").Int()
+	threadGraphFormat := threadGraphCmd.Flag("format", "Output format, one of: json, svg").Short('f').Default("json").String()
+	cmds[threadGraphCmd.FullCommand()] = func() error {
+		return ThreadGraph(*threadGraphThreadID, *threadGraphOffset, *threadGraphLimit, *threadGraphFormat)
+	}
+
 	// thread snapshot
 	// A snapshot is an encrypted object containing thread metadata and the latest block hash, which is enough to recover the thread.
 	threadSnapshotCmd := threadCmd.Command("snapshot", "Manage thread snapshots").Alias("snapshots")
@@ -933,6 +1118,49 @@ The response contains a base58 encoded version of the random bytes token.`).Alia
 		return TokenRemove(*tokenDeleteToken)
 	}
 
+	// token disable
+	tokenDisableCmd := tokenCmd.Command("disable", "Disables an existing cafe token for new registrations (existing sessions remain valid)")
+	tokenDisableToken := tokenDisableCmd.Arg("token", "The token to disable").Required().String()
+	cmds[tokenDisableCmd.FullCommand()] = func() error {
+		return TokenDisable(*tokenDisableToken)
+	}
+
+	// token enable
+	tokenEnableCmd := tokenCmd.Command("enable", "Enables a disabled cafe token")
+	tokenEnableToken := tokenEnableCmd.Arg("token", "The token to enable").Required().String()
+	cmds[tokenEnableCmd.FullCommand()] = func() error {
+		return TokenEnable(*tokenEnableToken)
+	}
+
+	// ================================
+
+	// apikeys
+	apiKeysCmd := appCmd.Command("apikeys", "API keys grant scoped access to the local API").Alias("apikey")
+
+	// apikeys create
+	apiKeysCreateCmd := apiKeysCmd.Command("create", `Creates an API key w/ the given scopes and saves a bcrypt hashed version for future lookup.
+The response contains the key, which is not shown again. Use it w/ an 'Authorization: Bearer <key>' header.`).Alias("add")
+	apiKeysCreateName := apiKeysCreateCmd.Flag("name", "A name to identify the key").Short('n').String()
+	apiKeysCreateScopes := apiKeysCreateCmd.Flag("scope", "A scope: read, thread_write, admin, or cafe").Short('s').Default("read").Enums("read", "thread_write", "admin", "cafe")
+	apiKeysCreateThreads := apiKeysCreateCmd.Flag("thread", "A thread ID writable w/ the thread_write scope").Short('t').Strings()
+	apiKeysCreateExpires := apiKeysCreateCmd.Flag("expires", "Duration after which the key expires, e.g., 720h (never if omitted)").Short('e').String()
+	cmds[apiKeysCreateCmd.FullCommand()] = func() error {
+		return ApiKeyCreate(*apiKeysCreateName, *apiKeysCreateScopes, *apiKeysCreateThreads, *apiKeysCreateExpires)
+	}
+
+	// apikeys list
+	apiKeysListCmd := apiKeysCmd.Command("list", "List info about all API keys").Alias("ls").Default()
+	cmds[apiKeysListCmd.FullCommand()] = func() error {
+		return ApiKeyList()
+	}
+
+	// apikeys revoke
+	apiKeysRevokeCmd := apiKeysCmd.Command("revoke", "Revokes an API key").Alias("rm")
+	apiKeysRevokeID := apiKeysRevokeCmd.Arg("id", "The ID of the key to revoke").Required().String()
+	cmds[apiKeysRevokeCmd.FullCommand()] = func() error {
+		return ApiKeyRevoke(*apiKeysRevokeID)
+	}
+
 	// ================================
 
 	// version
@@ -1118,7 +1346,11 @@ func request(meth method, pth string, pars params) (*http.Response, func(), erro
 		req.Header.Set("Content-Type", pars.ctype)
 	}
 
-	req.SetBasicAuth(*appUsername, *appPassword)
+	if *appApiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+*appApiKey)
+	} else {
+		req.SetBasicAuth(*appUsername, *appPassword)
+	}
 
 	tr := &http.Transport{}
 	client := &http.Client{Transport: tr}
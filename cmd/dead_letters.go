@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"net/http"
+	"strconv"
+)
+
+func DeadLetterList(queue string, offset string, limit int) error {
+	opts := map[string]string{
+		"queue":  queue,
+		"offset": offset,
+		"limit":  strconv.Itoa(limit),
+	}
+
+	res, err := executeJsonCmd(http.MethodGet, "deadletters", params{opts: opts}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func DeadLetterGet(id string) error {
+	res, err := executeJsonCmd(http.MethodGet, "deadletters/"+id, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func DeadLetterRetry(id string, queue string) error {
+	pth := "deadletters"
+	if id != "" {
+		pth += "/" + id
+	}
+
+	res, err := executeStringCmd(http.MethodPost, pth, params{
+		opts: map[string]string{"queue": queue},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func DeadLetterPurge(id string, queue string) error {
+	pth := "deadletters"
+	if id != "" {
+		pth += "/" + id
+	}
+
+	res, err := executeStringCmd(http.MethodDelete, pth, params{
+		opts: map[string]string{"queue": queue},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -10,7 +11,6 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
-	"sync"
 	"time"
 
 	ipfspath "github.com/ipfs/go-path"
@@ -18,6 +18,7 @@ import (
 	"github.com/textileio/go-textile/core"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/schema"
+	"github.com/textileio/go-textile/util"
 )
 
 var errNothingToAdd = fmt.Errorf("nothing to add")
@@ -25,8 +26,6 @@ var errNothingToAdd = fmt.Errorf("nothing to add")
 // ------------------------------------
 // > files
 
-const batchSize = 10
-
 type millOpts struct {
 	val map[string]string
 }
@@ -91,7 +90,6 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 	}
 
 	var pths []string
-	var dirs []*pb.Directory
 	var count int
 
 	start := time.Now()
@@ -114,53 +112,9 @@ func FileAdd(path string, threadID string, caption string, group bool, verbose b
 		}
 		output(msg)
 
-		batches := batchPaths(pths, batchSize)
-		for i, batch := range batches {
-
-			ready := make(chan *pb.Directory, batchSize)
-			go millBatch(batch, thrd.SchemaNode, ready, verbose)
-
-			var cerr error
-		loop:
-			for {
-				select {
-				case dir, ok := <-ready:
-					if !ok {
-						break loop
-					}
-
-					if group == false {
-						files, err := add(
-							[]*pb.Directory{dir},
-							threadID,
-							strings.TrimSpace(fmt.Sprintf("%s (%d)", caption, count+1)),
-							verbose)
-						if err != nil {
-							cerr = err
-							break loop
-						}
-
-						output(fmt.Sprintf("File %d data=%s block=%s", count+1, files.Data, files.Block))
-					} else {
-						dirs = append(dirs, dir)
-					}
-
-					count++
-				}
-			}
-			if cerr != nil {
-				return cerr
-			}
-
-			output(fmt.Sprintf("Milled batch %d/%d", i+1, len(batches)))
-		}
-
-		if group && len(dirs) > 0 {
-			files, err := add(dirs, threadID, caption, verbose)
-			if err != nil {
-				return err
-			}
-			output(fmt.Sprintf("Group data=%s block=%s", files.Data, files.Block))
+		count, err = addBatch(pths, threadID, caption, group, verbose)
+		if err != nil {
+			return err
 		}
 
 		if count == 0 {
@@ -217,6 +171,100 @@ func add(dirs []*pb.Directory, threadID string, caption string, verbose bool) (*
 	return files, nil
 }
 
+// addBatch streams files to the batch add endpoint, which mills them concurrently,
+// and returns the number of milled files
+func addBatch(pths []string, threadID string, caption string, group bool, verbose bool) (int, error) {
+	reader, writer := io.Pipe()
+	form := multipart.NewWriter(writer)
+	go func() {
+		for i, pth := range pths {
+			// files are grouped by form field name
+			field := "file"
+			if !group {
+				field = fmt.Sprintf("file%d", i)
+			}
+			if err := writeFilePart(form, field, pth); err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+		}
+		_ = writer.CloseWithError(form.Close())
+	}()
+
+	res, cancel, err := request(http.MethodPost, "threads/"+threadID+"/files/batch", params{
+		opts:    map[string]string{"caption": caption},
+		payload: reader,
+		ctype:   form.FormDataContentType(),
+	})
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	defer cancel()
+
+	if res.StatusCode >= 400 {
+		body, err := util.UnmarshalString(res.Body)
+		if err != nil {
+			return 0, err
+		}
+		return 0, fmt.Errorf(body)
+	}
+
+	var count, added int
+	decoder := json.NewDecoder(res.Body)
+	for decoder.More() {
+		var update pb.FileBatchUpdate
+		if err := pbUnmarshaler.UnmarshalNext(decoder, &update); err == io.EOF {
+			break
+		} else if err != nil {
+			return count, err
+		}
+
+		switch update.Status {
+		case pb.FileBatchUpdate_MILLED:
+			count++
+			if verbose {
+				out, err := pbMarshaler.MarshalToString(update.Dir)
+				if err != nil {
+					return count, err
+				}
+				output(out)
+			}
+		case pb.FileBatchUpdate_SKIPPED:
+			if verbose {
+				output("Skipped " + update.Name)
+			}
+		case pb.FileBatchUpdate_ERROR:
+			output("mill error: " + update.Error)
+		case pb.FileBatchUpdate_ADDED:
+			added++
+			if group {
+				output(fmt.Sprintf("Group data=%s block=%s", update.Files.Data, update.Files.Block))
+			} else {
+				output(fmt.Sprintf("File %d data=%s block=%s", added, update.Files.Data, update.Files.Block))
+			}
+		}
+	}
+
+	return count, nil
+}
+
+// writeFilePart copies a file into a multipart form
+func writeFilePart(form *multipart.Writer, field string, pth string) error {
+	f, err := os.Open(pth)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	part, err := form.CreateFormFile(field, filepath.Base(pth))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(part, f)
+	return err
+}
+
 func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 	ref, err := ipfspath.ParsePath(pth)
 	if err == nil {
@@ -352,44 +400,6 @@ func mill(pth string, node *pb.Node, verbose bool) (*pb.Directory, error) {
 	return dir, nil
 }
 
-func millBatch(pths []string, node *pb.Node, ready chan *pb.Directory, verbose bool) {
-	wg := sync.WaitGroup{}
-
-	for _, pth := range pths {
-		wg.Add(1)
-
-		go func(p string) {
-			dir, err := mill(p, node, verbose)
-			if err != nil {
-				output("mill error: " + err.Error())
-			} else {
-				ready <- dir
-			}
-			wg.Done()
-		}(pth)
-
-	}
-
-	wg.Wait()
-	close(ready)
-}
-
-func batchPaths(pths []string, size int) [][]string {
-	var batches [][]string
-
-	for i := 0; i < len(pths); i += size {
-		end := i + size
-
-		if end > len(pths) {
-			end = len(pths)
-		}
-
-		batches = append(batches, pths[i:end])
-	}
-
-	return batches
-}
-
 func handleStep(mil string, reader io.Reader, opts millOpts, ctype string) (string, *pb.FileIndex, error) {
 	var file pb.FileIndex
 
@@ -422,12 +432,13 @@ func multipartReader(f *os.File) (io.ReadSeeker, string, error) {
 // ------------------------------------
 // > file list thread
 
-func FileListThread(threadID string, offset string, limit int) error {
+func FileListThread(threadID string, offset string, limit int, sort string) error {
 	var list pb.FilesList
 	res, err := executeJsonPbCmd(http.MethodGet, "files", params{opts: map[string]string{
 		"thread": threadID,
 		"offset": offset,
 		"limit":  strconv.Itoa(limit),
+		"sort":   sort,
 	}}, &list)
 	if err != nil {
 		return err
@@ -444,7 +455,7 @@ func FileListThread(threadID string, offset string, limit int) error {
 		return err
 	}
 
-	return FileListThread(threadID, list.Items[len(list.Items)-1].Block, limit)
+	return FileListThread(threadID, list.Items[len(list.Items)-1].Block, limit, sort)
 }
 
 // ------------------------------------
@@ -507,6 +518,25 @@ func FileGetBlock(blockID string, index int, path string, content bool) error {
 	return nil
 }
 
+// ------------------------------------
+// > file share
+
+func FileShareBlock(blockID string, index int, path string, expires string) error {
+	if path == "" {
+		path = "."
+	}
+	urlPath := "blocks/" + blockID + "/files/" + strconv.Itoa(index) + "/" + strings.Trim(path, "/") + "/share"
+
+	res, err := executeStringCmd(http.MethodPost, urlPath, params{
+		opts: map[string]string{"expires": expires},
+	})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 // ------------------------------------
 // > file get
 
@@ -28,12 +28,13 @@ func addMessage(threadID string, body string) (string, error) {
 	return res, nil
 }
 
-func MessageList(threadID string, offset string, limit int) error {
+func MessageList(threadID string, offset string, limit int, sort string) error {
 	var list pb.TextList
 	opts := map[string]string{
 		"thread": threadID,
 		"offset": offset,
 		"limit":  strconv.Itoa(limit),
+		"sort":   sort,
 	}
 	res, err := executeJsonPbCmd(http.MethodGet, "messages", params{opts: opts}, &list)
 	if err != nil {
@@ -51,7 +52,7 @@ func MessageList(threadID string, offset string, limit int) error {
 		return err
 	}
 
-	return MessageList(threadID, list.Items[len(list.Items)-1].Block, limit)
+	return MessageList(threadID, list.Items[len(list.Items)-1].Block, limit, sort)
 }
 
 func MessageGet(blockID string) error {
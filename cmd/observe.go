@@ -10,8 +10,8 @@ import (
 	"github.com/textileio/go-textile/util"
 )
 
-func ObserveCommand(threadID string, types []string) error {
-	updates, err := Observe(threadID, types)
+func ObserveCommand(threadID string, types []string, since string) error {
+	updates, err := Observe(threadID, types, since)
 	if err != nil {
 		return err
 	}
@@ -34,7 +34,7 @@ func ObserveCommand(threadID string, types []string) error {
 	}
 }
 
-func Observe(threadID string, types []string) (<-chan *pb.FeedItem, error) {
+func Observe(threadID string, types []string, since string) (<-chan *pb.FeedItem, error) {
 	if threadID != "" {
 		threadID = "/" + threadID
 	}
@@ -44,7 +44,10 @@ func Observe(threadID string, types []string) (<-chan *pb.FeedItem, error) {
 		defer close(updates)
 
 		res, cancel, err := request(http.MethodGet, "observe"+threadID, params{
-			opts: map[string]string{"type": strings.Join(types, "|")},
+			opts: map[string]string{
+				"type":  strings.Join(types, "|"),
+				"since": since,
+			},
 		})
 		if err != nil {
 			output(err.Error())
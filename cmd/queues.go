@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"net/http"
+)
+
+func QueueList() error {
+	res, err := executeJsonCmd(http.MethodGet, "queues", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func QueueGet(queue string) error {
+	res, err := executeJsonCmd(http.MethodGet, "queues/"+queue, params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func QueueFlush(queue string) error {
+	res, err := executeJsonCmd(http.MethodPost, "queues/"+queue+"/flush", params{}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -10,8 +12,10 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/mitchellh/go-homedir"
+	"github.com/textileio/go-textile/core"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/schema/textile"
+	"github.com/textileio/go-textile/util"
 )
 
 func ThreadAdd(name string, key string, tipe string, sharing string, whitelist []string, schema string, schemaFile string, blob bool, cameraRoll bool, media bool) error {
@@ -115,6 +119,110 @@ func ThreadAbandon(threadID string) error {
 	return nil
 }
 
+func ThreadExport(threadID string, out string) error {
+	if out == "" {
+		return executeBlobCmd(http.MethodGet, "threads/"+threadID+"/export", params{})
+	}
+
+	res, _, err := request(http.MethodGet, "threads/"+threadID+"/export", params{})
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		body, err := util.UnmarshalString(res.Body)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf(body)
+	}
+
+	file, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if _, err := io.Copy(file, res.Body); err != nil {
+		return err
+	}
+
+	output("exported " + threadID + " to " + out)
+	return nil
+}
+
+func ThreadImport(path string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	archive, err := core.ReadThreadArchive(bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+archive.Thread.Id+"/import", params{
+		payload: bytes.NewReader(data),
+		ctype:   "application/vnd.ipld.car",
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadFork(threadID string, name string, key string, tipe string, sharing string, whitelist []string, head string, blocks []string) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/fork", params{
+		args: []string{name},
+		opts: map[string]string{
+			"key":       key,
+			"type":      tipe,
+			"sharing":   sharing,
+			"whitelist": strings.Join(whitelist, ","),
+			"head":      head,
+			"blocks":    strings.Join(blocks, ","),
+		},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadVerify(threadID string, repair bool) error {
+	res, err := executeJsonCmd(http.MethodPost, "threads/"+threadID+"/verify", params{
+		opts: map[string]string{"repair": strconv.FormatBool(repair)},
+	}, nil)
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func ThreadGraph(threadID string, offset string, limit int, format string) error {
+	opts := map[string]string{
+		"offset": offset,
+		"limit":  strconv.Itoa(limit),
+		"format": format,
+	}
+
+	var res string
+	var err error
+	if format == "svg" {
+		res, err = executeStringCmd(http.MethodGet, "threads/"+threadID+"/graph", params{opts: opts})
+	} else {
+		res, err = executeJsonCmd(http.MethodGet, "threads/"+threadID+"/graph", params{opts: opts}, nil)
+	}
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
 func ThreadSnapshotCreate() error {
 	res, err := createThreadSnapshot()
 	if err != nil {
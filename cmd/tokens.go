@@ -45,3 +45,21 @@ func TokenRemove(token string) error {
 	output(res)
 	return nil
 }
+
+func TokenDisable(token string) error {
+	res, err := executeStringCmd(http.MethodPost, "tokens/"+token+"/disable", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
+
+func TokenEnable(token string) error {
+	res, err := executeStringCmd(http.MethodPost, "tokens/"+token+"/enable", params{})
+	if err != nil {
+		return err
+	}
+	output(res)
+	return nil
+}
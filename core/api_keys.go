@@ -0,0 +1,171 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/mr-tron/base58/base58"
+	"github.com/textileio/go-textile/crypto"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// ErrApiKeyNotFound indicates an api key is not stored by this peer
+var ErrApiKeyNotFound = fmt.Errorf("api key not found")
+
+// ErrInvalidApiKey indicates an api key is malformed or does not match a stored key
+var ErrInvalidApiKey = fmt.Errorf("invalid api key")
+
+// ErrApiKeyExpired indicates an api key is past its expiry date
+var ErrApiKeyExpired = fmt.Errorf("api key expired")
+
+// ErrApiKeyRevoked indicates an api key has been revoked
+var ErrApiKeyRevoked = fmt.Errorf("api key revoked")
+
+// CreateApiKey creates a random api key w/ the given scopes, stores a bcrypt hashed version,
+// and returns the key w/ its base58 encoded plaintext, which is not available again.
+// THREAD_WRITE keys are limited to threads. A zero expires means the key does not expire.
+func (t *Textile) CreateApiKey(name string, scopes []pb.ApiKey_Scope, threads []string, expires time.Duration) (*pb.ApiKey, error) {
+	if len(scopes) == 0 {
+		scopes = []pb.ApiKey_Scope{pb.ApiKey_READ}
+	}
+	for _, scope := range scopes {
+		if _, ok := pb.ApiKey_Scope_name[int32(scope)]; !ok {
+			return nil, fmt.Errorf("invalid scope: %d", scope)
+		}
+		if scope == pb.ApiKey_THREAD_WRITE && len(threads) == 0 {
+			return nil, fmt.Errorf("thread write scope requires at least one thread")
+		}
+	}
+	for _, id := range threads {
+		if t.Thread(id) == nil {
+			return nil, ErrThreadNotFound
+		}
+	}
+
+	// keys are base58(id+secret) like cafe tokens
+	raw, err := crypto.GenerateAESKey()
+	if err != nil {
+		return nil, err
+	}
+	safe, err := bcrypt.GenerateFromPassword(raw[12:], bcrypt.DefaultCost)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &pb.ApiKey{
+		Id:      hex.EncodeToString(raw[:12]),
+		Value:   safe,
+		Name:    name,
+		Scopes:  scopes,
+		Threads: threads,
+		Created: ptypes.TimestampNow(),
+	}
+	if expires > 0 {
+		key.Expires = util.ProtoTs(time.Now().Add(expires).UnixNano())
+	}
+	err = t.datastore.ApiKeys().Add(key)
+	if err != nil {
+		return nil, err
+	}
+
+	key.Value = nil
+	key.Key = base58.FastBase58Encoding(raw)
+	return key, nil
+}
+
+// ApiKeys lists all api keys without their hashed secrets
+func (t *Textile) ApiKeys() *pb.ApiKeyList {
+	list := &pb.ApiKeyList{Items: make([]*pb.ApiKey, 0)}
+	for _, key := range t.datastore.ApiKeys().List() {
+		key := key
+		key.Value = nil
+		list.Items = append(list.Items, &key)
+	}
+	return list
+}
+
+// RevokeApiKey stops an api key from being used
+func (t *Textile) RevokeApiKey(id string) error {
+	key := t.datastore.ApiKeys().Get(id)
+	if key == nil {
+		return ErrApiKeyNotFound
+	}
+	if key.Revoked != nil {
+		return nil
+	}
+	return t.datastore.ApiKeys().UpdateRevoked(id, time.Now())
+}
+
+// ValidateApiKey returns the stored key matching a base58 encoded api key.
+// Verified secrets are cached so that bcrypt only runs once per key, but revocation
+// and expiry are always checked against the stored key.
+func (t *Textile) ValidateApiKey(str string) (*pb.ApiKey, error) {
+	raw, err := base58.FastBase58Decoding(str)
+	if err != nil || len(raw) != 44 {
+		return nil, ErrInvalidApiKey
+	}
+
+	id := hex.EncodeToString(raw[:12])
+	key := t.datastore.ApiKeys().Get(id)
+	if key == nil {
+		return nil, ErrInvalidApiKey
+	}
+
+	sum := sha256.Sum256(raw)
+	digest := hex.EncodeToString(sum[:])
+	t.apiKeysLock.Lock()
+	cached := t.apiKeys[id] == digest
+	t.apiKeysLock.Unlock()
+	if !cached {
+		if err := bcrypt.CompareHashAndPassword(key.Value, raw[12:]); err != nil {
+			return nil, ErrInvalidApiKey
+		}
+		t.apiKeysLock.Lock()
+		t.apiKeys[id] = digest
+		t.apiKeysLock.Unlock()
+	}
+
+	if key.Revoked != nil {
+		return nil, ErrApiKeyRevoked
+	}
+	if key.Expires != nil && util.ProtoTime(key.Expires).Before(time.Now()) {
+		return nil, ErrApiKeyExpired
+	}
+	key.Value = nil
+	return key, nil
+}
+
+// ApiKeyAllows returns whether or not a key grants scope.
+// ADMIN grants everything. THREAD_WRITE is limited to the key's threads.
+func ApiKeyAllows(key *pb.ApiKey, scope pb.ApiKey_Scope, threadId string) bool {
+	for _, s := range key.Scopes {
+		if s == pb.ApiKey_ADMIN {
+			return true
+		}
+		if s != scope {
+			continue
+		}
+		if scope != pb.ApiKey_THREAD_WRITE {
+			return true
+		}
+		for _, id := range key.Threads {
+			if id == threadId {
+				return true
+			}
+		}
+	}
+	// thread write implies read
+	if scope == pb.ApiKey_READ {
+		for _, s := range key.Scopes {
+			if s == pb.ApiKey_THREAD_WRITE {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,357 @@
+package core
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/golang/protobuf/proto"
+	blocks "github.com/ipfs/go-block-format"
+	icid "github.com/ipfs/go-cid"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// ErrInvalidThreadArchive indicates an archive is missing its thread model
+var ErrInvalidThreadArchive = fmt.Errorf("invalid thread archive")
+
+// ErrThreadArchiveTooLarge indicates an archive exceeds the size or block limits
+var ErrThreadArchiveTooLarge = fmt.Errorf("thread archive too large")
+
+// ThreadArchiveSizeLimit returns the max number of bytes read from a thread archive
+func ThreadArchiveSizeLimit() int64 {
+	return threadArchiveLimits.size
+}
+
+// threadArchiveLimits bound the archives read into memory
+var threadArchiveLimits = struct {
+	size   int64
+	blocks int
+}{
+	size:   1 << 30,
+	blocks: 100000,
+}
+
+// manifestPrefix is used to address the thread model at the root of an archive
+var manifestPrefix = icid.Prefix{
+	Version:  1,
+	Codec:    icid.Raw,
+	MhType:   mh.SHA2_256,
+	MhLength: -1,
+}
+
+// ExportThread writes a thread to a CAR archive containing the thread model,
+// including its secret key, followed by the schema, block nodes, and file dags.
+// Note: The archive is not encrypted, treat it as top secret. Queued blocks are not included.
+func (t *Textile) ExportThread(id string, w io.Writer) error {
+	thread := t.Thread(id)
+	if thread == nil {
+		return ErrThreadNotFound
+	}
+
+	mod := t.datastore.Threads().Get(thread.Id)
+	if mod == nil {
+		return errThreadReload
+	}
+	manifest, err := proto.Marshal(mod)
+	if err != nil {
+		return err
+	}
+	mid, err := manifestPrefix.Sum(manifest)
+	if err != nil {
+		return err
+	}
+	mblock, err := blocks.NewBlockWithCid(manifest, mid)
+	if err != nil {
+		return err
+	}
+
+	cw, err := ipfs.NewCarWriter(w, []icid.Cid{mid})
+	if err != nil {
+		return err
+	}
+	err = cw.Put(mblock)
+	if err != nil {
+		return err
+	}
+
+	roots := util.SplitString(mod.Head, ",")
+	if mod.Schema != "" {
+		roots = append(roots, mod.Schema)
+	}
+	var missing int
+	for _, root := range roots {
+		rid, err := icid.Decode(root)
+		if err != nil {
+			return err
+		}
+		m, err := cw.PutDag(t.node, rid)
+		if err != nil {
+			return err
+		}
+		missing += m
+	}
+	if missing > 0 {
+		log.Warningf("exported thread %s is missing %d blocks", thread.Id, missing)
+	}
+
+	log.Debugf("exported thread %s", thread.Id)
+
+	return nil
+}
+
+// ThreadArchive is a thread read from a CAR archive created by ExportThread
+type ThreadArchive struct {
+	Thread *pb.Thread
+	Sk     libp2pc.PrivKey
+	Blocks []blocks.Block
+}
+
+// ReadThreadArchive reads and validates a thread archive, returning ErrThreadArchiveTooLarge
+// if it exceeds the size or block limits
+func ReadThreadArchive(r io.Reader) (*ThreadArchive, error) {
+	// read at most one byte past the limit so an oversized archive is never fully buffered
+	counter := &countingReader{r: io.LimitReader(r, threadArchiveLimits.size+1)}
+	tooLarge := func(err error) error {
+		if counter.n > threadArchiveLimits.size {
+			return ErrThreadArchiveTooLarge
+		}
+		return err
+	}
+	cr, err := ipfs.NewCarReader(counter)
+	if err != nil {
+		return nil, tooLarge(err)
+	}
+	if len(cr.Roots) != 1 {
+		return nil, ErrInvalidThreadArchive
+	}
+
+	archive := &ThreadArchive{}
+	for {
+		blk, err := cr.Next()
+		if err == io.EOF {
+			if counter.n > threadArchiveLimits.size {
+				return nil, ErrThreadArchiveTooLarge
+			}
+			break
+		} else if err != nil {
+			return nil, tooLarge(err)
+		}
+		if counter.n > threadArchiveLimits.size || len(archive.Blocks) >= threadArchiveLimits.blocks {
+			return nil, ErrThreadArchiveTooLarge
+		}
+
+		// the manifest is never added to the blockstore
+		if blk.Cid().Equals(cr.Roots[0]) {
+			archive.Thread = new(pb.Thread)
+			err = proto.Unmarshal(blk.RawData(), archive.Thread)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+		archive.Blocks = append(archive.Blocks, blk)
+	}
+	if archive.Thread == nil || len(archive.Thread.Sk) == 0 {
+		return nil, ErrInvalidThreadArchive
+	}
+
+	archive.Sk, err = ipfs.UnmarshalPrivateKey(archive.Thread.Sk)
+	if err != nil {
+		return nil, err
+	}
+	id, err := peer.IDFromPrivateKey(archive.Sk)
+	if err != nil {
+		return nil, err
+	}
+	if id.Pretty() != archive.Thread.Id {
+		return nil, ErrInvalidThreadArchive
+	}
+
+	return archive, nil
+}
+
+// ImportThread adds a thread from an archive, indexing each archived block.
+// Existing threads are merged with the archive.
+func (t *Textile) ImportThread(archive *ThreadArchive) (*Thread, error) {
+	mod := archive.Thread
+	sk := archive.Sk
+
+	err := t.node.Blocks.AddBlocks(archive.Blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	heads := util.SplitString(mod.Head, ",")
+	thread := t.Thread(mod.Id)
+	if thread == nil {
+		config := pb.AddThreadConfig{
+			Key:  mod.Key,
+			Name: mod.Name,
+			Schema: &pb.AddThreadConfig_Schema{
+				Id: mod.Schema,
+			},
+			Type:      mod.Type,
+			Sharing:   mod.Sharing,
+			Whitelist: mod.Whitelist,
+			Force:     true,
+		}
+		thread, err = t.AddThread(config, sk, mod.Initiator, false, false)
+		if err != nil {
+			return nil, err
+		}
+		if mod.ForkThread != "" {
+			err = t.datastore.Threads().UpdateFork(thread.Id, mod.ForkThread, mod.ForkHead)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		_, err = thread.followArchive(heads)
+		if err != nil {
+			return nil, err
+		}
+		err = thread.updateHead(heads, false)
+		if err != nil {
+			return nil, err
+		}
+		err = thread.reclock()
+		if err != nil {
+			return nil, err
+		}
+
+		// have we joined?
+		query := fmt.Sprintf("threadId='%s' and type=%d and authorId='%s'", thread.Id, pb.Block_JOIN, t.node.Identity.Pretty())
+		if t.datastore.Blocks().Count(query) == 0 {
+			_, err = thread.join(t.node.Identity.Pretty())
+		} else {
+			err = thread.store()
+		}
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		leaves, err := thread.followArchive(heads)
+		if err != nil {
+			return nil, err
+		}
+		err = thread.handleHead(heads, leaves)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = t.pinArchiveFiles(thread, archive)
+	if err != nil {
+		return nil, err
+	}
+
+	err = thread.sendWelcome()
+	if err != nil {
+		return nil, err
+	}
+	t.FlushCafes()
+
+	log.Debugf("imported thread %s", thread.Id)
+
+	return thread, nil
+}
+
+// pinArchiveFiles recursively pins the file dags of a thread's files blocks that were
+// included in an archive
+func (t *Textile) pinArchiveFiles(thread *Thread, archive *ThreadArchive) error {
+	included := make(map[string]icid.Cid)
+	for _, blk := range archive.Blocks {
+		included[blk.Cid().Hash().B58String()] = blk.Cid()
+	}
+
+	query := fmt.Sprintf("threadId='%s' and type=%d", thread.Id, pb.Block_FILES)
+	for _, block := range t.datastore.Blocks().List("", -1, query).Items {
+		id, ok := included[block.Data]
+		if !ok {
+			continue
+		}
+		node, err := ipfs.NodeAtCid(t.node, id)
+		if err != nil {
+			return err
+		}
+		err = ipfs.PinNode(t.node, node, true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countingReader counts the bytes read from r
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// followArchive walks a locally available thread dag, handling each block along the way
+// Note: Returns a final list of already indexed hashes that were reached during the traversal
+func (t *Thread) followArchive(parents []string) ([]string, error) {
+	var leaves []string
+	for _, parent := range parents {
+		if parent == "" {
+			continue // some old blocks may contain empty string parents
+		}
+
+		ends, err := t.followArchiveParent(parent)
+		if err != nil {
+			return nil, err
+		}
+		leaves = append(leaves, ends...)
+	}
+	return leaves, nil
+}
+
+// followArchiveParent handles a single locally available block and its parents
+func (t *Thread) followArchiveParent(parent string) ([]string, error) {
+	node, err := ipfs.NodeAtPath(t.node(), parent, ipfs.DefaultTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	bnode := &blockNode{}
+	if len(node.Links()) == 0 {
+		// older block, the node is the block
+		bnode.hash = parent
+	} else {
+		bnode, err = extractNode(t.node(), node, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// pending blocks were queued for download, but the archive has their content
+	var replace bool
+	index := t.datastore.Blocks().Get(bnode.hash)
+	if index != nil {
+		if index.Status != pb.Block_PENDING {
+			return []string{parent}, nil
+		}
+		replace = true
+	}
+
+	bnode.ciphertext, err = ipfs.DataAtPath(t.node(), bnode.hash)
+	if err != nil {
+		return nil, err
+	}
+	_, err = t.handle(bnode, replace)
+	if err != nil {
+		log.Warningf("failed to handle archived block %s: %s", bnode.hash, err)
+	}
+
+	return t.followArchive(bnode.parents)
+}
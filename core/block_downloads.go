@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/textileio/go-textile/ipfs"
@@ -12,7 +13,8 @@ import (
 // downloadsFlushGroupSize is the size of concurrently processed downloads
 const downloadsFlushGroupSize = 16
 
-// maxDownloadAttempts is the number of times a download can fail to download before being deleted
+// maxDownloadAttempts is the number of times a download can fail to download before being
+// moved to dead letters
 const maxDownloadAttempts = 5
 
 // BlockDownloads manages a queue of pending downloads
@@ -21,22 +23,26 @@ type BlockDownloads struct {
 	datastore repo.Datastore
 	getThread func(id string) *Thread
 	flushing  bool
+	debounce  *debouncer
+	lastErr   queueError
 }
 
 // NewBlockDownloads creates a new download queue
-func NewBlockDownloads(node func() *core.IpfsNode, datastore repo.Datastore, getThread func(id string) *Thread) *BlockDownloads {
-	return &BlockDownloads{
+func NewBlockDownloads(node func() *core.IpfsNode, datastore repo.Datastore, getThread func(id string) *Thread, debounce time.Duration) *BlockDownloads {
+	q := &BlockDownloads{
 		node:      node,
 		datastore: datastore,
 		getThread: getThread,
 	}
+	q.debounce = newDebouncer(debounce, q.Flush)
+	return q
 }
 
-// Add queues a download, starting it if flush is not active
+// Add queues a download, scheduling a flush
 func (q *BlockDownloads) Add(download *pb.Block) error {
 	err := q.datastore.Blocks().Add(download)
 	if err == nil {
-		go q.Flush()
+		q.debounce.trigger()
 	}
 	return err
 }
@@ -52,8 +58,7 @@ func (q *BlockDownloads) Flush() {
 	}()
 	log.Debug("flushing downloads")
 
-	query := fmt.Sprintf("status=%d", pb.Block_PENDING)
-	q.batch(q.datastore.Blocks().List("", downloadsFlushGroupSize, query).Items)
+	q.batch(q.datastore.Blocks().List("", downloadsFlushGroupSize, q.query()).Items)
 }
 
 // batch flushes a batch of downloads
@@ -76,15 +81,20 @@ func (q *BlockDownloads) batch(downloads []*pb.Block) {
 
 	// next batch
 	offset := downloads[len(downloads)-1].Id
-	query := fmt.Sprintf("status=%d", pb.Block_PENDING)
-	q.batch(q.datastore.Blocks().List(offset, downloadsFlushGroupSize, query).Items)
+	q.batch(q.datastore.Blocks().List(offset, downloadsFlushGroupSize, q.query()).Items)
+}
+
+// query matches pending downloads that are not backing off
+func (q *BlockDownloads) query() string {
+	return fmt.Sprintf("status=%d and retry<=%d", pb.Block_PENDING, time.Now().UnixNano())
 }
 
 // handle handles a single message
 func (q *BlockDownloads) handle(dl *pb.Block) error {
 	fail := func(reason string) error {
 		log.Warningf("download %s failed: %s", dl.Id, reason)
-		return q.datastore.Blocks().Delete(dl.Id)
+		q.lastErr.set(fmt.Errorf(reason))
+		return q.kill(dl, fmt.Errorf(reason))
 	}
 
 	ciphertext, err := ipfs.DataAtPath(q.node(), dl.Id)
@@ -109,16 +119,26 @@ func (q *BlockDownloads) handle(dl *pb.Block) error {
 	return nil
 }
 
-// handleErr deletes or adds an attempt to a download processing error
+// handleErr moves to dead letters or adds an attempt to a download processing error
 func (q *BlockDownloads) handleErr(herr error, dl *pb.Block) error {
+	q.lastErr.set(herr)
 	var err error
 	if dl.Attempts+1 >= maxDownloadAttempts {
-		err = q.datastore.Blocks().Delete(dl.Id)
+		err = q.kill(dl, herr)
 	} else {
-		err = q.datastore.Blocks().AddAttempt(dl.Id)
+		err = q.datastore.Blocks().AddAttempt(dl.Id, nextRetry(dl.Attempts+1))
 	}
 	if err != nil {
 		return err
 	}
 	return herr
 }
+
+// kill moves a download to dead letters
+func (q *BlockDownloads) kill(dl *pb.Block, reason error) error {
+	err := addDeadLetter(q.datastore, pb.DeadLetter_BLOCK_DOWNLOADS, dl.Id, dl, dl.Attempts+1, reason)
+	if err != nil {
+		return err
+	}
+	return q.datastore.Blocks().Delete(dl.Id)
+}
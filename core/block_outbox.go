@@ -2,11 +2,11 @@ package core
 
 import (
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/ptypes"
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/segmentio/ksuid"
-	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
 )
@@ -21,6 +21,8 @@ type BlockOutbox struct {
 	node       func() *core.IpfsNode
 	datastore  repo.Datastore
 	cafeOutbox *CafeOutbox
+	debounce   *debouncer
+	lastErr    queueError
 	lock       sync.Mutex
 }
 
@@ -29,24 +31,32 @@ func NewBlockOutbox(
 	service func() *ThreadsService,
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
-	cafeOutbox *CafeOutbox) *BlockOutbox {
-	return &BlockOutbox{
+	cafeOutbox *CafeOutbox,
+	debounce time.Duration) *BlockOutbox {
+	q := &BlockOutbox{
 		service:    service,
 		node:       node,
 		datastore:  datastore,
 		cafeOutbox: cafeOutbox,
 	}
+	q.debounce = newDebouncer(debounce, q.Flush)
+	return q
 }
 
-// Add adds an outbound message
+// Add adds an outbound message, scheduling a flush
 func (q *BlockOutbox) Add(peerId string, env *pb.Envelope) error {
 	log.Debugf("adding block message for %s", peerId)
-	return q.datastore.BlockMessages().Add(&pb.BlockMessage{
+	err := q.datastore.BlockMessages().Add(&pb.BlockMessage{
 		Id:   ksuid.New().String(),
 		Peer: peerId,
 		Env:  env,
 		Date: ptypes.TimestampNow(),
 	})
+	if err != nil {
+		return err
+	}
+	q.debounce.trigger()
+	return nil
 }
 
 // Flush processes pending messages
@@ -83,6 +93,7 @@ func (q *BlockOutbox) batch(msgs []pb.BlockMessage) {
 			for _, msg := range msgs {
 				if err := q.handle(msg); err != nil {
 					log.Warningf("error handling block message %s: %s", msg.Id, err)
+					q.lastErr.set(err)
 					continue
 				}
 				toDelete = append(toDelete, msg.Id)
@@ -111,39 +122,21 @@ func (q *BlockOutbox) batch(msgs []pb.BlockMessage) {
 
 // handle handles a single message
 func (q *BlockOutbox) handle(msg pb.BlockMessage) error {
-	online := q.service().online
-	var connected bool
-	var err error
-	if online {
-		// 1) attempt to send the message directly to the recipient
-		connected, err = ipfs.SwarmConnected(q.node(), msg.Peer)
-		if err != nil {
-			return err
-		}
-		if connected {
-			log.Debugf("sending block message direct to %s", msg.Peer)
-			err = q.service().SendMessage(nil, msg.Peer, msg.Env)
-		}
-	}
+	contact := q.datastore.Peers().Get(msg.Peer)
 
-	if !connected || err != nil {
-		// 2) attempt to reach the peer via pubsub
-		if online {
-			log.Debugf("publishing block message to %s", msg.Peer)
-			err = q.service().SendPubSubMessage(msg)
-		}
+	// 1) attempt live delivery directly, via a relay, or via pubsub
+	if q.service().online && q.deliver(msg, contact) {
+		return nil
+	}
 
-		// 3) add offline inbox requests
-		if !online || err != nil {
-			contact := q.datastore.Peers().Get(msg.Peer)
-			if contact != nil && len(contact.Inboxes) > 0 {
-				log.Debugf("sending block message for %s to %s", msg.Peer, contact.Inboxes)
-				err = q.cafeOutbox.AddForInbox(msg.Peer, msg.Env, contact.Inboxes)
-				if err != nil {
-					return err
-				}
-			}
+	// 2) add offline inbox requests
+	if contact != nil && len(contact.Inboxes) > 0 {
+		log.Debugf("sending block message for %s to %s", msg.Peer, contact.Inboxes)
+		err := q.cafeOutbox.AddForInbox(msg.Peer, msg.Env, contact.Inboxes)
+		if err != nil {
+			return err
 		}
+		q.addReachability(msg.Peer, pb.PeerReachability_INBOX, true)
 	}
 	return nil
 }
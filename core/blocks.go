@@ -11,9 +11,14 @@ var ErrBlockNotFound = fmt.Errorf("block not found")
 
 // GetBlocks paginates blocks
 func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
+	return t.SortedBlocks(offset, limit, query, pb.Block_DATE)
+}
+
+// SortedBlocks paginates blocks in the given order
+func (t *Textile) SortedBlocks(offset string, limit int, query string, sort pb.Block_BlockSort) *pb.BlockList {
 	filtered := &pb.BlockList{Items: make([]*pb.Block, 0)}
 
-	for _, block := range t.datastore.Blocks().List(offset, limit, query).Items {
+	for _, block := range t.listBlocks(offset, limit, query, sort).Items {
 		q := fmt.Sprintf("target='%s' and type=%d", block.Id, pb.Block_IGNORE)
 		ignored := t.datastore.Blocks().List("", -1, q)
 		if len(ignored.Items) == 0 {
@@ -24,6 +29,14 @@ func (t *Textile) Blocks(offset string, limit int, query string) *pb.BlockList {
 	return filtered
 }
 
+// listBlocks paginates the block index in the given order
+func (t *Textile) listBlocks(offset string, limit int, query string, sort pb.Block_BlockSort) *pb.BlockList {
+	if sort == pb.Block_CAUSAL {
+		return t.datastore.Blocks().ListCausal(offset, limit, query)
+	}
+	return t.datastore.Blocks().List(offset, limit, query)
+}
+
 // Block returns block with id
 func (t *Textile) Block(id string) (*pb.Block, error) {
 	block := t.datastore.Blocks().Get(id)
@@ -15,6 +15,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/mr-tron/base58/base58"
 	"github.com/textileio/go-textile/jwt"
+	"github.com/textileio/go-textile/metrics"
 	"github.com/textileio/go-textile/pb"
 	"golang.org/x/crypto/bcrypt"
 )
@@ -79,6 +80,14 @@ func (c *cafeApi) start() {
 	})
 
 	conf := c.node.Config()
+	if conf.Cafe.Host.Metrics {
+		// this route is public, so client ids are left out
+		router.GET("/metrics", func(g *gin.Context) {
+			g.Header("Content-Type", metrics.ContentType)
+			g.Status(http.StatusOK)
+			c.node.writeMetrics(g.Writer, false)
+		})
+	}
 	if conf.Cafe.Host.SizeLimit > 0 {
 		router.Use(limit.RequestSizeLimiter(conf.Cafe.Host.SizeLimit))
 	}
@@ -207,6 +216,11 @@ func (c *cafeApi) validateToken(g *gin.Context) {
 		}
 		return
 	}
+	if c.node.cafe.revoked(claims) {
+		log.Warning("revoked token")
+		c.abort(g, http.StatusUnauthorized, nil)
+		return
+	}
 
 	g.Set("from", claims.Subject)
 	g.Set("token", token)
@@ -237,6 +251,11 @@ func (c *cafeApi) validateChallengeToken(g *gin.Context) {
 		c.abort(g, http.StatusForbidden, nil)
 		return
 	}
+	if encodedToken.Disabled {
+		log.Warning("token disabled")
+		c.abort(g, http.StatusForbidden, nil)
+		return
+	}
 
 	err = bcrypt.CompareHashAndPassword(encodedToken.Value, plainBytes[12:])
 	if err != nil {
@@ -250,7 +269,7 @@ func (c *cafeApi) validateChallengeToken(g *gin.Context) {
 
 // verifyKeyFunc returns the correct key for token verification
 func (c *cafeApi) verifyKeyFunc(token *njwt.Token) (interface{}, error) {
-	return c.node.Ipfs().PrivateKey.GetPublic(), nil
+	return c.node.cafe.verifyKeyFunc(token)
 }
 
 // CafeError represents a cafe request error
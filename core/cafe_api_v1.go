@@ -258,6 +258,14 @@ func (c *cafeApi) deleteSession(g *gin.Context) {
 		return
 	}
 
+	c.node.cafe.releaseObjects(pid)
+	err = c.node.datastore.CafeClientUsage().Delete(pid)
+	if err != nil {
+		log.Warning(err)
+		c.abort(g, http.StatusInternalServerError, err)
+		return
+	}
+
 	err = c.node.datastore.CafeClients().Delete(pid)
 	if err != nil {
 		log.Warning(err)
@@ -286,7 +294,15 @@ func (c *cafeApi) store(g *gin.Context) {
 			f.Close()
 		}
 	}()
+	from := g.GetString("from")
 	for _, file := range files {
+		err = c.node.cafe.checkQuota(from, file.Size, 1)
+		if err != nil {
+			log.Warning(err)
+			c.abort(g, errQuotaExceededCode, err)
+			return
+		}
+
 		f, err = file.Open()
 		if err != nil {
 			log.Warning(err)
@@ -305,6 +321,13 @@ func (c *cafeApi) store(g *gin.Context) {
 			return
 		}
 
+		c.node.cafe.addObject(from, *aid, file.Size)
+		c.node.cafe.replicate(&pb.CafeReplicate{
+			Type:   pb.CafeReplicate_STORE,
+			Client: from,
+			Cids:   []string{aid.Hash().B58String()},
+		})
+
 		log.Debugf("stored %s", aid.Hash().B58String())
 
 		f.Close()
@@ -329,15 +352,22 @@ func (c *cafeApi) unstore(g *gin.Context) {
 		return
 	}
 
+	from := g.GetString("from")
 	for _, p := range pinned {
 		if p.Mode != pin.NotPinned {
-			err = ipfs.UnpinCid(c.node.Ipfs(), p.Key, true)
+			err = c.node.cafe.releaseObject(from, p.Key)
 			if err != nil {
 				log.Warning(err)
 				c.abort(g, http.StatusBadRequest, err)
 				return
 			}
 
+			c.node.cafe.replicate(&pb.CafeReplicate{
+				Type:   pb.CafeReplicate_UNSTORE,
+				Client: from,
+				Cids:   []string{p.Key.Hash().B58String()},
+			})
+
 			log.Debugf("unstored %s", p.Key.Hash().B58String())
 		}
 	}
@@ -381,6 +411,13 @@ func (c *cafeApi) storeThread(g *gin.Context) {
 		return
 	}
 
+	c.node.cafe.replicate(&pb.CafeReplicate{
+		Type:       pb.CafeReplicate_STORE_THREAD,
+		Client:     client.Id,
+		Thread:     id,
+		Ciphertext: buf.Bytes(),
+	})
+
 	log.Debugf("stored thread %s", id)
 
 	g.Status(http.StatusNoContent)
@@ -404,6 +441,12 @@ func (c *cafeApi) unstoreThread(g *gin.Context) {
 		return
 	}
 
+	c.node.cafe.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_UNSTORE_THREAD,
+		Client: client.Id,
+		Thread: id,
+	})
+
 	log.Debugf("unstored thread %s", id)
 
 	g.Status(http.StatusNoContent)
@@ -551,12 +594,24 @@ func (c *cafeApi) deliverMessage(g *gin.Context) {
 		return
 	}
 
+	c.node.cafe.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_MESSAGE,
+		Client: client.Id,
+		Message: &pb.CafeDeliverMessage{
+			Id:     msgId,
+			Client: client.Id,
+			Env:    body,
+		},
+		From: from,
+	})
+
 	go func() {
-		err = c.node.cafe.notifyClient(client.Id)
+		err := c.node.cafe.notifyClient(client.Id)
 		if err != nil {
 			log.Debugf("unable to notify client: %s", client.Id)
 		}
 	}()
+	c.node.cafe.queueWebhook(client, msgId)
 
 	log.Debugf("delivered message %s", msgId)
 
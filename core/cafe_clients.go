@@ -0,0 +1,117 @@
+package core
+
+import (
+	"fmt"
+	"time"
+
+	icid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/jwt"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrCafeClientNotFound indicates a cafe client is not registered with this host
+var ErrCafeClientNotFound = fmt.Errorf("cafe client not found")
+
+// CafeClients lists clients of this cafe w/ storage usage, most recently seen first
+func (t *Textile) CafeClients() *pb.CafeClientList {
+	list := &pb.CafeClientList{Items: make([]*pb.CafeClient, 0)}
+	for _, c := range t.datastore.CafeClients().List() {
+		client := c
+		client.WebhookSecret = ""
+		client.Usage = t.cafeClientUsage(client.Id)
+		list.Items = append(list.Items, &client)
+	}
+	return list
+}
+
+// CafeClient returns a client of this cafe w/ storage usage
+func (t *Textile) CafeClient(id string) (*pb.CafeClient, error) {
+	client := t.datastore.CafeClients().Get(id)
+	if client == nil {
+		return nil, ErrCafeClientNotFound
+	}
+	client.WebhookSecret = ""
+	client.Usage = t.cafeClientUsage(id)
+	return client, nil
+}
+
+// RevokeCafeClientSessions invalidates all sessions issued to a client.
+// The client must register again w/ a valid token to get a new session.
+func (t *Textile) RevokeCafeClientSessions(id string) error {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return ErrCafeClientNotFound
+	}
+	return t.datastore.CafeClients().UpdateRevoked(id, time.Now())
+}
+
+// PurgeCafeClient deletes a client's stored threads and inbox messages, and
+// releases its stored objects. The client remains registered.
+func (t *Textile) PurgeCafeClient(id string) error {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return ErrCafeClientNotFound
+	}
+	err := t.datastore.CafeClientThreads().DeleteByClient(id)
+	if err != nil {
+		return err
+	}
+	err = t.datastore.CafeClientMessages().DeleteByClient(id, -1)
+	if err != nil {
+		return err
+	}
+	t.cafe.releaseObjects(id)
+	return t.datastore.CafeClientUsage().Delete(id)
+}
+
+// releaseObjects removes all of a client's object references,
+// unpinning objects no other client references
+func (h *CafeService) releaseObjects(clientId string) {
+	for _, obj := range h.datastore.CafeClientObjects().ListByClient(clientId) {
+		dec, err := icid.Decode(obj.Id)
+		if err != nil {
+			log.Warningf("error decoding object %s: %s", obj.Id, err)
+			h.removeObject(clientId, obj.Id)
+			continue
+		}
+		err = h.releaseObject(clientId, dec)
+		if err != nil {
+			log.Warningf("error releasing %s: %s", obj.Id, err)
+		}
+	}
+}
+
+// releaseObject removes a client's reference to an object, unpinning it
+// once no client references it. Objects the client never referenced are left alone.
+func (h *CafeService) releaseObject(clientId string, id icid.Cid) error {
+	hash := id.Hash().B58String()
+	if !h.removeObject(clientId, hash) {
+		return nil
+	}
+	if refs := h.datastore.CafeClientObjects().Count(hash); refs > 0 {
+		log.Debugf("%s still referenced by %d clients", hash, refs)
+		return nil
+	}
+
+	pinned, err := ipfs.Pinned(h.service.Node(), []string{hash})
+	if err != nil {
+		return err
+	}
+	if len(pinned) == 0 {
+		return nil
+	}
+	err = ipfs.UnpinCid(h.service.Node(), id, true)
+	if err != nil {
+		return err
+	}
+	log.Debugf("released %s", hash)
+	return nil
+}
+
+// revoked returns whether a session issued to a client has since been revoked
+func (h *CafeService) revoked(claims *jwt.TextileClaims) bool {
+	client := h.datastore.CafeClients().Get(claims.Subject)
+	if client == nil || client.Revoked == nil {
+		return false
+	}
+	return claims.IssuedAt <= client.Revoked.Seconds
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/ipfs/go-ipfs/core/corerepo"
+	"github.com/textileio/go-textile/pb"
+)
+
+// CafeGC garbage collects objects no longer pinned for any client,
+// returning a report of what was freed
+func (t *Textile) CafeGC() (*pb.CafeGCReport, error) {
+	if !t.Online() {
+		return nil, ErrOffline
+	}
+	return t.cafe.gc(), nil
+}
+
+// LastCafeGC returns the report of the last cafe garbage collection, if any
+func (t *Textile) LastCafeGC() *pb.CafeGCReport {
+	t.cafe.gcLock.Lock()
+	defer t.cafe.gcLock.Unlock()
+	return t.cafe.gcReport
+}
+
+// runCafeGC garbage collects on an interval until the node stops
+func (t *Textile) runCafeGC(interval time.Duration) {
+	tick := time.NewTicker(interval)
+	defer tick.Stop()
+	for {
+		select {
+		case <-tick.C:
+			t.cafe.gc()
+		case <-t.node.Context().Done():
+			log.Debug("cafe GC shutdown")
+			return
+		}
+	}
+}
+
+// cafeGCInterval parses the configured gc interval, zero if disabled or invalid
+func cafeGCInterval(str string) time.Duration {
+	if str == "" {
+		return 0
+	}
+	interval, err := time.ParseDuration(str)
+	if err != nil || interval <= 0 {
+		log.Warningf("invalid cafe gc interval %s, disabling", str)
+		return 0
+	}
+	return interval
+}
+
+// gc runs blockstore garbage collection, measuring freed bytes by repo usage
+func (h *CafeService) gc() *pb.CafeGCReport {
+	h.gcRunLock.Lock()
+	defer h.gcRunLock.Unlock()
+
+	node := h.service.Node()
+	report := &pb.CafeGCReport{
+		Started: ptypes.TimestampNow(),
+	}
+	before, err := node.Repo.GetStorageUsage()
+	if err != nil {
+		log.Warningf("error getting storage usage: %s", err)
+	}
+
+	for res := range corerepo.GarbageCollectAsync(node, node.Context()) {
+		if res.Error != nil {
+			if report.Error == "" {
+				report.Error = res.Error.Error()
+			}
+			continue
+		}
+		report.Removed++
+	}
+
+	after, err := node.Repo.GetStorageUsage()
+	if err != nil {
+		log.Warningf("error getting storage usage: %s", err)
+	}
+	if before > after {
+		report.Freed = int64(before - after)
+	}
+	report.Finished = ptypes.TimestampNow()
+
+	h.gcLock.Lock()
+	h.gcReport = report
+	h.gcLock.Unlock()
+
+	if report.Error != "" {
+		log.Errorf("cafe gc error: %s", report.Error)
+	}
+	log.Infof("cafe gc removed %d blocks, freed %d bytes", report.Removed, report.Freed)
+	return report
+}
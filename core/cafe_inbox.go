@@ -15,7 +15,8 @@ import (
 // cafeInFlushGroupSize is the size of concurrently processed messages
 const cafeInFlushGroupSize = 16
 
-// cafeInMaxDownloadAttempts is the number of times a message can fail to download before being deleted
+// cafeInMaxDownloadAttempts is the number of times a message can fail to download before being
+// moved to dead letters
 const cafeInMaxDownloadAttempts = 5
 
 // CafeInbox queues and processes downloaded cafe messages
@@ -25,6 +26,7 @@ type CafeInbox struct {
 	node           func() *core.IpfsNode
 	datastore      repo.Datastore
 	checking       bool
+	lastErr        queueError
 	lock           sync.Mutex
 }
 
@@ -103,6 +105,9 @@ func (q *CafeInbox) batch(msgs []pb.CafeMessage) {
 	}
 
 	for _, msg := range msgs {
+		if !retryReady(msg.Retry) {
+			continue
+		}
 		go func(msg pb.CafeMessage) {
 			err := q.handle(msg)
 			if err != nil {
@@ -156,13 +161,17 @@ func (q *CafeInbox) handle(msg pb.CafeMessage) error {
 	return nil
 }
 
-// handleErr deletes or adds an attempt to a message processing error
+// handleErr moves to dead letters or adds an attempt to a message processing error
 func (q *CafeInbox) handleErr(herr error, msg pb.CafeMessage) error {
+	q.lastErr.set(herr)
 	var err error
 	if msg.Attempts+1 >= cafeInMaxDownloadAttempts {
-		err = q.datastore.CafeMessages().Delete(msg.Id)
+		err = addDeadLetter(q.datastore, pb.DeadLetter_CAFE_INBOX, msg.Id, &msg, msg.Attempts+1, herr)
+		if err == nil {
+			err = q.datastore.CafeMessages().Delete(msg.Id)
+		}
 	} else {
-		err = q.datastore.CafeMessages().AddAttempt(msg.Id)
+		err = q.datastore.CafeMessages().AddAttempt(msg.Id, nextRetry(msg.Attempts+1))
 	}
 	if err != nil {
 		return err
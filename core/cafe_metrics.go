@@ -0,0 +1,94 @@
+package core
+
+import (
+	"io"
+	"time"
+
+	"github.com/textileio/go-textile/metrics"
+	"github.com/textileio/go-textile/pb"
+)
+
+// pubsub query metrics
+var (
+	pubSubQueriesSent = metrics.Default.Counter("textile_cafe_pubsub_queries_total",
+		"Pubsub queries published or received", metrics.Labels{"direction": "sent"})
+	pubSubQueriesReceived = metrics.Default.Counter("textile_cafe_pubsub_queries_total",
+		"Pubsub queries published or received", metrics.Labels{"direction": "received"})
+	pubSubQueriesInFlight = metrics.Default.Gauge("textile_cafe_pubsub_queries_in_flight",
+		"Pubsub queries published and awaiting results", nil)
+)
+
+// WriteMetrics writes process and node metrics in the Prometheus text format
+func (t *Textile) WriteMetrics(w io.Writer) {
+	t.writeMetrics(w, true)
+}
+
+// writeMetrics writes metrics, w/ samples labeled by client only if perClient is true
+func (t *Textile) writeMetrics(w io.Writer, perClient bool) {
+	metrics.Default.WriteTo(w, func() []metrics.Sample {
+		return t.collectMetrics(perClient)
+	})
+}
+
+// observeCafeRequest records the outcome and latency of a cafe service request
+func observeCafeRequest(mtype pb.Message_Type, start time.Time, renv *pb.Envelope, err error) {
+	labels := metrics.Labels{"type": mtype.String()}
+	metrics.Default.Counter("textile_cafe_requests_total",
+		"Cafe service requests handled by message type", labels).Inc()
+	if err != nil || (renv != nil && renv.Message.Type == pb.Message_ERROR) {
+		metrics.Default.Counter("textile_cafe_request_errors_total",
+			"Cafe service requests that failed by message type", labels).Inc()
+	}
+	metrics.Default.Histogram("textile_cafe_request_duration_seconds",
+		"Cafe service request latencies by message type", labels, metrics.DefaultBuckets).
+		Observe(time.Since(start).Seconds())
+}
+
+// collectMetrics returns cafe host and queue metrics computed at scrape time.
+// Client inbox counts are totaled unless perClient is true.
+func (t *Textile) collectMetrics(perClient bool) []metrics.Sample {
+	gauge := func(name string, help string, labels metrics.Labels, value float64) metrics.Sample {
+		return metrics.Sample{Name: name, Help: help, Type: metrics.GaugeType, Labels: labels, Value: value}
+	}
+
+	samples := []metrics.Sample{
+		gauge("textile_cafe_sessions", "Sessions this peer holds with cafes",
+			nil, float64(len(t.datastore.CafeSessions().List().Items))),
+	}
+
+	// cafe host
+	clients := t.datastore.CafeClients().List()
+	samples = append(samples, gauge("textile_cafe_clients", "Clients registered with this cafe",
+		nil, float64(len(clients))))
+	var objects, bytes int64
+	for _, usage := range t.datastore.CafeClientUsage().List() {
+		objects += usage.Objects
+		bytes += usage.Bytes
+	}
+	samples = append(samples,
+		gauge("textile_cafe_stored_objects", "Objects stored for clients", nil, float64(objects)),
+		gauge("textile_cafe_stored_bytes", "Bytes stored for clients", nil, float64(bytes)))
+	var inbox int
+	for _, client := range clients {
+		count := t.datastore.CafeClientMessages().CountByClient(client.Id)
+		if perClient {
+			samples = append(samples, gauge("textile_cafe_inbox_messages", "Inbox messages waiting for a client",
+				metrics.Labels{"client": client.Id}, float64(count)))
+		}
+		inbox += count
+	}
+	if !perClient {
+		samples = append(samples, gauge("textile_cafe_inbox_messages", "Inbox messages waiting for clients",
+			nil, float64(inbox)))
+	}
+
+	// queues
+	for _, stats := range t.Queues().Items {
+		labels := metrics.Labels{"queue": stats.Queue.String()}
+		samples = append(samples,
+			gauge("textile_queue_items", "Items waiting in a queue", labels, float64(stats.Count)),
+			gauge("textile_queue_dead_letters", "Items moved from a queue to dead letters", labels, float64(stats.Dead)),
+			gauge("textile_queue_oldest_age_seconds", "Age of the oldest item in a queue", labels, float64(stats.OldestAge)))
+	}
+	return samples
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
@@ -92,6 +93,7 @@ type CafeOutbox struct {
 	datastore   repo.Datastore
 	handler     CafeOutboxHandler
 	flushBlocks func()
+	debounce    *debouncer
 	lock        sync.Mutex
 }
 
@@ -100,13 +102,18 @@ func NewCafeOutbox(
 	node func() *core.IpfsNode,
 	datastore repo.Datastore,
 	handler CafeOutboxHandler,
-	flushBlocks func()) *CafeOutbox {
-	return &CafeOutbox{
+	flushBlocks func(),
+	debounce time.Duration) *CafeOutbox {
+	q := &CafeOutbox{
 		node:        node,
 		datastore:   datastore,
 		handler:     handler,
 		flushBlocks: flushBlocks,
 	}
+	q.debounce = newDebouncer(debounce, func() {
+		q.Flush(false)
+	})
+	return q
 }
 
 // Add adds a request for each active cafe session
@@ -193,11 +200,11 @@ func (q *CafeOutbox) Flush(skipBlocks bool) {
 	}
 }
 
-// add queues a single request
+// add queues a single request, scheduling a flush
 func (q *CafeOutbox) add(peerId string, target string, cafe *pb.Cafe, rtype pb.CafeRequest_Type, settings *CafeRequestSettings) error {
 	log.Debugf("adding cafe %s request: %s", rtype.String(), target)
 
-	return q.datastore.CafeRequests().Add(&pb.CafeRequest{
+	err := q.datastore.CafeRequests().Add(&pb.CafeRequest{
 		Id:        ksuid.New().String(),
 		Peer:      peerId,
 		Target:    target,
@@ -209,4 +216,9 @@ func (q *CafeOutbox) add(peerId string, target string, cafe *pb.Cafe, rtype pb.C
 		Size:      int64(settings.Size),
 		Status:    pb.CafeRequest_NEW,
 	})
+	if err != nil {
+		return err
+	}
+	q.debounce.trigger()
+	return nil
 }
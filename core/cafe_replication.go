@@ -0,0 +1,195 @@
+package core
+
+import (
+	"sort"
+
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/service"
+)
+
+// setNeighbors sets the cafes this host replicates client data to and accepts it from
+func (h *CafeService) setNeighbors(ids []string) {
+	self := h.service.Node().Identity.Pretty()
+	h.neighbors = make(map[string]struct{})
+	for _, id := range ids {
+		if id == "" || id == self {
+			continue
+		}
+		if _, err := peer.IDB58Decode(id); err != nil {
+			log.Warningf("invalid cafe neighbor %s: %s", id, err)
+			continue
+		}
+		h.neighbors[id] = struct{}{}
+	}
+}
+
+// isNeighbor returns whether or not a peer is a configured neighbor cafe
+func (h *CafeService) isNeighbor(id string) bool {
+	_, ok := h.neighbors[id]
+	return ok
+}
+
+// neighborList returns the configured neighbor cafes
+func (h *CafeService) neighborList() []string {
+	list := make([]string, 0)
+	for id := range h.neighbors {
+		list = append(list, id)
+	}
+	sort.Strings(list)
+	return list
+}
+
+// replicate forwards a client change to each neighbor cafe, logging failures
+func (h *CafeService) replicate(rep *pb.CafeReplicate) {
+	if len(h.neighbors) == 0 {
+		return
+	}
+	client := h.datastore.CafeClients().Get(rep.Client)
+	if client == nil {
+		return
+	}
+	rep.Address = client.Address
+
+	env, err := h.service.NewEnvelope(pb.Message_CAFE_REPLICATE, rep, nil, false)
+	if err != nil {
+		log.Errorf("error creating replication envelope: %s", err)
+		return
+	}
+	for id := range h.neighbors {
+		go func(id string) {
+			err := h.service.SendMessage(nil, id, env)
+			if err != nil {
+				log.Warningf("error replicating %s for %s to %s: %s", rep.Type, rep.Client, id, err)
+			}
+		}(id)
+	}
+}
+
+// handleReplicate receives a client change from a neighbor cafe
+func (h *CafeService) handleReplicate(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	rep := new(pb.CafeReplicate)
+	err := ptypes.UnmarshalAny(env.Message.Payload, rep)
+	if err != nil {
+		return nil, err
+	}
+
+	if !h.isNeighbor(pid.Pretty()) {
+		log.Warningf("received replication from unknown cafe %s", pid.Pretty())
+		return nil, nil
+	}
+
+	client := h.datastore.CafeClients().Get(rep.Client)
+	if client == nil {
+		now := ptypes.TimestampNow()
+		client = &pb.CafeClient{
+			Id:      rep.Client,
+			Address: rep.Address,
+			Created: now,
+			Seen:    now,
+		}
+		err = h.datastore.CafeClients().Add(client)
+		if err != nil {
+			log.Errorf("error adding replicated client %s: %s", rep.Client, err)
+			return nil, nil
+		}
+	}
+
+	switch rep.Type {
+	case pb.CafeReplicate_STORE:
+		for _, id := range rep.Cids {
+			dec, err := icid.Decode(id)
+			if err != nil {
+				log.Warningf("error decoding replicated cid %s: %s", id, err)
+				continue
+			}
+			pinned, err := ipfs.Pinned(h.service.Node(), []string{id})
+			if err != nil {
+				log.Warningf("error checking replicated cid %s: %s", id, err)
+				continue
+			}
+			if len(pinned) > 0 {
+				h.holdUntracked(id)
+			}
+			node, err := ipfs.NodeAtCid(h.service.Node(), dec)
+			if err != nil {
+				log.Warningf("error getting replicated node %s: %s", id, err)
+				continue
+			}
+			// like the primary, pin the whole dag, which fetches any missing blocks
+			err = ipfs.PinNode(h.service.Node(), node, true)
+			if err != nil {
+				log.Warningf("error pinning replicated node %s: %s", id, err)
+				continue
+			}
+			size, err := h.objectSize(dec)
+			if err != nil {
+				log.Warningf("error getting size of replicated node %s: %s", id, err)
+				continue
+			}
+			h.addObject(client.Id, dec, size)
+		}
+	case pb.CafeReplicate_UNSTORE:
+		for _, id := range rep.Cids {
+			dec, err := icid.Decode(id)
+			if err != nil {
+				log.Warningf("error decoding replicated cid %s: %s", id, err)
+				continue
+			}
+			err = h.releaseObject(client.Id, dec)
+			if err != nil {
+				log.Warningf("error releasing replicated object %s: %s", id, err)
+			}
+		}
+	case pb.CafeReplicate_STORE_THREAD:
+		err = h.datastore.CafeClientThreads().AddOrUpdate(&pb.CafeClientThread{
+			Id:         rep.Thread,
+			Client:     client.Id,
+			Ciphertext: rep.Ciphertext,
+		})
+		if err != nil {
+			log.Errorf("error storing replicated thread %s: %s", rep.Thread, err)
+		}
+	case pb.CafeReplicate_UNSTORE_THREAD:
+		err = h.datastore.CafeClientThreads().Delete(rep.Thread, client.Id)
+		if err != nil {
+			log.Errorf("error removing replicated thread %s: %s", rep.Thread, err)
+		}
+	case pb.CafeReplicate_MESSAGE:
+		if rep.Message == nil {
+			return nil, nil
+		}
+		err = h.addMessage(rep.Message, rep.From, client.Id)
+		if err != nil {
+			log.Errorf("error adding replicated message: %s", err)
+		}
+	}
+	log.Debugf("replicated %s for %s from %s", rep.Type, client.Id, pid.Pretty())
+	return nil, nil
+}
+
+// sendToCafe sends a request to a cafe, failing over to its neighbors
+// when the cafe itself cannot be reached
+func (h *CafeService) sendToCafe(cafe *pb.Cafe, env *pb.Envelope) (*pb.Envelope, error) {
+	renv, err := h.service.SendRequest(cafe.Peer, env)
+	if err == nil {
+		return renv, nil
+	}
+	if _, ok := err.(*service.RemoteError); ok {
+		return nil, err
+	}
+	for _, id := range cafe.Neighbors {
+		log.Debugf("cafe %s unreachable (%s), failing over to %s", cafe.Peer, err, id)
+		renv, nerr := h.service.SendRequest(id, env)
+		if nerr == nil {
+			return renv, nil
+		}
+		if _, ok := nerr.(*service.RemoteError); ok {
+			return nil, nerr
+		}
+	}
+	return nil, err
+}
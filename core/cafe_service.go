@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math"
+	"net/http"
 	"strings"
 	"sync"
 	"time"
@@ -37,7 +38,7 @@ import (
 // defaultSessionDuration after which session token expires
 const defaultSessionDuration = time.Hour * 24 * 7 * 4
 
-// maxRequestAttempts is the number of times a request can fail before being deleted
+// maxRequestAttempts is the number of times a request can fail before being moved to dead letters
 const maxRequestAttempts = 5
 
 // inboxMessagePageSize is the page size used when checking messages
@@ -64,6 +65,9 @@ const (
 	errBadRequest     = "bad request"
 )
 
+// errQuotaExceededCode is the status code for requests which would exceed a client quota
+const errQuotaExceededCode = 507
+
 // cafeServiceProtocol is the current protocol tag
 const cafeServiceProtocol = protocol.ID("/textile/cafe/1.0.0")
 
@@ -77,6 +81,16 @@ type CafeService struct {
 	open            bool
 	queryResults    *broadcast.Broadcaster
 	inFlightQueries map[string]struct{}
+	lastErr         queueError
+	byteQuota       int64
+	objectQuota     int64
+	gcReport        *pb.CafeGCReport
+	gcLock          sync.Mutex // guards gcReport
+	gcRunLock       sync.Mutex
+	neighbors       map[string]struct{}
+	webhooks        map[string]*pendingWebhook
+	webhookHTTP     *http.Client
+	webhookLock     sync.Mutex // guards webhooks and webhookHTTP
 }
 
 // NewCafeService returns a new threads service
@@ -91,6 +105,7 @@ func NewCafeService(
 		inbox:           inbox,
 		queryResults:    broadcast.NewBroadcaster(10),
 		inFlightQueries: make(map[string]struct{}),
+		webhooks:        make(map[string]*pendingWebhook),
 	}
 	handler.service = service.NewService(account, handler, node)
 	return handler
@@ -113,6 +128,14 @@ func (h *CafeService) Ping(pid peer.ID) (service.PeerStatus, error) {
 
 // Handle is called by the underlying service handler method
 func (h *CafeService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	start := time.Now()
+	renv, err := h.handle(env, pid)
+	observeCafeRequest(env.Message.Type, start, renv, err)
+	return renv, err
+}
+
+// handle routes a request to its handler
+func (h *CafeService) handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
 	switch env.Message.Type {
 	case pb.Message_CAFE_CHALLENGE:
 		return h.handleChallenge(env, pid)
@@ -134,12 +157,16 @@ func (h *CafeService) Handle(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error
 		return h.handleUnstoreThread(env, pid)
 	case pb.Message_CAFE_DELIVER_MESSAGE:
 		return h.handleDeliverMessage(env, pid)
+	case pb.Message_CAFE_WEBHOOK:
+		return h.handleWebhook(env, pid)
 	case pb.Message_CAFE_CHECK_MESSAGES:
 		return h.handleCheckMessages(env, pid)
 	case pb.Message_CAFE_DELETE_MESSAGES:
 		return h.handleDeleteMessages(env, pid)
 	case pb.Message_CAFE_YOU_HAVE_MAIL:
 		return h.handleNotifyClient(env, pid)
+	case pb.Message_CAFE_REPLICATE:
+		return h.handleReplicate(env, pid)
 	case pb.Message_CAFE_PUBLISH_PEER:
 		return h.handlePublishPeer(env, pid)
 	case pb.Message_CAFE_PUBSUB_QUERY:
@@ -160,11 +187,13 @@ func (h *CafeService) HandleStream(env *pb.Envelope, pid peer.ID) (chan *pb.Enve
 	go func() {
 		defer close(renvCh)
 
+		start := time.Now()
 		var err error
 		switch env.Message.Type {
 		case pb.Message_CAFE_QUERY:
 			err = h.handleQuery(env, pid, renvCh, cancelCh)
 		}
+		observeCafeRequest(env.Message.Type, start, nil, err)
 		if err != nil {
 			errCh <- err
 		}
@@ -383,7 +412,8 @@ func (h *CafeService) notifyClient(peerId string) error {
 	return ipfs.Publish(h.service.Node(), client, payload)
 }
 
-// sendCafeRequest sends an authenticated request, retrying once after a session refresh
+// sendCafeRequest sends an authenticated request, retrying once after a session refresh.
+// Requests fail over to the cafe's neighbors if it can't be reached.
 func (h *CafeService) sendCafeRequest(cafeId string, envFactory func(*pb.CafeSession) (*pb.Envelope, error)) (*pb.Envelope, error) {
 	session := h.datastore.CafeSessions().Get(cafeId)
 	if session == nil {
@@ -395,7 +425,7 @@ func (h *CafeService) sendCafeRequest(cafeId string, envFactory func(*pb.CafeSes
 		return nil, err
 	}
 
-	renv, err := h.service.SendRequest(session.Cafe.Peer, env)
+	renv, err := h.sendToCafe(session.Cafe, env)
 	if err != nil {
 		if err.Error() == errUnauthorized {
 			refreshed, err := h.refresh(session)
@@ -408,7 +438,7 @@ func (h *CafeService) sendCafeRequest(cafeId string, envFactory func(*pb.CafeSes
 				return nil, err
 			}
 
-			renv, err = h.service.SendRequest(refreshed.Cafe.Peer, env)
+			renv, err = h.sendToCafe(refreshed.Cafe, env)
 			if err != nil {
 				return nil, err
 			}
@@ -469,7 +499,7 @@ func (h *CafeService) refresh(session *pb.CafeSession) (*pb.CafeSession, error)
 }
 
 // sendObject sends data or an object by cid to a cafe peer
-func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) error {
+func (h *CafeService) sendObject(id icid.Cid, cafe *pb.Cafe, token string) error {
 	hash := id.Hash().B58String()
 	obj := &pb.CafeObject{
 		Token: token,
@@ -496,7 +526,7 @@ func (h *CafeService) sendObject(id icid.Cid, cafeId string, token string) error
 	if err != nil {
 		return err
 	}
-	_, err = h.service.SendRequest(cafeId, env)
+	_, err = h.sendToCafe(cafe, env)
 	if err != nil {
 		return err
 	}
@@ -602,8 +632,11 @@ func (h *CafeService) searchLocal(qtype pb.Query_Type, options *pb.QueryOptions,
 // searchPubSub performs a network-wide search for the given query
 func (h *CafeService) searchPubSub(query *pb.Query, reply func(*pb.QueryResults) bool, cancelCh <-chan interface{}, fromCafe bool) error {
 	h.inFlightQueries[query.Id] = struct{}{}
+	pubSubQueriesSent.Inc()
+	pubSubQueriesInFlight.Inc()
 	defer func() {
 		delete(h.inFlightQueries, query.Id)
+		pubSubQueriesInFlight.Dec()
 	}()
 
 	// respond pubsub if this is a cafe and the request is not from a cafe
@@ -735,7 +768,7 @@ func (h *CafeService) handleRegistration(env *pb.Envelope, pid peer.ID) (*pb.Env
 	}
 
 	encodedToken := h.datastore.CafeTokens().Get(hex.EncodeToString(plainBytes[:12]))
-	if encodedToken == nil {
+	if encodedToken == nil || encodedToken.Disabled {
 		return h.service.NewError(403, errForbidden, env.Message.Request)
 	}
 
@@ -822,6 +855,11 @@ func (h *CafeService) handleDeregistration(env *pb.Envelope, pid peer.ID) (*pb.E
 	if err != nil {
 		return h.service.NewError(500, "delete client messages failed", env.Message.Request)
 	}
+	h.releaseObjects(peerId)
+	err = h.datastore.CafeClientUsage().Delete(peerId)
+	if err != nil {
+		return h.service.NewError(500, "delete client usage failed", env.Message.Request)
+	}
 	err = h.datastore.CafeClients().Delete(peerId)
 	if err != nil {
 		return h.service.NewError(500, "delete client failed", env.Message.Request)
@@ -919,8 +957,54 @@ func (h *CafeService) handleStore(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return nil, err
 	}
 	var need []string
+	needed := make(map[string]struct{})
 	for _, p := range list {
 		need = append(need, p.Hash().B58String())
+		needed[p.Hash().B58String()] = struct{}{}
+	}
+
+	// reference objects already stored for other clients
+	var referenced []string
+	var charged []icid.Cid
+	var sizes []int64
+	var refBytes int64
+	for _, id := range store.Cids {
+		if _, ok := needed[id]; ok {
+			continue
+		}
+		dec, err := icid.Decode(id)
+		if err != nil {
+			return h.service.NewError(400, errBadRequest, env.Message.Request)
+		}
+		referenced = append(referenced, id)
+		h.holdUntracked(id)
+		if h.datastore.CafeClientObjects().Get(id, pid.Pretty()) != nil {
+			continue
+		}
+		size, err := h.objectSize(dec)
+		if err != nil {
+			return nil, err
+		}
+		charged = append(charged, dec)
+		sizes = append(sizes, size)
+		refBytes += size
+	}
+
+	// check quota before charging for references
+	err = h.checkQuota(pid.Pretty(), refBytes, int64(len(need)+len(charged)))
+	if err != nil {
+		return h.service.NewError(errQuotaExceededCode, err.Error(), env.Message.Request)
+	}
+
+	for i, id := range charged {
+		h.addObject(pid.Pretty(), id, sizes[i])
+	}
+	if len(referenced) > 0 {
+		h.replicate(&pb.CafeReplicate{
+			Type:   pb.CafeReplicate_STORE,
+			Client: pid.Pretty(),
+			Cids:   referenced,
+		})
 	}
 
 	res := &pb.CafeObjectList{Cids: need}
@@ -950,12 +1034,19 @@ func (h *CafeService) handleUnstore(env *pb.Envelope, pid peer.ID) (*pb.Envelope
 	}
 	var unstored []string
 	for _, p := range list {
-		err := ipfs.UnpinCid(h.service.Node(), p, true)
+		err := h.releaseObject(pid.Pretty(), p)
 		if err != nil {
 			return nil, err
 		}
 		unstored = append(unstored, p.Hash().B58String())
 	}
+	if len(unstored) > 0 {
+		h.replicate(&pb.CafeReplicate{
+			Type:   pb.CafeReplicate_UNSTORE,
+			Client: pid.Pretty(),
+			Cids:   unstored,
+		})
+	}
 
 	res := &pb.CafeUnstoreAck{Cids: unstored}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_ACK, res, &env.Message.Request, true)
@@ -977,6 +1068,11 @@ func (h *CafeService) handleObject(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return rerr, nil
 	}
 
+	err = h.checkQuota(pid.Pretty(), int64(len(obj.Data)+len(obj.Node)), 1)
+	if err != nil {
+		return h.service.NewError(errQuotaExceededCode, err.Error(), env.Message.Request)
+	}
+
 	var aid *icid.Cid
 	if obj.Data != nil {
 		aid, err = ipfs.AddData(h.service.Node(), bytes.NewReader(obj.Data), true, false)
@@ -989,6 +1085,12 @@ func (h *CafeService) handleObject(env *pb.Envelope, pid peer.ID) (*pb.Envelope,
 		return nil, err
 	}
 	rhash := aid.Hash().B58String()
+	h.addObject(pid.Pretty(), *aid, int64(len(obj.Data)+len(obj.Node)))
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_STORE,
+		Client: pid.Pretty(),
+		Cids:   []string{rhash},
+	})
 
 	log.Debugf("stored %s", rhash)
 
@@ -1030,6 +1132,12 @@ func (h *CafeService) handleStoreThread(env *pb.Envelope, pid peer.ID) (*pb.Enve
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicate(&pb.CafeReplicate{
+		Type:       pb.CafeReplicate_STORE_THREAD,
+		Client:     client.Id,
+		Thread:     store.Id,
+		Ciphertext: store.Ciphertext,
+	})
 
 	res := &pb.CafeStoreThreadAck{Id: store.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_STORE_THREAD_ACK, res, &env.Message.Request, true)
@@ -1060,6 +1168,11 @@ func (h *CafeService) handleUnstoreThread(env *pb.Envelope, pid peer.ID) (*pb.En
 	if err != nil {
 		return h.service.NewError(500, err.Error(), env.Message.Request)
 	}
+	h.replicate(&pb.CafeReplicate{
+		Type:   pb.CafeReplicate_UNSTORE_THREAD,
+		Client: client.Id,
+		Thread: unstore.Id,
+	})
 
 	res := &pb.CafeUnstoreThreadAck{Id: unstore.Id}
 	return h.service.NewEnvelope(pb.Message_CAFE_UNSTORE_THREAD_ACK, res, &env.Message.Request, true)
@@ -1079,71 +1192,87 @@ func (h *CafeService) handleDeliverMessage(env *pb.Envelope, pid peer.ID) (*pb.E
 		return nil, nil
 	}
 
+	err = h.addMessage(msg, pid.Pretty(), client.Id)
+	if err != nil {
+		return nil, err
+	}
+	h.replicate(&pb.CafeReplicate{
+		Type:    pb.CafeReplicate_MESSAGE,
+		Client:  client.Id,
+		Message: msg,
+		From:    pid.Pretty(),
+	})
+
+	go func() {
+		err := h.notifyClient(client.Id)
+		if err != nil {
+			log.Debugf("unable to notify offline client: %s", client.Id)
+		}
+	}()
+	h.queueWebhook(client, msg.Id)
+	return nil, nil
+}
+
+// addMessage pins an inbox message for a client, if included, and adds it to the inbox
+func (h *CafeService) addMessage(msg *pb.CafeDeliverMessage, from string, clientId string) error {
 	if msg.Env != nil {
 		// pin inner node
 		nenv := new(pb.Envelope)
-		err = proto.Unmarshal(msg.Env, nenv)
+		err := proto.Unmarshal(msg.Env, nenv)
 		if err != nil {
 			log.Warningf("error unmarshaling envelope: %s", err)
-			return nil, err
+			return err
 		}
 		tenv := new(pb.ThreadEnvelope)
 		err = ptypes.UnmarshalAny(nenv.Message.Payload, tenv)
 		if err != nil {
 			log.Warningf("error unmarshaling payload: %s", err)
-			return nil, err
+			return err
 		}
 		oid, err := ipfs.AddObject(h.service.Node(), bytes.NewReader(tenv.Node), true)
 		if err != nil {
 			log.Warningf("error adding object: %s", err)
-			return nil, err
+			return err
 		}
 		node, err := ipfs.NodeAtCid(h.service.Node(), *oid)
 		if err != nil {
 			log.Warningf("error getting node: %s", err)
-			return nil, err
+			return err
 		}
 		if tenv.Block != nil {
 			_, err = ipfs.AddData(h.service.Node(), bytes.NewReader(tenv.Block), true, false)
 			if err != nil {
 				log.Warningf("error adding block: %s", err)
-				return nil, err
+				return err
 			}
 		}
 		_, err = extractNode(h.service.Node(), node, tenv.Block == nil)
 		if err != nil {
 			log.Warningf("error extracting node: %s", err)
-			return nil, err
+			return err
 		}
 
 		// pin envelope
 		id, err := ipfs.AddData(h.service.Node(), bytes.NewReader(msg.Env), true, false)
 		if err != nil {
 			log.Warningf("error pinning envelope: %s", err)
-			return nil, err
+			return err
 		}
 		msg.Id = id.Hash().B58String()
 	}
 
-	err = h.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
+	err := h.datastore.CafeClientMessages().AddOrUpdate(&pb.CafeClientMessage{
 		Id:     msg.Id,
-		Peer:   pid.Pretty(),
-		Client: client.Id,
+		Peer:   from,
+		Client: clientId,
 		Date:   ptypes.TimestampNow(),
 	})
 	if err != nil {
 		log.Errorf("error adding message: %s", err)
-		return nil, nil
+		return err
 	}
-	log.Debugf("added message for %s: %s", client.Id, msg.Id)
-
-	go func() {
-		err = h.notifyClient(client.Id)
-		if err != nil {
-			log.Debugf("unable to notify offline client: %s", client.Id)
-		}
-	}()
-	return nil, nil
+	log.Debugf("added message for %s: %s", clientId, msg.Id)
+	return nil
 }
 
 // handleCheckMessages receives a check inbox messages request
@@ -1322,6 +1451,7 @@ func (h *CafeService) handlePubSubQuery(env *pb.Envelope, pid peer.ID) (*pb.Enve
 	if _, ok := h.inFlightQueries[query.Id]; ok {
 		return nil, nil
 	}
+	pubSubQueriesReceived.Inc()
 
 	// return results, if any
 	options := &pb.QueryOptions{
@@ -1391,7 +1521,7 @@ func (h *CafeService) handlePubSubQueryResults(env *pb.Envelope, pid peer.ID) (*
 // authToken verifies a request token from a peer
 func (h *CafeService) authToken(pid peer.ID, token string, refreshing bool, requestId int32) (*pb.Envelope, error) {
 	subject := pid.Pretty()
-	_, err := jwt.Validate(token, h.verifyKeyFunc, refreshing, string(h.Protocol()), &subject)
+	claims, err := jwt.Validate(token, h.verifyKeyFunc, refreshing, string(h.Protocol()), &subject)
 	if err != nil {
 		switch err {
 		case jwt.ErrNoToken, jwt.ErrExpired:
@@ -1400,11 +1530,25 @@ func (h *CafeService) authToken(pid peer.ID, token string, refreshing bool, requ
 			return h.service.NewError(403, errForbidden, requestId)
 		}
 	}
+	if claims != nil && h.revoked(claims) {
+		return h.service.NewError(401, errUnauthorized, requestId)
+	}
 	return nil, nil
 }
 
-// verifyKeyFunc returns the correct key for token verification
+// verifyKeyFunc returns the correct key for token verification,
+// which is the issuer's key for tokens issued by a neighbor cafe
 func (h *CafeService) verifyKeyFunc(token *njwt.Token) (interface{}, error) {
+	if claims, ok := token.Claims.(njwt.MapClaims); ok {
+		iss, _ := claims["iss"].(string)
+		if h.isNeighbor(iss) {
+			pid, err := peer.IDB58Decode(iss)
+			if err != nil {
+				return nil, err
+			}
+			return pid.ExtractPublicKey()
+		}
+	}
 	return h.service.Node().PrivateKey.GetPublic(), nil
 }
 
@@ -1428,12 +1572,13 @@ func (h *CafeService) setAddrs(conf *config.Config) {
 	log.Infof("cafe url: %s", url)
 
 	h.info = &pb.Cafe{
-		Peer:     h.service.Node().Identity.Pretty(),
-		Address:  conf.Account.Address,
-		Api:      CafeApiVersion,
-		Protocol: string(cafeServiceProtocol),
-		Node:     common.Version,
-		Url:      url,
+		Peer:      h.service.Node().Identity.Pretty(),
+		Address:   conf.Account.Address,
+		Api:       CafeApiVersion,
+		Protocol:  string(cafeServiceProtocol),
+		Node:      common.Version,
+		Url:       url,
+		Neighbors: h.neighborList(),
 	}
 }
 
@@ -1461,11 +1606,16 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 	// group reqs by cafe
 	groups := make(map[string][]*pb.CafeRequest)
 	for _, req := range reqs.Items {
+		if !retryReady(req.Retry) {
+			continue
+		}
 		groups[req.Cafe.Peer] = append(groups[req.Cafe.Peer], req)
 	}
 
 	// process each cafe group concurrently
 	var toComplete, toFail, toUnpin []string
+	reasons := make(map[string]error)
+	var lock sync.Mutex
 	wg := sync.WaitGroup{}
 	for cafeId, group := range groups {
 		wg.Add(1)
@@ -1479,7 +1629,9 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 				handled, failed, err := h.handleRequests(group, t, cafeId)
 				if err != nil {
 					log.Warningf("error handling requests of type %s: %s", t.String(), err)
+					h.lastErr.set(err)
 				}
+				lock.Lock()
 				for _, id := range handled {
 					toComplete = append(toComplete, id)
 					if t == pb.CafeRequest_INBOX {
@@ -1488,7 +1640,9 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 				}
 				for _, id := range failed {
 					toFail = append(toFail, id)
+					reasons[id] = err
 				}
+				lock.Unlock()
 			}
 			wg.Done()
 		}(cafeId, group)
@@ -1523,6 +1677,11 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 			continue
 		}
 		if req.Attempts+1 >= maxRequestAttempts {
+			err = addDeadLetter(h.datastore, pb.DeadLetter_CAFE_REQUESTS, id, req, req.Attempts+1, reasons[id])
+			if err != nil {
+				log.Error(err.Error())
+				return
+			}
 			err = h.datastore.CafeRequests().Delete(id)
 			if err != nil {
 				log.Error(err.Error())
@@ -1533,7 +1692,7 @@ func (h *CafeService) batchRequests(reqs *pb.CafeRequestList) {
 			// @todo: Uncomment this when sync can only be handled by a single cafe session
 			//err = h.datastore.Blocks().Delete(req.SyncGroup)
 		} else {
-			err = h.datastore.CafeRequests().AddAttempt(id)
+			err = h.datastore.CafeRequests().AddAttempt(id, nextRetry(req.Attempts+1))
 		}
 		if err != nil {
 			log.Error(err.Error())
@@ -1661,12 +1820,14 @@ func (h *CafeService) store(cids []string, cafeId string) ([]string, error) {
 	var stored []string
 
 	var accessToken string
+	var cafe *pb.Cafe
 	renv, err := h.sendCafeRequest(cafeId, func(session *pb.CafeSession) (*pb.Envelope, error) {
 		store := &pb.CafeStore{
 			Token: session.Access,
 			Cids:  cids,
 		}
 		accessToken = session.Access
+		cafe = session.Cafe
 		return h.service.NewEnvelope(pb.Message_CAFE_STORE, store, nil, false)
 	})
 	if err != nil {
@@ -1703,7 +1864,7 @@ loop:
 		if err != nil {
 			return stored, err
 		}
-		err = h.sendObject(decoded, cafeId, accessToken)
+		err = h.sendObject(decoded, cafe, accessToken)
 		if err != nil {
 			return stored, err
 		}
@@ -1788,7 +1949,17 @@ func (h *CafeService) deliverMessage(mid string, peerId string, cafe *pb.Cafe) e
 		return err
 	}
 
-	return h.service.SendMessage(nil, cafe.Peer, env)
+	err = h.service.SendMessage(nil, cafe.Peer, env)
+	if err == nil {
+		return nil
+	}
+	for _, id := range cafe.Neighbors {
+		log.Debugf("inbox %s unreachable (%s), failing over to %s", cafe.Peer, err, id)
+		if h.service.SendMessage(nil, id, env) == nil {
+			return nil
+		}
+	}
+	return err
 }
 
 // queryDefaults ensures the query is within the expected bounds
@@ -0,0 +1,134 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// ErrQuotaExceeded indicates a request would put a client over its storage quota
+var ErrQuotaExceeded = fmt.Errorf("storage quota exceeded")
+
+// CafeClientUsage returns storage usage for a client of this cafe
+func (t *Textile) CafeClientUsage(id string) (*pb.CafeClientUsage, error) {
+	if t.datastore.CafeClients().Get(id) == nil {
+		return nil, ErrCafeClientNotFound
+	}
+	return t.cafeClientUsage(id), nil
+}
+
+// CafeClientUsages returns storage usage for all clients of this cafe
+func (t *Textile) CafeClientUsages() *pb.CafeClientUsageList {
+	list := &pb.CafeClientUsageList{Items: make([]*pb.CafeClientUsage, 0)}
+	for _, client := range t.datastore.CafeClients().List() {
+		list.Items = append(list.Items, t.cafeClientUsage(client.Id))
+	}
+	return list
+}
+
+// cafeClientUsage returns usage w/ quotas for a client, which may have stored nothing yet
+func (t *Textile) cafeClientUsage(id string) *pb.CafeClientUsage {
+	usage := t.datastore.CafeClientUsage().Get(id)
+	if usage == nil {
+		usage = &pb.CafeClientUsage{Id: id}
+	}
+	usage.ByteQuota = t.config.Cafe.Host.ClientByteQuota
+	usage.ObjectQuota = t.config.Cafe.Host.ClientObjectQuota
+	return usage
+}
+
+// setQuotas sets the per-client storage quotas enforced by this host
+func (h *CafeService) setQuotas(byteQuota int64, objectQuota int64) {
+	h.byteQuota = byteQuota
+	h.objectQuota = objectQuota
+}
+
+// checkQuota returns ErrQuotaExceeded if storing more bytes and objects
+// would put a client over quota
+func (h *CafeService) checkQuota(clientId string, bytes int64, objects int64) error {
+	if h.byteQuota <= 0 && h.objectQuota <= 0 {
+		return nil
+	}
+	usage := h.datastore.CafeClientUsage().Get(clientId)
+	if usage == nil {
+		usage = &pb.CafeClientUsage{}
+	}
+	if h.byteQuota > 0 && usage.Bytes+bytes > h.byteQuota {
+		return ErrQuotaExceeded
+	}
+	if h.objectQuota > 0 && usage.Objects+objects > h.objectQuota {
+		return ErrQuotaExceeded
+	}
+	return nil
+}
+
+// objectSize returns the cumulative size of an object's DAG
+func (h *CafeService) objectSize(id icid.Cid) (int64, error) {
+	stat, err := ipfs.StatObjectAtPath(h.service.Node(), id.Hash().B58String())
+	if err != nil {
+		return 0, err
+	}
+	return int64(stat.CumulativeSize), nil
+}
+
+// addObject records a client's reference to a stored object of size bytes, charging
+// the client for it the first time
+func (h *CafeService) addObject(clientId string, id icid.Cid, size int64) {
+	hash := id.Hash().B58String()
+	if h.datastore.CafeClientObjects().Get(hash, clientId) != nil {
+		return
+	}
+	err := h.datastore.CafeClientObjects().Add(&pb.CafeClientObject{
+		Id:     hash,
+		Client: clientId,
+		Size:   size,
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		log.Errorf("error adding object %s for %s: %s", hash, clientId, err)
+		return
+	}
+	err = h.datastore.CafeClientUsage().Add(clientId, size, 1)
+	if err != nil {
+		log.Errorf("error updating usage for %s: %s", clientId, err)
+	}
+}
+
+// removeObject removes a client's reference to a stored object, crediting the client.
+// It returns whether or not the client held a reference.
+func (h *CafeService) removeObject(clientId string, id string) bool {
+	obj := h.datastore.CafeClientObjects().Get(id, clientId)
+	if obj == nil {
+		return false
+	}
+	err := h.datastore.CafeClientObjects().Delete(id, clientId)
+	if err != nil {
+		log.Errorf("error removing object %s for %s: %s", id, clientId, err)
+		return false
+	}
+	err = h.datastore.CafeClientUsage().Add(clientId, -obj.Size, -1)
+	if err != nil {
+		log.Errorf("error updating usage for %s: %s", clientId, err)
+	}
+	return true
+}
+
+// holdUntracked records a reference by the cafe itself to a pinned object that no
+// client references, e.g., one stored before client objects were tracked, so that
+// clients referencing it later can never unpin it
+func (h *CafeService) holdUntracked(id string) {
+	if h.datastore.CafeClientObjects().Count(id) > 0 {
+		return
+	}
+	err := h.datastore.CafeClientObjects().Add(&pb.CafeClientObject{
+		Id:     id,
+		Client: h.service.Node().Identity.Pretty(),
+		Date:   ptypes.TimestampNow(),
+	})
+	if err != nil {
+		log.Errorf("error holding untracked object %s: %s", id, err)
+	}
+}
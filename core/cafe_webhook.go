@@ -0,0 +1,298 @@
+package core
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	peer "github.com/libp2p/go-libp2p-core/peer"
+	"github.com/textileio/go-textile/pb"
+)
+
+// webhookTimeout bounds webhook requests to push relays
+const webhookTimeout = time.Second * 10
+
+// webhookDebounce is how long to wait for more inbox messages before posting a webhook event,
+// so that a burst of messages results in a single request
+const webhookDebounce = time.Second * 2
+
+// webhookSignatureHeader carries the hex HMAC-SHA256 of a webhook body keyed w/ the client's secret
+const webhookSignatureHeader = "X-Textile-Signature"
+
+// ErrInvalidWebhook indicates a webhook url is not an absolute http(s) url
+var ErrInvalidWebhook = fmt.Errorf("invalid webhook url")
+
+// errPrivateWebhook indicates a webhook host resolved to an address that is not public
+var errPrivateWebhook = fmt.Errorf("webhook host is not a public address")
+
+// privateNets are address ranges webhooks may not reach unless allowed by the host
+var privateNets = parseCIDRs(
+	"0.0.0.0/8",
+	"10.0.0.0/8",
+	"100.64.0.0/10",
+	"172.16.0.0/12",
+	"192.168.0.0/16",
+	"198.18.0.0/15",
+	"fc00::/7",
+)
+
+// webhookEvent is posted to a client's webhook when inbox messages arrive.
+// Message is the latest of count messages received since the last event.
+type webhookEvent struct {
+	Type    string    `json:"type"`
+	Cafe    string    `json:"cafe"`
+	Client  string    `json:"client"`
+	Message string    `json:"message,omitempty"`
+	Count   int       `json:"count"`
+	Date    time.Time `json:"date"`
+}
+
+// pendingWebhook collects the inbox messages of a client's next webhook event
+type pendingWebhook struct {
+	debouncer *debouncer
+	message   string
+	count     int
+}
+
+// SetCafeWebhook registers a url w/ a cafe session to receive signed events when
+// inbox messages arrive, e.g., an operator-run push relay. An empty url removes it.
+func (t *Textile) SetCafeWebhook(cafeId string, url string, secret string) error {
+	_, err := peer.IDB58Decode(cafeId)
+	if err != nil {
+		return fmt.Errorf("not a valid peerID: %s", cafeId)
+	}
+	if url != "" && !validWebhook(url) {
+		return ErrInvalidWebhook
+	}
+	return t.cafe.setWebhook(cafeId, url, secret)
+}
+
+// setWebhook sends a webhook registration to a cafe
+func (h *CafeService) setWebhook(cafeId string, url string, secret string) error {
+	renv, err := h.sendCafeRequest(cafeId, func(session *pb.CafeSession) (*pb.Envelope, error) {
+		return h.service.NewEnvelope(pb.Message_CAFE_WEBHOOK, &pb.CafeWebhook{
+			Token:  session.Access,
+			Url:    url,
+			Secret: secret,
+		}, nil, false)
+	})
+	if err != nil {
+		return err
+	}
+
+	res := new(pb.CafeWebhookAck)
+	return ptypes.UnmarshalAny(renv.Message.Payload, res)
+}
+
+// handleWebhook receives a webhook registration from a client
+func (h *CafeService) handleWebhook(env *pb.Envelope, pid peer.ID) (*pb.Envelope, error) {
+	hook := new(pb.CafeWebhook)
+	err := ptypes.UnmarshalAny(env.Message.Payload, hook)
+	if err != nil {
+		return nil, err
+	}
+
+	rerr, err := h.authToken(pid, hook.Token, false, env.Message.Request)
+	if err != nil {
+		return nil, err
+	}
+	if rerr != nil {
+		return rerr, nil
+	}
+
+	client := h.datastore.CafeClients().Get(pid.Pretty())
+	if client == nil {
+		return h.service.NewError(403, errForbidden, env.Message.Request)
+	}
+	if hook.Url != "" && !validWebhook(hook.Url) {
+		return h.service.NewError(400, errBadRequest, env.Message.Request)
+	}
+
+	err = h.datastore.CafeClients().UpdateWebhook(client.Id, hook.Url, hook.Secret)
+	if err != nil {
+		return h.service.NewError(500, err.Error(), env.Message.Request)
+	}
+
+	res := &pb.CafeWebhookAck{Url: hook.Url}
+	return h.service.NewEnvelope(pb.Message_CAFE_WEBHOOK_ACK, res, &env.Message.Request, true)
+}
+
+// queueWebhook schedules a webhook event for an inbox message, if the client has a webhook.
+// Messages that arrive within the debounce window of each other are posted as one event.
+func (h *CafeService) queueWebhook(client *pb.CafeClient, msgId string) {
+	if client.WebhookUrl == "" {
+		return
+	}
+
+	h.webhookLock.Lock()
+	defer h.webhookLock.Unlock()
+	pending, ok := h.webhooks[client.Id]
+	if !ok {
+		id := client.Id
+		pending = &pendingWebhook{
+			debouncer: newDebouncer(webhookDebounce, func() {
+				h.flushWebhook(id)
+			}),
+		}
+		pending.debouncer.start()
+		h.webhooks[id] = pending
+	}
+	pending.message = msgId
+	pending.count++
+	pending.debouncer.trigger()
+}
+
+// flushWebhook posts the pending webhook event of a client
+func (h *CafeService) flushWebhook(clientId string) {
+	h.webhookLock.Lock()
+	pending, ok := h.webhooks[clientId]
+	if ok {
+		pending.debouncer.stop()
+		delete(h.webhooks, clientId)
+	}
+	h.webhookLock.Unlock()
+	if !ok {
+		return
+	}
+
+	// the webhook may have changed since the messages were queued
+	client := h.datastore.CafeClients().Get(clientId)
+	if client == nil {
+		return
+	}
+	if err := h.notifyWebhook(client, pending.message, pending.count); err != nil {
+		log.Warningf("error posting webhook for %s: %s", clientId, err)
+	}
+}
+
+// notifyWebhook posts a signed "you have mail" event to a client's webhook, if any
+func (h *CafeService) notifyWebhook(client *pb.CafeClient, msgId string, count int) error {
+	if client.WebhookUrl == "" {
+		return nil
+	}
+	body, err := json.Marshal(&webhookEvent{
+		Type:    pb.Message_CAFE_YOU_HAVE_MAIL.String(),
+		Cafe:    h.service.Node().Identity.Pretty(),
+		Client:  client.Id,
+		Message: msgId,
+		Count:   count,
+		Date:    time.Now().UTC(),
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, client.WebhookUrl, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookSignatureHeader, webhookSignature(client.WebhookSecret, body))
+
+	res, err := h.webhookClient().Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
+
+// webhookSignature returns the hex HMAC-SHA256 of a body keyed w/ secret
+func webhookSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// validWebhook returns whether or not str is an absolute http(s) url
+func validWebhook(str string) bool {
+	u, err := url.Parse(str)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// setWebhookPrivateHosts sets whether or not client webhooks may reach loopback, private,
+// and link-local addresses, e.g., for a push relay on the host's own network
+func (h *CafeService) setWebhookPrivateHosts(allow bool) {
+	h.webhookLock.Lock()
+	defer h.webhookLock.Unlock()
+	h.webhookHTTP = newWebhookClient(allow)
+}
+
+// webhookClient returns the http client used to post webhook events
+func (h *CafeService) webhookClient() *http.Client {
+	h.webhookLock.Lock()
+	defer h.webhookLock.Unlock()
+	if h.webhookHTTP == nil {
+		h.webhookHTTP = newWebhookClient(false)
+	}
+	return h.webhookHTTP
+}
+
+// newWebhookClient returns an http client for webhook events. Unless allowPrivate is set,
+// connections are refused to addresses that are not public. Addresses are checked once
+// resolved, so that a public name can't point requests at the host's own network.
+func newWebhookClient(allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: webhookTimeout}
+	if !allowPrivate {
+		dialer.Control = func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !publicIP(net.ParseIP(host)) {
+				return errPrivateWebhook
+			}
+			return nil
+		}
+	}
+	return &http.Client{
+		Timeout: webhookTimeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: webhookTimeout,
+			IdleConnTimeout:     time.Minute,
+		},
+	}
+}
+
+// publicIP returns whether or not ip is a public unicast address
+func publicIP(ip net.IP) bool {
+	if ip == nil || ip.IsLoopback() || ip.IsUnspecified() || ip.IsMulticast() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() {
+		return false
+	}
+	for _, n := range privateNets {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseCIDRs parses a list of known good CIDR ranges
+func parseCIDRs(cidrs ...string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range cidrs {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}
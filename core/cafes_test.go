@@ -1,15 +1,26 @@
 package core
 
 import (
+	"bytes"
+	"crypto/rand"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
 
 	icid "github.com/ipfs/go-cid"
+	"github.com/ipfs/go-ipfs/pin"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	peer "github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/peerstore"
 	"github.com/segmentio/ksuid"
 	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/jwt"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/schema/textile"
 )
@@ -184,6 +195,527 @@ func TestCore_HandleCafeRequests(t *testing.T) {
 	}
 }
 
+func TestTextile_CafeClientUsage(t *testing.T) {
+	c := cafeVars.cafe
+	clientId := cafeVars.node.Ipfs().Identity.Pretty()
+
+	usage, err := c.CafeClientUsage(clientId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Bytes == 0 || usage.Objects == 0 {
+		t.Fatal("stored objects were not counted")
+	}
+	if len(c.CafeClientUsages().Items) != 1 {
+		t.Fatal("expected usage for one client")
+	}
+	if _, err := c.CafeClientUsage("nope"); err != ErrCafeClientNotFound {
+		t.Fatal("expected client not found")
+	}
+
+	c.cafe.setQuotas(usage.Bytes, 0)
+	defer c.cafe.setQuotas(0, 0)
+	if err := c.cafe.checkQuota(clientId, 0, 1); err != nil {
+		t.Fatalf("expected usage to be within quota: %s", err)
+	}
+	if err := c.cafe.checkQuota(clientId, 1, 1); err != ErrQuotaExceeded {
+		t.Fatal("expected byte quota to be exceeded")
+	}
+	c.cafe.setQuotas(0, usage.Objects)
+	if err := c.cafe.checkQuota(clientId, 0, 1); err != ErrQuotaExceeded {
+		t.Fatal("expected object quota to be exceeded")
+	}
+}
+
+func TestTextile_CafeStoreQuota(t *testing.T) {
+	c := cafeVars.cafe
+	pid := cafeVars.node.Ipfs().Identity
+	clientId := pid.Pretty()
+	session := cafeVars.node.datastore.CafeSessions().Get(c.Ipfs().Identity.Pretty())
+	if session == nil {
+		t.Fatal("missing cafe session")
+	}
+
+	// a chunked object stored for another client
+	data := make([]byte, 1<<20)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	id, err := ipfs.AddData(c.Ipfs(), bytes.NewReader(data), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// clients w/o a reference can't unpin it
+	if err := c.cafe.releaseObject(clientId, *id); err != nil {
+		t.Fatal(err)
+	}
+	not, err := ipfs.NotPinned(c.Ipfs(), []string{id.Hash().B58String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(not) != 0 {
+		t.Fatal("object w/o references should remain pinned")
+	}
+
+	size, err := c.cafe.objectSize(*id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if size < int64(len(data)) {
+		t.Fatalf("expected cumulative size of at least %d, got %d", len(data), size)
+	}
+
+	store := func() *pb.Envelope {
+		env, err := c.cafe.service.NewEnvelope(pb.Message_CAFE_STORE, &pb.CafeStore{
+			Token: session.Access,
+			Cids:  []string{id.Hash().B58String()},
+		}, nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := c.cafe.handleStore(env, pid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return res
+	}
+
+	// references are not charged to a client over quota
+	before := c.cafeClientUsage(clientId)
+	c.cafe.setQuotas(before.Bytes+size-1, 0)
+	defer c.cafe.setQuotas(0, 0)
+	if res := store(); res.Message.Type != pb.Message_ERROR {
+		t.Fatalf("expected a quota error, got %s", res.Message.Type)
+	}
+	if c.datastore.CafeClientObjects().Get(id.Hash().B58String(), clientId) != nil {
+		t.Fatal("reference over quota should not be recorded")
+	}
+	if c.cafeClientUsage(clientId).Bytes != before.Bytes {
+		t.Fatal("reference over quota should not be charged")
+	}
+
+	// references are charged for the whole dag
+	c.cafe.setQuotas(0, 0)
+	if res := store(); res.Message.Type != pb.Message_CAFE_OBJECT_LIST {
+		t.Fatalf("expected an object list, got %s", res.Message.Type)
+	}
+	after := c.cafeClientUsage(clientId)
+	if after.Bytes != before.Bytes+size || after.Objects != before.Objects+1 {
+		t.Fatalf("expected usage to grow by %d bytes, got %d", size, after.Bytes-before.Bytes)
+	}
+
+	// the object was pinned w/o a client reference, so releasing it leaves it pinned
+	if err := c.cafe.releaseObject(clientId, *id); err != nil {
+		t.Fatal(err)
+	}
+	not, err = ipfs.NotPinned(c.Ipfs(), []string{id.Hash().B58String()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(not) != 0 {
+		t.Fatal("untracked object should remain pinned")
+	}
+}
+
+func TestTextile_CafeObjectRefs(t *testing.T) {
+	c := cafeVars.cafe
+	clientId := cafeVars.node.Ipfs().Identity.Pretty()
+
+	objs := c.datastore.CafeClientObjects().ListByClient(clientId)
+	if len(objs) == 0 {
+		t.Fatal("expected client objects")
+	}
+	obj := objs[len(objs)-1]
+	dec, err := icid.Decode(obj.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// another client references the same object
+	err = c.datastore.CafeClientObjects().Add(&pb.CafeClientObject{
+		Id:     obj.Id,
+		Client: "other",
+		Size:   obj.Size,
+		Date:   obj.Date,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a client w/o a reference can't release it
+	if err := c.cafe.releaseObject("stranger", dec); err != nil {
+		t.Fatal(err)
+	}
+	if c.datastore.CafeClientObjects().Count(obj.Id) != 2 {
+		t.Fatal("release w/o a reference should not remove references")
+	}
+
+	if err := c.cafe.releaseObject(clientId, dec); err != nil {
+		t.Fatal(err)
+	}
+	not, err := ipfs.NotPinned(c.Ipfs(), []string{obj.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(not) != 0 {
+		t.Fatal("object referenced by another client should remain pinned")
+	}
+
+	if err := c.cafe.releaseObject("other", dec); err != nil {
+		t.Fatal(err)
+	}
+	not, err = ipfs.NotPinned(c.Ipfs(), []string{obj.Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(not) != 1 {
+		t.Fatal("object should be unpinned after the last reference is released")
+	}
+
+	report, err := c.CafeGC()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Finished == nil || report.Removed == 0 {
+		t.Fatal("gc should remove the released object")
+	}
+	if c.LastCafeGC() != report {
+		t.Fatal("last gc report was not saved")
+	}
+}
+
+func TestTextile_CafeWebhook(t *testing.T) {
+	n := cafeVars.node
+	c := cafeVars.cafe
+	cafeId := c.Ipfs().Identity.Pretty()
+	clientId := n.Ipfs().Identity.Pretty()
+
+	events := make(chan webhookEvent, 4)
+	stub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get(webhookSignatureHeader) != webhookSignature("secret", body) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var event webhookEvent
+		if err := json.Unmarshal(body, &event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		events <- event
+	}))
+	defer stub.Close()
+
+	if err := n.SetCafeWebhook(cafeId, "not a url", "secret"); err != ErrInvalidWebhook {
+		t.Fatal("expected invalid webhook error")
+	}
+	if err := n.SetCafeWebhook(cafeId, stub.URL, "secret"); err != nil {
+		t.Fatal(err)
+	}
+	client := c.datastore.CafeClients().Get(clientId)
+	if client == nil || client.WebhookUrl != stub.URL || client.WebhookSecret != "secret" {
+		t.Fatal("webhook was not stored with client")
+	}
+	if view, err := c.CafeClient(clientId); err != nil || view.WebhookSecret != "" {
+		t.Fatal("webhook secret should not be exposed")
+	}
+
+	// the stub relay is on loopback, which hosts must opt in to
+	if err := c.cafe.notifyWebhook(client, "msg", 1); err == nil || !strings.Contains(err.Error(), errPrivateWebhook.Error()) {
+		t.Fatalf("expected private webhook error, got %v", err)
+	}
+	c.cafe.setWebhookPrivateHosts(true)
+	defer c.cafe.setWebhookPrivateHosts(false)
+
+	if err := c.cafe.notifyWebhook(client, "msg", 1); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case event := <-events:
+		if event.Client != clientId || event.Cafe != cafeId || event.Message != "msg" || event.Count != 1 {
+			t.Fatal("bad webhook event")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("webhook was not posted")
+	}
+
+	// a burst of messages is posted as one event
+	for _, id := range []string{"msg1", "msg2", "msg3"} {
+		c.cafe.queueWebhook(client, id)
+	}
+	select {
+	case event := <-events:
+		if event.Message != "msg3" || event.Count != 3 {
+			t.Fatalf("expected one event for 3 messages, got %d ending w/ %s", event.Count, event.Message)
+		}
+	case <-time.After(webhookDebounce * 3):
+		t.Fatal("webhook was not posted")
+	}
+	select {
+	case <-events:
+		t.Fatal("expected a single webhook event")
+	case <-time.After(webhookDebounce + time.Second):
+	}
+
+	// signatures made w/ the wrong secret are rejected by the relay
+	client.WebhookSecret = "wrong"
+	if err := c.cafe.notifyWebhook(client, "msg", 1); err == nil {
+		t.Fatal("expected error from relay")
+	}
+
+	if err := n.SetCafeWebhook(cafeId, "", ""); err != nil {
+		t.Fatal(err)
+	}
+	if c.datastore.CafeClients().Get(clientId).WebhookUrl != "" {
+		t.Fatal("webhook was not removed")
+	}
+}
+
+func TestPublicIP(t *testing.T) {
+	for ip, public := range map[string]bool{
+		"1.1.1.1":         true,
+		"2606:4700::1111": true,
+		"127.0.0.1":       false,
+		"::1":             false,
+		"0.0.0.0":         false,
+		"10.1.2.3":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"100.64.0.1":      false,
+		"169.254.169.254": false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"224.0.0.1":       false,
+	} {
+		if publicIP(net.ParseIP(ip)) != public {
+			t.Errorf("expected public=%t for %s", public, ip)
+		}
+	}
+}
+
+func TestTextile_CafeMetrics(t *testing.T) {
+	c := cafeVars.cafe
+	clientId := cafeVars.node.Ipfs().Identity.Pretty()
+
+	var buf bytes.Buffer
+	c.WriteMetrics(&buf)
+	out := buf.String()
+	for _, e := range []string{
+		`textile_cafe_requests_total{type="CAFE_STORE"}`,
+		`textile_cafe_request_duration_seconds_count{type="CAFE_STORE"}`,
+		"textile_cafe_clients 1",
+		"textile_cafe_stored_objects",
+		`textile_cafe_inbox_messages{client="` + clientId + `"}`,
+		`textile_queue_items{queue="CAFE_REQUESTS"}`,
+		"ipfs_",
+	} {
+		if !strings.Contains(out, e) {
+			t.Fatalf("expected metrics to contain %s", e)
+		}
+	}
+
+	// client ids are left out of public metrics
+	buf.Reset()
+	c.writeMetrics(&buf, false)
+	out = buf.String()
+	if strings.Contains(out, clientId) {
+		t.Fatal("public metrics should not contain client ids")
+	}
+	if !strings.Contains(out, "textile_cafe_inbox_messages ") {
+		t.Fatal("expected public metrics to contain total inbox messages")
+	}
+}
+
+func TestTextile_CafeClients(t *testing.T) {
+	n := cafeVars.node
+	c := cafeVars.cafe
+	clientId := n.Ipfs().Identity.Pretty()
+
+	list := c.CafeClients()
+	if len(list.Items) != 1 || list.Items[0].Id != clientId {
+		t.Fatal("expected one client")
+	}
+	if list.Items[0].Usage == nil || list.Items[0].Usage.Objects == 0 {
+		t.Fatal("client should include storage usage")
+	}
+
+	// disabled tokens can't register new clients
+	token, err := c.CreateCafeToken("", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DisableCafeToken(token); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := c.ValidateCafeToken(token); ok {
+		t.Fatal("disabled token should not be valid")
+	}
+	if err := c.EnableCafeToken(token); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := c.ValidateCafeToken(token); !ok {
+		t.Fatal("enabled token should be valid")
+	}
+
+	// revoked sessions are rejected
+	session, err := n.CafeSession(c.Ipfs().Identity.Pretty())
+	if err != nil || session == nil {
+		t.Fatal("node should have a cafe session")
+	}
+	rerr, err := c.cafe.authToken(n.Ipfs().Identity, session.Access, false, 0)
+	if err != nil || rerr != nil {
+		t.Fatal("session should be valid")
+	}
+	if err := c.RevokeCafeClientSessions(clientId); err != nil {
+		t.Fatal(err)
+	}
+	rerr, err = c.cafe.authToken(n.Ipfs().Identity, session.Access, false, 0)
+	if err != nil || rerr == nil {
+		t.Fatal("revoked session should be rejected")
+	}
+
+	// purged clients keep their registration but lose their data
+	objs := c.datastore.CafeClientObjects().ListByClient(clientId)
+	if len(objs) == 0 {
+		t.Fatal("expected client objects")
+	}
+	if err := c.PurgeCafeClient(clientId); err != nil {
+		t.Fatal(err)
+	}
+	if len(c.datastore.CafeClientObjects().ListByClient(clientId)) != 0 {
+		t.Fatal("client objects were not purged")
+	}
+	if len(c.datastore.CafeClientThreads().ListByClient(clientId)) != 0 {
+		t.Fatal("client threads were not purged")
+	}
+	not, err := ipfs.NotPinned(c.Ipfs(), []string{objs[0].Id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(not) != 1 {
+		t.Fatal("client objects were not unpinned")
+	}
+	usage, err := c.CafeClientUsage(clientId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage.Bytes != 0 || usage.Objects != 0 {
+		t.Fatal("client usage was not reset")
+	}
+}
+
+func TestTextile_CafeNeighbors(t *testing.T) {
+	n := cafeVars.node
+	c := cafeVars.cafe
+	neighborId := n.Ipfs().Identity
+	defer c.cafe.setNeighbors(nil)
+
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	clientId, err := peer.IDFromPrivateKey(sk)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// sessions issued by neighbors are only trusted once configured
+	session, err := jwt.NewSession(n.Ipfs().PrivateKey, clientId, cafeServiceProtocol, time.Hour, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rerr, err := c.cafe.authToken(clientId, session.Access, false, 0)
+	if err != nil || rerr == nil {
+		t.Fatal("session from unknown cafe should be rejected")
+	}
+	c.cafe.setNeighbors([]string{neighborId.Pretty()})
+	rerr, err = c.cafe.authToken(clientId, session.Access, false, 0)
+	if err != nil || rerr != nil {
+		t.Fatal("session from neighbor should be valid")
+	}
+
+	// replicated data is only accepted from neighbors
+	env, err := n.cafe.service.NewEnvelope(pb.Message_CAFE_REPLICATE, &pb.CafeReplicate{
+		Type:       pb.CafeReplicate_STORE_THREAD,
+		Client:     clientId.Pretty(),
+		Thread:     "thread",
+		Ciphertext: []byte("ciphertext"),
+	}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.cafe.setNeighbors(nil)
+	if _, err := c.cafe.handleReplicate(env, neighborId); err != nil {
+		t.Fatal(err)
+	}
+	if c.datastore.CafeClients().Get(clientId.Pretty()) != nil {
+		t.Fatal("replication from unknown cafe should be ignored")
+	}
+	c.cafe.setNeighbors([]string{neighborId.Pretty()})
+	if _, err := c.cafe.handleReplicate(env, neighborId); err != nil {
+		t.Fatal(err)
+	}
+	if c.datastore.CafeClients().Get(clientId.Pretty()) == nil {
+		t.Fatal("replicated client was not added")
+	}
+	thrds := c.datastore.CafeClientThreads().ListByClient(clientId.Pretty())
+	if len(thrds) != 1 || string(thrds[0].Ciphertext) != "ciphertext" {
+		t.Fatal("replicated thread was not stored")
+	}
+
+	// replicated objects are fetched and pinned in full
+	data := make([]byte, 1<<20)
+	if _, err := rand.Read(data); err != nil {
+		t.Fatal(err)
+	}
+	id, err := ipfs.AddData(n.Ipfs(), bytes.NewReader(data), true, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err = n.cafe.service.NewEnvelope(pb.Message_CAFE_REPLICATE, &pb.CafeReplicate{
+		Type:   pb.CafeReplicate_STORE,
+		Client: clientId.Pretty(),
+		Cids:   []string{id.Hash().B58String()},
+	}, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.cafe.handleReplicate(env, neighborId); err != nil {
+		t.Fatal(err)
+	}
+	node, err := ipfs.NodeAtCid(c.Ipfs(), *id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(node.Links()) == 0 {
+		t.Fatal("expected a chunked object")
+	}
+	for _, link := range node.Links() {
+		has, err := c.Ipfs().Blockstore.Has(link.Cid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !has {
+			t.Fatal("replicated object is missing blocks")
+		}
+	}
+	_, recursive, err := c.Ipfs().Pinning.IsPinnedWithType(*id, pin.Recursive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !recursive {
+		t.Fatal("replicated object should be pinned recursively")
+	}
+	obj := c.datastore.CafeClientObjects().Get(id.Hash().B58String(), clientId.Pretty())
+	if obj == nil || obj.Size < int64(len(data)) {
+		t.Fatal("replicated object was not recorded w/ its full size")
+	}
+}
+
 func TestCore_TeardownCafes(t *testing.T) {
 	_ = cafeVars.node.Stop()
 	_ = cafeVars.cafe.Stop()
@@ -52,6 +52,9 @@ func applyTextileConfigOptions(init InitConfig) error {
 	if init.ApiAddr != "" {
 		conf.Addresses.API = init.ApiAddr
 	}
+	if init.GrpcAddr != "" {
+		conf.Addresses.GRPC = init.GrpcAddr
+	}
 	if init.CafeApiAddr != "" {
 		conf.Addresses.CafeAPI = init.CafeApiAddr
 	}
@@ -21,12 +21,13 @@ import (
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
 	ipld "github.com/ipfs/go-ipld-format"
 	logging "github.com/ipfs/go-log"
-	"github.com/ipfs/go-metrics-interface"
+	imetrics "github.com/ipfs/go-metrics-interface"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/peerstore"
 	"github.com/textileio/go-textile/broadcast"
 	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/keypair"
+	"github.com/textileio/go-textile/metrics"
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
 	"github.com/textileio/go-textile/repo/config"
@@ -41,11 +42,19 @@ import (
 var log = logging.Logger("tex-core")
 
 // kJobFreq how often to flush the message queues
+// note: enqueuing triggers a debounced flush, this is a safety net
 const kJobFreq = time.Second * 60
 
 // kMobileJobFreq how often to flush the message queues on mobile
 const kMobileJobFreq = time.Second * 40
 
+// default debounce windows for flushes triggered by enqueuing
+const (
+	kBlockOutboxDebounce    = time.Second
+	kCafeOutboxDebounce     = time.Second * 5
+	kBlockDownloadsDebounce = time.Second
+)
+
 // kSyncAccountFreq how often to run account sync
 const kSyncAccountFreq = time.Hour
 
@@ -57,6 +66,7 @@ type InitConfig struct {
 	BaseRepoPath    string
 	SwarmPorts      string
 	ApiAddr         string
+	GrpcAddr        string
 	CafeApiAddr     string
 	GatewayAddr     string
 	ProfilingAddr   string
@@ -99,8 +109,10 @@ type Textile struct {
 	online            chan struct{}
 	done              chan struct{}
 	updates           chan *pb.AccountUpdate
+	accountUpdates    *broadcast.Broadcaster
 	threadUpdates     *broadcast.Broadcaster
 	notifications     chan *pb.Notification
+	notificationFeed  *broadcast.Broadcaster
 	threads           *ThreadsService
 	blockOutbox       *BlockOutbox
 	blockDownloads    *BlockDownloads
@@ -108,8 +120,11 @@ type Textile struct {
 	cafeOutbox        *CafeOutbox
 	cafeOutboxHandler CafeOutboxHandler
 	cafeInbox         *CafeInbox
+	postErr           queueError
 	checkMessages     func() error
 	cancelSync        *broadcast.Broadcaster
+	apiKeys           map[string]string
+	apiKeysLock       sync.Mutex
 	lock              sync.Mutex
 	writer            io.Writer
 }
@@ -269,10 +284,13 @@ func NewTextile(conf RunConfig) (*Textile, error) {
 		repoPath:          conf.RepoPath,
 		pinCode:           conf.PinCode,
 		updates:           make(chan *pb.AccountUpdate, 10),
+		accountUpdates:    broadcast.NewBroadcaster(10),
 		threadUpdates:     broadcast.NewBroadcaster(10),
 		notifications:     make(chan *pb.Notification, 10),
+		notificationFeed:  broadcast.NewBroadcaster(10),
 		cafeOutboxHandler: conf.CafeOutboxHandler,
 		checkMessages:     conf.CheckMessages,
+		apiKeys:           make(map[string]string),
 	}
 
 	node.config, err = config.Read(node.repoPath)
@@ -359,7 +377,8 @@ func (t *Textile) Start() error {
 	t.blockDownloads = NewBlockDownloads(
 		t.Ipfs,
 		t.datastore,
-		t.Thread)
+		t.Thread,
+		debounceWindow(t.config.Queues.BlockDownloadsDebounce, kBlockDownloadsDebounce))
 	t.cafeInbox = NewCafeInbox(
 		t.cafeService,
 		t.threadsService,
@@ -369,12 +388,14 @@ func (t *Textile) Start() error {
 		t.Ipfs,
 		t.datastore,
 		t.cafeOutboxHandler,
-		t.FlushBlocks)
+		t.FlushBlocks,
+		debounceWindow(t.config.Queues.CafeOutboxDebounce, kCafeOutboxDebounce))
 	t.blockOutbox = NewBlockOutbox(
 		t.threadsService,
 		t.Ipfs,
 		t.datastore,
-		t.cafeOutbox)
+		t.cafeOutbox,
+		debounceWindow(t.config.Queues.BlockOutboxDebounce, kBlockOutboxDebounce))
 
 	// create services
 	t.threads = NewThreadsService(
@@ -423,9 +444,15 @@ func (t *Textile) Start() error {
 
 		if t.config.Cafe.Host.Open {
 			go func() {
+				t.cafe.setNeighbors(t.config.Cafe.Host.Neighbors)
 				t.cafe.setAddrs(t.config)
+				t.cafe.setQuotas(t.config.Cafe.Host.ClientByteQuota, t.config.Cafe.Host.ClientObjectQuota)
+				t.cafe.setWebhookPrivateHosts(t.config.Cafe.Host.AllowPrivateWebhooks)
 				t.cafe.open = true
 				t.startCafeApi(t.config.Addresses.CafeAPI)
+				if interval := cafeGCInterval(t.config.Cafe.Host.GCInterval); interval > 0 {
+					go t.runCafeGC(interval)
+				}
 			}()
 		}
 
@@ -457,6 +484,7 @@ func (t *Textile) Start() error {
 	}
 
 	go t.loadThreadSchemas()
+	go t.reclockThreads()
 
 	t.started = true
 
@@ -504,6 +532,11 @@ func (t *Textile) Stop() error {
 	}()
 	log.Info("stopping node...")
 
+	// stop triggered flushes
+	t.blockOutbox.debounce.stop()
+	t.cafeOutbox.debounce.stop()
+	t.blockDownloads.debounce.stop()
+
 	// stop sync if in progress
 	if t.cancelSync != nil {
 		t.cancelSync.Close()
@@ -538,8 +571,10 @@ func (t *Textile) Stop() error {
 // CloseChns closes update channels
 func (t *Textile) CloseChns() {
 	close(t.updates)
+	t.accountUpdates.Close()
 	t.threadUpdates.Close()
 	close(t.notifications)
+	t.notificationFeed.Close()
 }
 
 // Started returns node started status
@@ -620,6 +655,12 @@ func (t *Textile) UpdateCh() <-chan *pb.AccountUpdate {
 	return t.updates
 }
 
+// AccountUpdateListener returns a listener for account updates.
+// Unlike UpdateCh, any number of listeners receive every update.
+func (t *Textile) AccountUpdateListener() *broadcast.Listener {
+	return t.accountUpdates.Listen()
+}
+
 // ThreadUpdateListener returns the thread update channel
 func (t *Textile) ThreadUpdateListener() *broadcast.Listener {
 	return t.threadUpdates.Listen()
@@ -630,6 +671,12 @@ func (t *Textile) NotificationCh() <-chan *pb.Notification {
 	return t.notifications
 }
 
+// NotificationListener returns a listener for notifications.
+// Unlike NotificationCh, any number of listeners receive every notification.
+func (t *Textile) NotificationListener() *broadcast.Listener {
+	return t.notificationFeed.Listen()
+}
+
 // PeerId returns peer id
 func (t *Textile) PeerId() (peer.ID, error) {
 	return t.node.Identity, nil
@@ -658,70 +705,94 @@ func (t *Textile) SetLogLevel(level *pb.LogLevel, color bool) error {
 
 // FlushBlocks flushes the block message outbox
 func (t *Textile) FlushBlocks() {
-	query := fmt.Sprintf("status=%d", pb.Block_QUEUED)
+	query := fmt.Sprintf("status=%d and retry<=%d", pb.Block_QUEUED, time.Now().UnixNano())
 	queued := t.datastore.Blocks().List("", -1, query)
 	sort.SliceStable(queued.Items, func(i, j int) bool {
 		return util.ProtoTime(queued.Items[i].Date).Before(
 			util.ProtoTime(queued.Items[j].Date))
 	})
-	wg := sync.WaitGroup{}
+
+	// blocks in the same thread are posted in order so that each is a parent of the next
+	groups := make(map[string][]*pb.Block)
 	for _, block := range queued.Items {
 		if t.datastore.CafeRequests().SyncGroupComplete(block.Id) {
-			wg.Add(1)
-			go func(block *pb.Block) {
-				var posted bool
-				defer func() {
-					t.blockOutbox.Flush()
-					if posted {
-						go t.cafeOutbox.Flush(true)
-					} else if t.cafeOutbox.handler != nil {
-						t.cafeOutbox.handler.Flush()
-					}
-					wg.Done()
-				}()
-
-				thread := t.Thread(block.Thread)
-				if thread == nil {
-					return
-				}
+			groups[block.Thread] = append(groups[block.Thread], block)
+		}
+	}
+	wg := sync.WaitGroup{}
+	for _, group := range groups {
+		wg.Add(1)
+		go func(blocks []*pb.Block) {
+			for _, block := range blocks {
+				t.postBlock(block)
+			}
+			wg.Done()
+		}(group)
+	}
+	wg.Wait()
+}
 
-				// if this is not a join, ensure it will hava at least one parent
-				if block.Type != pb.Block_JOIN {
-					heads, err := thread.Heads()
-					if err != nil {
-						log.Warningf("error getting heads: %s", err)
-						return
-					}
-					if len(heads) == 0 {
-						return
-					}
-				}
+// postBlock posts a single queued block
+func (t *Textile) postBlock(block *pb.Block) {
+	var posted bool
+	defer func() {
+		t.blockOutbox.Flush()
+		if posted {
+			go t.cafeOutbox.Flush(true)
+		} else if t.cafeOutbox.handler != nil {
+			t.cafeOutbox.handler.Flush()
+		}
+	}()
 
-				err := thread.post(block)
-				if err != nil {
-					log.Errorf("error posting block %s: %s", block.Id, err)
-					if block.Attempts+1 >= maxDownloadAttempts {
-						err = t.datastore.Blocks().Delete(block.Id)
-					} else {
-						err = t.datastore.Blocks().AddAttempt(block.Id)
-					}
-					if err != nil {
-						log.Errorf("error handling post error: %s", err)
-					}
-					return
-				}
-				posted = true
+	thread := t.Thread(block.Thread)
+	if thread == nil {
+		return
+	}
 
-				err = t.datastore.CafeRequests().DeleteBySyncGroup(block.Id)
-				if err != nil {
-					log.Error(err)
-				} else {
-					log.Debugf("deleted sync group: %s", block.Id)
-				}
-			}(block)
+	// a concurrent flush may have already posted this block
+	thread.lock.Lock()
+	defer thread.lock.Unlock()
+	if current := t.datastore.Blocks().Get(block.Id); current == nil || current.Status != pb.Block_QUEUED {
+		return
+	}
+
+	// if this is not a join, ensure it will hava at least one parent
+	if block.Type != pb.Block_JOIN {
+		heads, err := thread.Heads()
+		if err != nil {
+			log.Warningf("error getting heads: %s", err)
+			return
+		}
+		if len(heads) == 0 {
+			return
 		}
 	}
-	wg.Wait()
+
+	err := thread.post(block)
+	if err != nil {
+		log.Errorf("error posting block %s: %s", block.Id, err)
+		t.postErr.set(err)
+		if block.Attempts+1 >= maxDownloadAttempts {
+			err = addDeadLetter(t.datastore, pb.DeadLetter_BLOCK_POSTS, block.Id, block, block.Attempts+1, err)
+			if err == nil {
+				err = t.datastore.Blocks().Delete(block.Id)
+			}
+		} else {
+			err = t.datastore.Blocks().AddAttempt(block.Id, nextRetry(block.Attempts+1))
+		}
+		if err != nil {
+			log.Errorf("error handling post error: %s", err)
+		}
+		return
+	}
+	posted = true
+
+	err = t.datastore.CafeRequests().DeleteBySyncGroup(block.Id)
+	if err != nil {
+		log.Error(err)
+	} else {
+		log.Debugf("deleted sync group: %s", block.Id)
+	}
 }
 
 // FlushCafes flushes the cafe request outbox
@@ -767,8 +838,9 @@ func (t *Textile) createNode() error {
 		Routing: routing,
 	}
 
+	metrics.Inject()
 	ctx := context.Background()
-	ctx = metrics.CtxScope(ctx, "ipfs")
+	ctx = imetrics.CtxScope(ctx, "ipfs")
 
 	n := &core.IpfsNode{}
 	t.ctx = ctx
@@ -826,6 +898,16 @@ func (t *Textile) runJobs() {
 	tick := time.NewTicker(freq)
 	defer tick.Stop()
 
+	// from now on, enqueuing triggers a flush
+	t.blockOutbox.debounce.start()
+	t.cafeOutbox.debounce.start()
+	t.blockDownloads.debounce.start()
+	defer func() {
+		t.blockOutbox.debounce.stop()
+		t.cafeOutbox.debounce.stop()
+		t.blockDownloads.debounce.stop()
+	}()
+
 	go t.flushQueues()
 	t.maybeSyncAccount()
 
@@ -923,6 +1005,16 @@ func (t *Textile) loadThreadSchemas() {
 	}
 }
 
+// reclockThreads computes causal clocks that are not yet known, e.g., after a migration
+func (t *Textile) reclockThreads() {
+	for _, l := range t.loadedThreads {
+		err := l.reclock()
+		if err != nil {
+			log.Errorf("unable to clock blocks in %s: %s", l.Id, err)
+		}
+	}
+}
+
 // sendUpdate sends an update to the update channel
 func (t *Textile) sendUpdate(update *pb.AccountUpdate) {
 	if (update.Type == pb.AccountUpdate_THREAD_ADDED ||
@@ -931,6 +1023,7 @@ func (t *Textile) sendUpdate(update *pb.AccountUpdate) {
 		return
 	}
 	t.updates <- update
+	t.accountUpdates.Send(update)
 }
 
 // sendThreadUpdate sends a feed item to the update channel
@@ -954,7 +1047,9 @@ func (t *Textile) sendNotification(note *pb.Notification) error {
 		return err
 	}
 
-	t.notifications <- t.NotificationView(note)
+	view := t.NotificationView(note)
+	t.notifications <- view
+	t.notificationFeed.Send(view)
 	return nil
 }
 
@@ -1,17 +1,30 @@
 package core
 
 import (
+	"bytes"
+	"crypto/rand"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/golang/protobuf/ptypes"
+	icid "github.com/ipfs/go-cid"
+	"github.com/ipfs/go-ipfs/pin"
+	"github.com/textileio/go-textile/ipfs"
 	"github.com/textileio/go-textile/keypair"
 	"github.com/textileio/go-textile/util"
 
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/mr-tron/base58/base58"
 	"github.com/segmentio/ksuid"
 	"github.com/textileio/go-textile/mill"
 	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema"
 	"github.com/textileio/go-textile/schema/textile"
 )
 
@@ -24,6 +37,8 @@ var vars = struct {
 	token string
 
 	schemaHash string
+
+	archive []byte
 }{
 	initConfig: InitConfig{
 		BaseRepoPath: "testdata/.textile1",
@@ -258,6 +273,637 @@ func TestTextile_AddFile(t *testing.T) {
 	}
 }
 
+func TestTextile_ServeFileContent(t *testing.T) {
+	list, err := vars.node.Files("", 1, vars.thread.Id, pb.Block_DATE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) == 0 {
+		t.Fatal("missing files")
+	}
+	file := list.Items[0].Files[0].File
+	for _, f := range list.Items[0].Files[0].Links {
+		file = f
+		break
+	}
+
+	serveCache := func(header string, value string, cache ContentCache) *httptest.ResponseRecorder {
+		reader, err := vars.node.FileIndexReader(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer reader.Close()
+
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		if header != "" {
+			req.Header.Set(header, value)
+		}
+		res := httptest.NewRecorder()
+		ServeFileContent(res, req, reader, file.Hash, file.Media, file.Name, cache)
+		return res
+	}
+	serve := func(header string, value string) *httptest.ResponseRecorder {
+		return serveCache(header, value, CachePrivate)
+	}
+
+	res := serve("", "")
+	if res.Code != http.StatusOK || int64(res.Body.Len()) != file.Size {
+		t.Fatalf("expected full content, got %d w/ %d bytes", res.Code, res.Body.Len())
+	}
+	if res.Header().Get("Content-Type") != file.Media {
+		t.Fatal("wrong content type")
+	}
+	etag := res.Header().Get("ETag")
+	if etag != `"`+file.Hash+`"` {
+		t.Fatalf("wrong etag: %s", etag)
+	}
+	if res.Header().Get("Cache-Control") != "private, max-age=31536000, immutable" {
+		t.Fatalf("wrong cache control: %s", res.Header().Get("Cache-Control"))
+	}
+	full := res.Body.Bytes()
+
+	res = serveCache("", "", CachePublic)
+	if res.Header().Get("Cache-Control") != "public, max-age=31536000, immutable" {
+		t.Fatalf("wrong cache control: %s", res.Header().Get("Cache-Control"))
+	}
+	res = serveCache("", "", CacheNone)
+	if res.Header().Get("Cache-Control") != "" {
+		t.Fatalf("wrong cache control: %s", res.Header().Get("Cache-Control"))
+	}
+
+	res = serve("Range", "bytes=10-19")
+	if res.Code != http.StatusPartialContent {
+		t.Fatalf("expected partial content, got %d", res.Code)
+	}
+	if !bytes.Equal(res.Body.Bytes(), full[10:20]) {
+		t.Fatal("wrong range content")
+	}
+
+	res = serve("If-None-Match", etag)
+	if res.Code != http.StatusNotModified {
+		t.Fatalf("expected not modified, got %d", res.Code)
+	}
+}
+
+func TestTextile_FileLinks(t *testing.T) {
+	list, err := vars.node.Files("", 1, vars.thread.Id, pb.Block_DATE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) == 0 {
+		t.Fatal("missing files")
+	}
+	files := list.Items[0]
+
+	name := "."
+	for n := range files.Files[0].Links {
+		name = n
+		break
+	}
+	if _, err := FilesFile(files, 0, name); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := FilesFile(files, len(files.Files), name); err != ErrFileNotFound {
+		t.Fatal("expected file not found")
+	}
+
+	pth := FileLinkPath(vars.thread.Id, files.Block, 0, name)
+	if _, err := vars.node.SignFileLink(pth, MaxFileLinkExpiry+time.Second); err == nil {
+		t.Fatal("expected expiry limit error")
+	}
+	query, err := vars.node.SignFileLink(pth, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := vars.node.VerifyFileLink(pth, query.Get("expires"), query.Get("sig")); err != nil {
+		t.Fatal(err)
+	}
+
+	other := FileLinkPath(vars.thread.Id, files.Block, 1, ".")
+	if err := vars.node.VerifyFileLink(other, query.Get("expires"), query.Get("sig")); err != ErrInvalidFileLink {
+		t.Fatal("expected link to be invalid for another file")
+	}
+	later := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+	if err := vars.node.VerifyFileLink(pth, later, query.Get("sig")); err != ErrInvalidFileLink {
+		t.Fatal("expected link to be invalid w/ a changed expiry")
+	}
+
+	past := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	sig := base58.FastBase58Encoding(vars.node.fileLinkMAC(pth, past))
+	if err := vars.node.VerifyFileLink(pth, past, sig); err != ErrFileLinkExpired {
+		t.Fatal("expected link to be expired")
+	}
+}
+
+func TestTextile_ExportThread(t *testing.T) {
+	// the first pass posts the join, which the remaining blocks need as a parent
+	vars.node.FlushBlocks()
+	vars.node.FlushBlocks()
+	var buf bytes.Buffer
+	err := vars.node.ExportThread(vars.thread.Id, &buf)
+	if err != nil {
+		t.Fatalf("export thread failed: %s", err)
+	}
+	vars.archive = buf.Bytes()
+
+	archive, err := ReadThreadArchive(bytes.NewReader(vars.archive))
+	if err != nil {
+		t.Fatalf("read thread archive failed: %s", err)
+	}
+	if archive.Thread.Id != vars.thread.Id {
+		t.Fatal("archive has wrong thread id")
+	}
+	if len(archive.Blocks) == 0 {
+		t.Fatal("archive has no blocks")
+	}
+}
+
+func TestTextile_ImportThread(t *testing.T) {
+	query := fmt.Sprintf("threadId='%s' and type=%d", vars.thread.Id, pb.Block_FILES)
+	filesBlock := vars.node.datastore.Blocks().List("", 1, query).Items[0]
+	_, err := vars.node.RemoveThread(vars.thread.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	filesId, err := icid.Decode(filesBlock.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the import should restore the pins even if the files were unpinned meanwhile
+	_ = ipfs.UnpinCid(vars.node.Ipfs(), filesId, true)
+
+	archive, err := ReadThreadArchive(bytes.NewReader(vars.archive))
+	if err != nil {
+		t.Fatal(err)
+	}
+	thrd, err := vars.node.ImportThread(archive)
+	if err != nil {
+		t.Fatalf("import thread failed: %s", err)
+	}
+	if thrd.Id != vars.thread.Id {
+		t.Fatal("imported thread has wrong id")
+	}
+
+	files := vars.node.datastore.Blocks().Count(fmt.Sprintf("threadId='%s' and type=%d", thrd.Id, pb.Block_FILES))
+	if files != 1 {
+		t.Fatal("imported thread is missing files")
+	}
+	_, pinned, err := vars.node.Ipfs().Pinning.IsPinnedWithType(filesId, pin.Recursive)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pinned {
+		t.Fatal("imported files are not pinned")
+	}
+	vars.thread = thrd
+}
+
+func TestTextile_ReadThreadArchiveLimits(t *testing.T) {
+	limits := threadArchiveLimits
+	defer func() {
+		threadArchiveLimits = limits
+	}()
+
+	threadArchiveLimits.blocks = 1
+	_, err := ReadThreadArchive(bytes.NewReader(vars.archive))
+	if err != ErrThreadArchiveTooLarge {
+		t.Fatalf("expected too many blocks to be rejected, got %v", err)
+	}
+
+	threadArchiveLimits = limits
+	threadArchiveLimits.size = int64(len(vars.archive) / 2)
+	_, err = ReadThreadArchive(bytes.NewReader(vars.archive))
+	if err != ErrThreadArchiveTooLarge {
+		t.Fatalf("expected a large archive to be rejected, got %v", err)
+	}
+}
+
+func TestTextile_CausalOrder(t *testing.T) {
+	query := fmt.Sprintf("threadId='%s'", vars.thread.Id)
+	blocks := vars.node.SortedBlocks("", -1, query, pb.Block_CAUSAL).Items
+	if len(blocks) == 0 {
+		t.Fatal("causal order is missing blocks")
+	}
+	for i, block := range blocks {
+		if block.Clock == 0 {
+			t.Fatalf("block %s was not clocked", block.Id)
+		}
+		if i > 0 && block.Clock > blocks[i-1].Clock {
+			t.Fatal("blocks are not in causal order")
+		}
+	}
+	if blocks[len(blocks)-1].Clock != 1 {
+		t.Fatal("genesis block has wrong clock")
+	}
+
+	feed, err := vars.node.Feed(&pb.FeedRequest{
+		Thread: vars.thread.Id,
+		Limit:  -1,
+		Mode:   pb.FeedRequest_CHRONO,
+		Sort:   pb.Block_CAUSAL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(feed.Items) == 0 {
+		t.Fatal("causal feed is empty")
+	}
+}
+
+func TestTextile_ForkThread(t *testing.T) {
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fork, err := vars.node.ForkThread(vars.thread.Id, pb.AddThreadConfig{
+		Key:       ksuid.New().String(),
+		Name:      "fork",
+		Type:      pb.Thread_PRIVATE,
+		Sharing:   pb.Thread_NOT_SHARED,
+		Whitelist: []string{},
+	}, sk, "", nil)
+	if err != nil {
+		t.Fatalf("fork thread failed: %s", err)
+	}
+
+	mod := vars.node.datastore.Threads().Get(fork.Id)
+	if mod.ForkThread != vars.thread.Id {
+		t.Fatal("fork is missing origin thread")
+	}
+	if mod.Schema != vars.schemaHash {
+		t.Fatal("fork has wrong schema")
+	}
+	files := vars.node.datastore.Blocks().Count(fmt.Sprintf("threadId='%s' and type=%d", fork.Id, pb.Block_FILES))
+	if files != 1 {
+		t.Fatal("fork is missing files")
+	}
+}
+
+func TestTextile_ForkThreadFailure(t *testing.T) {
+	origin, err := addTestThread(vars.node, &pb.AddThreadConfig{
+		Key:       ksuid.New().String(),
+		Name:      "origin",
+		Schema:    &pb.AddThreadConfig_Schema{Id: vars.schemaHash},
+		Type:      pb.Thread_OPEN,
+		Sharing:   pb.Thread_NOT_SHARED,
+		Whitelist: []string{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = origin.AddMessage("", "first")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = addData(vars.node, []string{"../mill/testdata/image.jpeg"}, origin, "second")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the first pass posts the join, which the remaining blocks need as a parent
+	vars.node.FlushBlocks()
+	vars.node.FlushBlocks()
+
+	// the message copies, but the files don't validate against the fork's schema
+	avatars, err := vars.node.AddSchema(textile.Avatars, "avatars")
+	if err != nil {
+		t.Fatal(err)
+	}
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := ksuid.New().String()
+	threads := len(vars.node.Threads())
+	_, err = vars.node.ForkThread(origin.Id, pb.AddThreadConfig{
+		Key:       key,
+		Name:      "broken fork",
+		Schema:    &pb.AddThreadConfig_Schema{Id: avatars.Hash},
+		Type:      pb.Thread_PRIVATE,
+		Sharing:   pb.Thread_NOT_SHARED,
+		Whitelist: []string{},
+	}, sk, "", nil)
+	if err == nil {
+		t.Fatal("fork w/ invalid files should fail")
+	}
+
+	if vars.node.ThreadByKey(key) != nil || len(vars.node.Threads()) != threads {
+		t.Fatal("failed fork was not removed")
+	}
+	if vars.node.datastore.Threads().GetByKey(key) != nil {
+		t.Fatal("failed fork was not deleted")
+	}
+	copies := vars.node.datastore.Blocks().Count(fmt.Sprintf("type=%d and body='first'", pb.Block_TEXT))
+	if copies != 1 {
+		t.Fatal("failed fork blocks were not deleted")
+	}
+}
+
+func TestTextile_VerifyThread(t *testing.T) {
+	res, err := vars.node.VerifyThread(vars.thread.Id, false)
+	if err != nil {
+		t.Fatalf("verify thread failed: %s", err)
+	}
+	if res.Count == 0 {
+		t.Fatal("verify did not walk any nodes")
+	}
+	if len(res.Issues) > 0 {
+		t.Fatalf("verify found unexpected issues: %v", res.Issues)
+	}
+
+	// break pin state and the file index
+	block := vars.node.datastore.Blocks().List("", 1, fmt.Sprintf("threadId='%s' and type=%d", vars.thread.Id, pb.Block_FILES)).Items[0]
+	id, err := icid.Decode(block.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ipfs.UnpinCid(vars.node.Ipfs(), id, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nd, err := ipfs.NodeAtPath(vars.node.Ipfs(), block.Data+"/0", ipfs.DefaultTimeout)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !looksLikeFileNode(nd) {
+		nd, err = ipfs.NodeAtLink(vars.node.Ipfs(), nd.Links()[0])
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	dlink := schema.LinkByName(nd.Links(), ValidContentLinkNames)
+	err = vars.node.datastore.Files().RemoveTarget(dlink.Cid.Hash().B58String(), block.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err = vars.node.VerifyThread(vars.thread.Id, true)
+	if err != nil {
+		t.Fatalf("verify thread failed: %s", err)
+	}
+	var unpinned, missing bool
+	for _, issue := range res.Issues {
+		if !issue.Repaired {
+			t.Fatalf("issue was not repaired: %s", issue.Msg)
+		}
+		switch issue.Type {
+		case pb.ThreadVerification_Issue_UNPINNED:
+			unpinned = true
+		case pb.ThreadVerification_Issue_MISSING_FILE:
+			missing = true
+		}
+	}
+	if !unpinned || !missing {
+		t.Fatalf("verify did not find issues: %v", res.Issues)
+	}
+
+	res, err = vars.node.VerifyThread(vars.thread.Id, false)
+	if err != nil {
+		t.Fatalf("verify thread failed: %s", err)
+	}
+	if len(res.Issues) > 0 {
+		t.Fatalf("repair did not fix issues: %v", res.Issues)
+	}
+}
+
+func TestTextile_ThreadGraph(t *testing.T) {
+	graph, err := vars.node.ThreadGraph(vars.thread.Id, "", 100)
+	if err != nil {
+		t.Fatalf("thread graph failed: %s", err)
+	}
+	count := vars.node.datastore.Blocks().Count(fmt.Sprintf("threadId='%s'", vars.thread.Id))
+	if len(graph.Nodes) != count {
+		t.Fatalf("graph has %d nodes, expected %d", len(graph.Nodes), count)
+	}
+	if len(graph.Authors) == 0 {
+		t.Fatal("graph is missing authors")
+	}
+
+	rows := make(map[string]int32)
+	var head bool
+	for _, n := range graph.Nodes {
+		if n.Lane >= graph.Lanes {
+			t.Fatalf("node %s is outside of %d lanes", n.Id, graph.Lanes)
+		}
+		rows[n.Id] = n.Row
+		head = head || n.Head
+	}
+	if !head {
+		t.Fatal("graph is missing a head")
+	}
+	for _, e := range graph.Edges {
+		if e.Missing || e.Outside {
+			t.Fatalf("unexpected dangling edge from %s", e.From)
+		}
+		if rows[e.To] <= rows[e.From] {
+			t.Fatalf("parent %s is not below child %s", e.To, e.From)
+		}
+	}
+}
+
+func TestTextile_DeadLetters(t *testing.T) {
+	retry := nextRetry(1)
+	if retry.Before(time.Now().Add(retryBaseDelay)) || retry.After(time.Now().Add(retryBaseDelay*2)) {
+		t.Fatalf("retry %s is outside of backoff window", retry)
+	}
+
+	msg := pb.CafeMessage{
+		Id:       ksuid.New().String(),
+		Peer:     "invalid",
+		Date:     ptypes.TimestampNow(),
+		Attempts: cafeInMaxDownloadAttempts - 1,
+	}
+	inbox := func() bool {
+		for _, m := range vars.node.datastore.CafeMessages().List("", -1) {
+			if m.Id == msg.Id {
+				return true
+			}
+		}
+		return false
+	}
+	kill := func() {
+		err := vars.node.datastore.CafeMessages().Add(&msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := vars.node.cafeInbox.handle(msg); err == nil {
+			t.Fatal("handle should have failed")
+		}
+		if inbox() {
+			t.Fatal("exhausted message was not removed from inbox")
+		}
+	}
+	kill()
+
+	letter, err := vars.node.DeadLetter(msg.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if letter.Queue != pb.DeadLetter_CAFE_INBOX || letter.Attempts != cafeInMaxDownloadAttempts || letter.Error == "" {
+		t.Fatalf("unexpected dead letter: %s", letter.String())
+	}
+	list := vars.node.DeadLetters("", -1, pb.DeadLetter_CAFE_INBOX)
+	if len(list.Items) != 1 {
+		t.Fatalf("listed %d dead letters, expected 1", len(list.Items))
+	}
+	if len(vars.node.DeadLetters("", -1, pb.DeadLetter_BLOCK_DOWNLOADS).Items) != 0 {
+		t.Fatal("queue filter was not applied")
+	}
+
+	err = vars.node.RetryDeadLetter(msg.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vars.node.DeadLetter(msg.Id); err != ErrDeadLetterNotFound {
+		t.Fatal("retried dead letter was not removed")
+	}
+	if !inbox() {
+		t.Fatal("retried message was not returned to inbox")
+	}
+	err = vars.node.datastore.CafeMessages().Delete(msg.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = addDeadLetter(vars.node.datastore, pb.DeadLetter_CAFE_INBOX, msg.Id, &msg, msg.Attempts, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = vars.node.PurgeDeadLetters(pb.DeadLetter_CAFE_INBOX)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vars.node.DeadLetters("", -1).Items) != 0 {
+		t.Fatal("dead letters were not purged")
+	}
+}
+
+func TestTextile_Queues(t *testing.T) {
+	list := vars.node.Queues()
+	if len(list.Items) != len(pb.QueueStats_Queue_name) {
+		t.Fatalf("listed %d queues, expected %d", len(list.Items), len(pb.QueueStats_Queue_name))
+	}
+	for i, q := range list.Items {
+		if int(q.Queue) != i {
+			t.Fatalf("queue %s is out of order", q.Queue.String())
+		}
+	}
+
+	err := vars.node.FlushQueue(pb.QueueStats_BLOCK_MESSAGES)
+	if err != nil {
+		t.Fatalf("flush queue failed: %s", err)
+	}
+	stats, err := vars.node.Queue(pb.QueueStats_BLOCK_MESSAGES)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var peers int32
+	for _, c := range stats.Peers {
+		peers += c
+	}
+	if peers != stats.Count {
+		t.Fatal("queue peer breakdown does not match count")
+	}
+}
+
+func TestTextile_FeedSince(t *testing.T) {
+	var ids []string
+	for _, body := range []string{"one", "two", "three"} {
+		hash, err := vars.thread.AddMessage("", body)
+		if err != nil {
+			t.Fatalf("add message failed: %s", err)
+		}
+		ids = append(ids, hash.B58String())
+	}
+
+	list, err := vars.node.FeedSince(ids[0], vars.thread.Id, 1)
+	if err != nil {
+		t.Fatalf("feed since failed: %s", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Block != ids[1] || list.Next != ids[1] {
+		t.Fatal("first page should only include the next block")
+	}
+	list, err = vars.node.FeedSince(list.Next, vars.thread.Id, 10)
+	if err != nil {
+		t.Fatalf("feed since failed: %s", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Block != ids[2] || list.Next != "" {
+		t.Fatal("last page should only include the last block")
+	}
+
+	if _, err := vars.node.FeedSince("unknown", "", 10); err != ErrBlockNotFound {
+		t.Fatal("unknown cursor should not be found")
+	}
+}
+
+func TestTextile_ApiKeys(t *testing.T) {
+	_, err := vars.node.CreateApiKey("bad", []pb.ApiKey_Scope{pb.ApiKey_THREAD_WRITE}, nil, 0)
+	if err == nil {
+		t.Fatal("thread write key without threads should fail")
+	}
+
+	key, err := vars.node.CreateApiKey("writer", []pb.ApiKey_Scope{pb.ApiKey_THREAD_WRITE},
+		[]string{vars.thread.Id}, 0)
+	if err != nil {
+		t.Fatalf("create api key failed: %s", err)
+	}
+	if key.Key == "" || key.Value != nil {
+		t.Fatal("created key should only include the plaintext key")
+	}
+
+	// validate twice to hit the cache
+	for i := 0; i < 2; i++ {
+		valid, err := vars.node.ValidateApiKey(key.Key)
+		if err != nil {
+			t.Fatalf("validate api key failed: %s", err)
+		}
+		if valid.Id != key.Id {
+			t.Fatal("validated wrong key")
+		}
+		if !ApiKeyAllows(valid, pb.ApiKey_READ, "") {
+			t.Fatal("thread write should allow read")
+		}
+		if !ApiKeyAllows(valid, pb.ApiKey_THREAD_WRITE, vars.thread.Id) {
+			t.Fatal("thread write should allow writes to its thread")
+		}
+		if ApiKeyAllows(valid, pb.ApiKey_THREAD_WRITE, "other") {
+			t.Fatal("thread write should not allow writes to other threads")
+		}
+		if ApiKeyAllows(valid, pb.ApiKey_ADMIN, "") || ApiKeyAllows(valid, pb.ApiKey_CAFE, "") {
+			t.Fatal("thread write should not allow admin or cafe")
+		}
+	}
+	if _, err := vars.node.ValidateApiKey(key.Key[:len(key.Key)-2] + "11"); err == nil {
+		t.Fatal("altered key should not validate")
+	}
+
+	expired, err := vars.node.CreateApiKey("expired", []pb.ApiKey_Scope{pb.ApiKey_ADMIN}, nil, time.Millisecond)
+	if err != nil {
+		t.Fatalf("create api key failed: %s", err)
+	}
+	time.Sleep(time.Millisecond * 10)
+	if _, err := vars.node.ValidateApiKey(expired.Key); err != ErrApiKeyExpired {
+		t.Fatalf("expected expired error, got %v", err)
+	}
+
+	err = vars.node.RevokeApiKey(key.Id)
+	if err != nil {
+		t.Fatalf("revoke api key failed: %s", err)
+	}
+	if _, err := vars.node.ValidateApiKey(key.Key); err != ErrApiKeyRevoked {
+		t.Fatalf("expected revoked error, got %v", err)
+	}
+
+	list := vars.node.ApiKeys()
+	if len(list.Items) != 2 {
+		t.Fatalf("listed %d keys, expected 2", len(list.Items))
+	}
+	for _, k := range list.Items {
+		if k.Value != nil {
+			t.Fatal("listed keys should not include hashed values")
+		}
+	}
+}
+
 func TestTextile_Stop(t *testing.T) {
 	err := vars.node.Stop()
 	if err != nil {
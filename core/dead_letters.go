@@ -0,0 +1,195 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/repo/db"
+	"github.com/textileio/go-textile/util"
+)
+
+// retryBaseDelay is the backoff delay after the first failed attempt
+const retryBaseDelay = time.Second * 30
+
+// retryMaxDelay caps the backoff delay between attempts
+const retryMaxDelay = time.Hour
+
+// ErrDeadLetterNotFound indicates a dead letter is not in the datastore
+var ErrDeadLetterNotFound = fmt.Errorf("dead letter not found")
+
+// nextRetry returns when an item w/ the given number of failed attempts should next be tried.
+// The delay doubles w/ each attempt and is jittered so that items don't retry in lockstep.
+func nextRetry(attempts int32) time.Time {
+	delay := retryMaxDelay
+	if attempts < 16 {
+		delay = retryBaseDelay << uint(attempts)
+		if delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
+	}
+	jitter := time.Duration(rand.Int63n(int64(delay / 2)))
+	return time.Now().Add(delay/2 + jitter)
+}
+
+// retryReady returns whether or not an item's backoff has elapsed
+func retryReady(retry *timestamp.Timestamp) bool {
+	return retry == nil || !util.ProtoTime(retry).After(time.Now())
+}
+
+// addDeadLetter records an item that has exhausted its attempts
+// Note: The caller is responsible for removing the item from its queue
+func addDeadLetter(datastore repo.Datastore, queue pb.DeadLetter_Queue, id string, item proto.Message, attempts int32, reason error) error {
+	aitem, err := ptypes.MarshalAny(item)
+	if err != nil {
+		return err
+	}
+	var msg string
+	if reason != nil {
+		msg = reason.Error()
+	}
+
+	log.Warningf("moving %s %s to dead letters after %d attempts: %s", queue.String(), id, attempts, msg)
+
+	return datastore.DeadLetters().Add(&pb.DeadLetter{
+		Id:       id,
+		Queue:    queue,
+		Item:     aitem,
+		Attempts: attempts,
+		Error:    msg,
+		Date:     ptypes.TimestampNow(),
+	})
+}
+
+// DeadLetters lists dead letters, newest first, optionally only from the given queues
+func (t *Textile) DeadLetters(offset string, limit int, queues ...pb.DeadLetter_Queue) *pb.DeadLetterList {
+	return t.datastore.DeadLetters().List(offset, limit, deadLetterQuery(queues))
+}
+
+// DeadLetter returns a dead letter
+func (t *Textile) DeadLetter(id string) (*pb.DeadLetter, error) {
+	letter := t.datastore.DeadLetters().Get(id)
+	if letter == nil {
+		return nil, ErrDeadLetterNotFound
+	}
+	return letter, nil
+}
+
+// RetryDeadLetter returns a dead letter's item to its queue w/ a fresh attempt count
+func (t *Textile) RetryDeadLetter(id string) error {
+	letter := t.datastore.DeadLetters().Get(id)
+	if letter == nil {
+		return ErrDeadLetterNotFound
+	}
+
+	var err error
+	switch letter.Queue {
+	case pb.DeadLetter_BLOCK_DOWNLOADS, pb.DeadLetter_BLOCK_POSTS:
+		block := new(pb.Block)
+		if err = ptypes.UnmarshalAny(letter.Item, block); err != nil {
+			return err
+		}
+		block.Attempts = 0
+		block.Retry = nil
+		err = t.datastore.Blocks().Add(block)
+	case pb.DeadLetter_CAFE_INBOX:
+		msg := new(pb.CafeMessage)
+		if err = ptypes.UnmarshalAny(letter.Item, msg); err != nil {
+			return err
+		}
+		msg.Attempts = 0
+		msg.Retry = nil
+		err = t.datastore.CafeMessages().Add(msg)
+	case pb.DeadLetter_CAFE_REQUESTS:
+		req := new(pb.CafeRequest)
+		if err = ptypes.UnmarshalAny(letter.Item, req); err != nil {
+			return err
+		}
+		req.Status = pb.CafeRequest_NEW
+		req.Attempts = 0
+		req.Retry = nil
+		err = t.datastore.CafeRequests().Add(req)
+	default:
+		return fmt.Errorf("invalid queue: %s", letter.Queue.String())
+	}
+	if err != nil && !db.ConflictError(err) {
+		return err
+	}
+
+	err = t.datastore.DeadLetters().Delete(id)
+	if err != nil {
+		return err
+	}
+	log.Debugf("retrying dead letter %s from %s", id, letter.Queue.String())
+
+	if !t.Online() {
+		return nil
+	}
+	switch letter.Queue {
+	case pb.DeadLetter_BLOCK_DOWNLOADS:
+		go t.blockDownloads.Flush()
+	case pb.DeadLetter_BLOCK_POSTS, pb.DeadLetter_CAFE_REQUESTS:
+		go t.FlushCafes()
+	case pb.DeadLetter_CAFE_INBOX:
+		go t.cafeInbox.Flush()
+	}
+	return nil
+}
+
+// RetryDeadLetters retries all dead letters, optionally only from the given queues,
+// returning the number of retried items
+func (t *Textile) RetryDeadLetters(queues ...pb.DeadLetter_Queue) (int, error) {
+	var count int
+	query := deadLetterQuery(queues)
+	for _, letter := range t.datastore.DeadLetters().List("", -1, query).Items {
+		err := t.RetryDeadLetter(letter.Id)
+		if err != nil {
+			return count, err
+		}
+		count++
+	}
+	return count, nil
+}
+
+// PurgeDeadLetter permanently deletes a dead letter
+func (t *Textile) PurgeDeadLetter(id string) error {
+	if t.datastore.DeadLetters().Get(id) == nil {
+		return ErrDeadLetterNotFound
+	}
+	return t.datastore.DeadLetters().Delete(id)
+}
+
+// PurgeDeadLetters permanently deletes all dead letters, optionally only from the given queues
+func (t *Textile) PurgeDeadLetters(queues ...pb.DeadLetter_Queue) error {
+	if len(queues) == 0 {
+		for q := range pb.DeadLetter_Queue_name {
+			queues = append(queues, pb.DeadLetter_Queue(q))
+		}
+	}
+	for _, q := range queues {
+		err := t.datastore.DeadLetters().DeleteByQueue(q)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deadLetterQuery returns a query matching the given queues, or all if empty
+func deadLetterQuery(queues []pb.DeadLetter_Queue) string {
+	if len(queues) == 0 {
+		return ""
+	}
+	var list []string
+	for _, q := range queues {
+		list = append(list, strconv.Itoa(int(q)))
+	}
+	return "queue in (" + strings.Join(list, ",") + ")"
+}
@@ -0,0 +1,91 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+// debounceMaxWindows bounds how many windows a burst of triggers can delay a flush
+const debounceMaxWindows = 5
+
+// debouncer calls fn once a window has passed w/o another trigger, so that
+// a burst of enqueued items is flushed together. A steady stream of triggers
+// can delay the call by at most debounceMaxWindows windows.
+type debouncer struct {
+	fn      func()
+	window  time.Duration
+	timer   *time.Timer
+	first   time.Time
+	started bool
+	lock    sync.Mutex
+}
+
+// newDebouncer returns a stopped debouncer
+func newDebouncer(window time.Duration, fn func()) *debouncer {
+	return &debouncer{
+		fn:     fn,
+		window: window,
+	}
+}
+
+// start allows triggers to schedule calls
+func (d *debouncer) start() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.started = true
+}
+
+// stop cancels a scheduled call and ignores triggers until started again
+func (d *debouncer) stop() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.started = false
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+}
+
+// trigger schedules a call, pushing back one that is already scheduled
+func (d *debouncer) trigger() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	if !d.started {
+		return
+	}
+
+	if d.timer == nil {
+		d.first = time.Now()
+		d.timer = time.AfterFunc(d.window, d.fire)
+		return
+	}
+	// a timer that already fired has a pending call which will pick up this item
+	if time.Since(d.first)+d.window < d.window*debounceMaxWindows && d.timer.Stop() {
+		d.timer.Reset(d.window)
+	}
+}
+
+// fire calls fn if still started
+func (d *debouncer) fire() {
+	d.lock.Lock()
+	d.timer = nil
+	started := d.started
+	d.lock.Unlock()
+
+	if started {
+		d.fn()
+	}
+}
+
+// debounceWindow parses a configured window, falling back to def if unset or invalid
+func debounceWindow(str string, def time.Duration) time.Duration {
+	if str == "" {
+		return def
+	}
+	window, err := time.ParseDuration(str)
+	if err != nil || window <= 0 {
+		log.Warningf("invalid debounce window %s, using %s", str, def)
+		return def
+	}
+	return window
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebouncer(t *testing.T) {
+	var calls int32
+	d := newDebouncer(time.Millisecond*50, func() {
+		atomic.AddInt32(&calls, 1)
+	})
+
+	d.trigger()
+	time.Sleep(time.Millisecond * 100)
+	if atomic.LoadInt32(&calls) != 0 {
+		t.Fatal("stopped debouncer should ignore triggers")
+	}
+
+	d.start()
+	for i := 0; i < 3; i++ {
+		d.trigger()
+		time.Sleep(time.Millisecond * 10)
+	}
+	time.Sleep(time.Millisecond * 100)
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Fatalf("burst should flush once, got %d", n)
+	}
+
+	// a steady stream of triggers can't delay the call forever
+	deadline := time.Now().Add(time.Millisecond * 50 * (debounceMaxWindows + 2))
+	for time.Now().Before(deadline) {
+		d.trigger()
+		time.Sleep(time.Millisecond * 10)
+	}
+	if atomic.LoadInt32(&calls) < 2 {
+		t.Fatal("steady triggers should not starve the call")
+	}
+
+	d.trigger()
+	d.stop()
+	time.Sleep(time.Millisecond * 100)
+	n := atomic.LoadInt32(&calls)
+	d.trigger()
+	time.Sleep(time.Millisecond * 100)
+	if atomic.LoadInt32(&calls) != n {
+		t.Fatal("stop should cancel scheduled calls")
+	}
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// ErrNoRelays indicates there are no known relays for a peer
+var ErrNoRelays = fmt.Errorf("no relays available")
+
+// reachabilityCooldown is how long a path whose last attempt failed is passed over
+const reachabilityCooldown = time.Minute * 10
+
+// defaultDeliveryPaths is the order live paths are tried in w/o any history
+var defaultDeliveryPaths = []pb.PeerReachability_Path{
+	pb.PeerReachability_DIRECT,
+	pb.PeerReachability_RELAY,
+	pb.PeerReachability_PUBSUB,
+}
+
+// orderDeliveryPaths sorts live paths by past outcomes. Paths that have worked come
+// first, most recent success first. Paths that failed since their last success are
+// returned separately as cooling until reachabilityCooldown has passed.
+func orderDeliveryPaths(history []*pb.PeerReachability, now time.Time) (ready []pb.PeerReachability_Path, cooling []pb.PeerReachability_Path) {
+	byPath := make(map[pb.PeerReachability_Path]*pb.PeerReachability)
+	for _, h := range history {
+		byPath[h.Path] = h
+	}
+
+	for _, path := range defaultDeliveryPaths {
+		h := byPath[path]
+		if h != nil && h.LastFailure != nil {
+			failed := util.ProtoTime(h.LastFailure)
+			if (h.LastSuccess == nil || failed.After(util.ProtoTime(h.LastSuccess))) &&
+				now.Sub(failed) < reachabilityCooldown {
+				cooling = append(cooling, path)
+				continue
+			}
+		}
+		ready = append(ready, path)
+	}
+
+	lastSuccess := func(path pb.PeerReachability_Path) int64 {
+		h := byPath[path]
+		if h == nil || h.LastSuccess == nil {
+			return 0
+		}
+		return util.ProtoNanos(h.LastSuccess)
+	}
+	sort.SliceStable(ready, func(i, j int) bool {
+		return lastSuccess(ready[i]) > lastSuccess(ready[j])
+	})
+	return ready, cooling
+}
+
+// deliver attempts to send a message to a live peer, trying each path in turn.
+// Paths in cooldown are only tried if the peer has no inbox to fall back on.
+func (q *BlockOutbox) deliver(msg pb.BlockMessage, contact *pb.Peer) bool {
+	ready, cooling := orderDeliveryPaths(q.datastore.Peers().GetReachability(msg.Peer), time.Now())
+	paths := ready
+	if contact == nil || len(contact.Inboxes) == 0 {
+		paths = append(paths, cooling...)
+	}
+
+	for _, path := range paths {
+		var err error
+		switch path {
+		case pb.PeerReachability_DIRECT:
+			err = q.sendDirect(msg)
+		case pb.PeerReachability_RELAY:
+			err = q.sendRelayed(msg, contact)
+		case pb.PeerReachability_PUBSUB:
+			log.Debugf("publishing block message to %s", msg.Peer)
+			err = q.service().SendPubSubMessage(msg)
+		}
+		q.addReachability(msg.Peer, path, err == nil)
+		if err == nil {
+			return true
+		}
+		log.Debugf("%s delivery to %s failed: %s", path, msg.Peer, err)
+	}
+	return false
+}
+
+// sendDirect sends a message to the peer, dialing it if not already connected.
+// Dials are bounded by the connect timeout since hole punching can stall.
+func (q *BlockOutbox) sendDirect(msg pb.BlockMessage) error {
+	connected, err := ipfs.SwarmConnected(q.node(), msg.Peer)
+	if err != nil {
+		return err
+	}
+	log.Debugf("sending block message direct to %s", msg.Peer)
+	if connected {
+		return q.service().SendMessage(nil, msg.Peer, msg.Env)
+	}
+	ctx, cancel := context.WithTimeout(q.node().Context(), ipfs.ConnectTimeout)
+	defer cancel()
+	return q.service().SendMessage(ctx, msg.Peer, msg.Env)
+}
+
+// sendRelayed sends a message over a circuit relay through one of the peer's
+// inbox cafes or one of our own cafes
+func (q *BlockOutbox) sendRelayed(msg pb.BlockMessage, contact *pb.Peer) error {
+	relays := q.relays(msg.Peer, contact)
+	if len(relays) == 0 {
+		return ErrNoRelays
+	}
+
+	var err error
+	for _, relay := range relays {
+		log.Debugf("sending block message to %s via relay %s", msg.Peer, relay)
+		err = ipfs.SwarmConnectRelay(q.node(), relay, msg.Peer)
+		if err != nil {
+			continue
+		}
+		err = q.service().SendMessage(nil, msg.Peer, msg.Env)
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// relays returns the candidate relay peers for a recipient
+func (q *BlockOutbox) relays(peerId string, contact *pb.Peer) []string {
+	self := q.node().Identity.Pretty()
+	seen := map[string]struct{}{self: {}, peerId: {}}
+	var relays []string
+	add := func(id string) {
+		if id == "" {
+			return
+		}
+		if _, ok := seen[id]; ok {
+			return
+		}
+		seen[id] = struct{}{}
+		relays = append(relays, id)
+	}
+
+	if contact != nil {
+		for _, inbox := range contact.Inboxes {
+			add(inbox.Peer)
+		}
+	}
+	for _, session := range q.datastore.CafeSessions().List().Items {
+		if session.Cafe != nil {
+			add(session.Cafe.Peer)
+		}
+	}
+	return relays
+}
+
+// addReachability records a delivery outcome, logging any error
+func (q *BlockOutbox) addReachability(peerId string, path pb.PeerReachability_Path, reached bool) {
+	if err := q.datastore.Peers().AddReachability(peerId, path, reached); err != nil {
+		log.Warningf("error recording reachability for %s: %s", peerId, err)
+	}
+}
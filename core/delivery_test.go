@@ -0,0 +1,42 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+func TestOrderDeliveryPaths(t *testing.T) {
+	now := time.Now()
+	ts := func(ago time.Duration) *timestamp.Timestamp {
+		return util.ProtoTs(now.Add(-ago).UnixNano())
+	}
+
+	ready, cooling := orderDeliveryPaths(nil, now)
+	if len(ready) != 3 || ready[0] != pb.PeerReachability_DIRECT || len(cooling) != 0 {
+		t.Fatal("paths w/o history should use the default order")
+	}
+
+	history := []*pb.PeerReachability{
+		{Path: pb.PeerReachability_DIRECT, Failures: 1, LastFailure: ts(time.Minute)},
+		{Path: pb.PeerReachability_RELAY, Successes: 1, LastSuccess: ts(time.Hour)},
+		{Path: pb.PeerReachability_PUBSUB, Successes: 1, LastSuccess: ts(time.Minute)},
+	}
+	ready, cooling = orderDeliveryPaths(history, now)
+	if len(cooling) != 1 || cooling[0] != pb.PeerReachability_DIRECT {
+		t.Fatal("recently failed path should be cooling")
+	}
+	if len(ready) != 2 || ready[0] != pb.PeerReachability_PUBSUB || ready[1] != pb.PeerReachability_RELAY {
+		t.Fatal("most recent success should come first")
+	}
+
+	// an old failure no longer holds a path back
+	history[0].LastFailure = ts(reachabilityCooldown + time.Minute)
+	ready, cooling = orderDeliveryPaths(history, now)
+	if len(ready) != 3 || len(cooling) != 0 || ready[2] != pb.PeerReachability_DIRECT {
+		t.Fatal("failed path should be ready again after the cooldown")
+	}
+}
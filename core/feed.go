@@ -64,7 +64,7 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 		query = fmt.Sprintf("(threadId='%s') and %s", req.Thread, query)
 	}
 
-	blocks := t.Blocks(req.Offset, int(req.Limit), query)
+	blocks := t.SortedBlocks(req.Offset, int(req.Limit), query, req.Sort)
 	list := make([]*pb.FeedItem, 0)
 	var count int
 
@@ -119,7 +119,7 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 		nextOffset = blocks.Items[len(blocks.Items)-1].Id
 
 		// see if there's actually more
-		if len(t.datastore.Blocks().List(nextOffset, 1, query).Items) == 0 {
+		if len(t.listBlocks(nextOffset, 1, query, req.Sort).Items) == 0 {
 			nextOffset = ""
 		}
 	}
@@ -131,6 +131,45 @@ func (t *Textile) Feed(req *pb.FeedRequest) (*pb.FeedItemList, error) {
 	}, nil
 }
 
+// FeedSince returns feed items for blocks indexed after the block since, oldest first,
+// optionally limited to a thread. Like live updates, the account thread is skipped.
+// Next is set to the last listed block id when there may be more.
+func (t *Textile) FeedSince(since string, threadId string, limit int) (*pb.FeedItemList, error) {
+	if t.datastore.Blocks().Get(since) == nil {
+		return nil, ErrBlockNotFound
+	}
+
+	query := fmt.Sprintf("threadId!='%s'", t.config.Account.Thread)
+	if threadId != "" {
+		query += fmt.Sprintf(" and threadId='%s'", threadId)
+	}
+	blocks := t.datastore.Blocks().ListAfter(since, limit, query)
+
+	list := make([]*pb.FeedItem, 0)
+	for _, block := range blocks.Items {
+		item, err := t.feedItem(block, feedItemOpts{})
+		if err != nil {
+			log.Errorf("error building thread update: %s", err)
+			continue
+		}
+		if item == nil {
+			continue
+		}
+		list = append(list, item)
+	}
+
+	var next string
+	if limit > 0 && len(blocks.Items) == limit {
+		next = blocks.Items[len(blocks.Items)-1].Id
+	}
+
+	return &pb.FeedItemList{
+		Items: list,
+		Count: int32(len(list)),
+		Next:  next,
+	}, nil
+}
+
 func (t *Textile) feedItem(block *pb.Block, opts feedItemOpts) (*pb.FeedItem, error) {
 	if block == nil {
 		return nil, nil
@@ -8,7 +8,7 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func (t *Textile) Files(offset string, limit int, threadId string) (*pb.FilesList, error) {
+func (t *Textile) Files(offset string, limit int, threadId string, sort pb.Block_BlockSort) (*pb.FilesList, error) {
 	var query string
 	if threadId != "" {
 		if t.Thread(threadId) == nil {
@@ -21,7 +21,7 @@ func (t *Textile) Files(offset string, limit int, threadId string) (*pb.FilesLis
 
 	list := make([]*pb.Files, 0)
 
-	blocks := t.Blocks(offset, limit, query)
+	blocks := t.SortedBlocks(offset, limit, query, sort)
 	for _, block := range blocks.Items {
 		file, err := t.file(block, feedItemOpts{annotations: true})
 		if err != nil {
@@ -146,3 +146,23 @@ func (t *Textile) fileThreads(data string) []string {
 	}
 	return unique
 }
+
+// FilesFile returns the file index at index and link path of a files block.
+// An empty or "." path selects the file of a single file schema.
+func FilesFile(files *pb.Files, index int, path string) (*pb.FileIndex, error) {
+	if index < 0 || index >= len(files.Files) {
+		return nil, ErrFileNotFound
+	}
+	f := files.Files[index]
+
+	var fi *pb.FileIndex
+	if path == "" || path == "." {
+		fi = f.File
+	} else {
+		fi = f.Links[path]
+	}
+	if fi == nil {
+		return nil, ErrFileNotFound
+	}
+	return fi, nil
+}
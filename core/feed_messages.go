@@ -6,7 +6,7 @@ import (
 	"github.com/textileio/go-textile/pb"
 )
 
-func (t *Textile) Messages(offset string, limit int, threadId string) (*pb.TextList, error) {
+func (t *Textile) Messages(offset string, limit int, threadId string, sort pb.Block_BlockSort) (*pb.TextList, error) {
 	var query string
 	if threadId != "" {
 		if t.Thread(threadId) == nil {
@@ -19,7 +19,7 @@ func (t *Textile) Messages(offset string, limit int, threadId string) (*pb.TextL
 
 	list := make([]*pb.Text, 0)
 
-	blocks := t.Blocks(offset, limit, query)
+	blocks := t.SortedBlocks(offset, limit, query, sort)
 	for _, block := range blocks.Items {
 		msg, err := t.message(block, feedItemOpts{annotations: true})
 		if err != nil {
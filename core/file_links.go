@@ -0,0 +1,78 @@
+package core
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/mr-tron/base58/base58"
+)
+
+// ErrInvalidFileLink indicates a file link signature is malformed or does not match its path
+var ErrInvalidFileLink = fmt.Errorf("invalid file link")
+
+// ErrFileLinkExpired indicates a file link is past its expiry date
+var ErrFileLinkExpired = fmt.Errorf("file link expired")
+
+// MaxFileLinkExpiry limits how long a signed file link grants access
+const MaxFileLinkExpiry = time.Hour * 24 * 7
+
+// FilesLinkPath returns the gateway path of a thread files block
+func FilesLinkPath(threadId string, blockId string) string {
+	return fmt.Sprintf("/threads/%s/files/%s", threadId, blockId)
+}
+
+// FileLinkPath returns the gateway path of a file within a thread files block.
+// The link path is omitted for the file of a single file schema.
+func FileLinkPath(threadId string, blockId string, index int, path string) string {
+	pth := fmt.Sprintf("%s/%d", FilesLinkPath(threadId, blockId), index)
+	if path == "" || path == "." {
+		return pth
+	}
+	return pth + "/" + url.PathEscape(path)
+}
+
+// SignFileLink returns query params granting access to a gateway file path until expires
+// has passed. Links are signed w/ a key derived from the account seed, so the file's
+// AES key is never part of the link.
+func (t *Textile) SignFileLink(pth string, expires time.Duration) (url.Values, error) {
+	if expires <= 0 || expires > MaxFileLinkExpiry {
+		return nil, fmt.Errorf("link expiry must be between 0 and %s", MaxFileLinkExpiry)
+	}
+
+	exp := strconv.FormatInt(time.Now().Add(expires).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", exp)
+	query.Set("sig", base58.FastBase58Encoding(t.fileLinkMAC(pth, exp)))
+	return query, nil
+}
+
+// VerifyFileLink checks the expiry and signature query params of a gateway file path
+func (t *Textile) VerifyFileLink(pth string, expires string, sig string) error {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidFileLink
+	}
+	sigb, err := base58.FastBase58Decoding(sig)
+	if err != nil {
+		return ErrInvalidFileLink
+	}
+	if !hmac.Equal(sigb, t.fileLinkMAC(pth, expires)) {
+		return ErrInvalidFileLink
+	}
+	if time.Unix(exp, 0).Before(time.Now()) {
+		return ErrFileLinkExpired
+	}
+	return nil
+}
+
+// fileLinkMAC returns the HMAC-SHA256 of a file link path and expiry
+func (t *Textile) fileLinkMAC(pth string, expires string) []byte {
+	seed := sha256.Sum256([]byte("file links:" + t.account.Seed()))
+	mac := hmac.New(sha256.New, seed[:])
+	mac.Write([]byte(pth + "\n" + expires))
+	return mac.Sum(nil)
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/ptypes"
@@ -268,6 +269,84 @@ func (t *Textile) FileIndexContent(file *pb.FileIndex) (io.ReadSeeker, error) {
 	return bytes.NewReader(plaintext), nil
 }
 
+// ReadSeekCloser is seekable content that must be closed
+type ReadSeekCloser interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// nopSeekCloser adds a no-op Close to in-memory content
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
+// FileIndexReader returns a seekable reader of file content. Plaintext content is read
+// from ipfs as needed, e.g., for range requests. Encrypted content is decrypted in full,
+// since AES-GCM must authenticate the entire ciphertext. The caller must close the reader.
+func (t *Textile) FileIndexReader(file *pb.FileIndex) (ReadSeekCloser, error) {
+	if file.Key == "" {
+		f, _, err := ipfs.FileAtPath(t.node, file.Hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get file index content for hash %s with error: %s", file.Hash, err)
+		}
+		return f, nil
+	}
+
+	reader, err := t.FileIndexContent(file)
+	if err != nil {
+		return nil, err
+	}
+	return nopSeekCloser{reader}, nil
+}
+
+// ContentCache is the caching policy of served file content
+type ContentCache int
+
+const (
+	// CacheNone leaves the Cache-Control header to the caller
+	CacheNone ContentCache = iota
+	// CachePrivate lets only the client cache immutable content, e.g., decrypted content
+	// or content that required credentials
+	CachePrivate
+	// CachePublic lets shared caches store immutable content, i.e., plaintext behind a
+	// content addressed path
+	CachePublic
+)
+
+// ServeFileContent writes content w/ hash as a strong ETag, honoring conditional and range
+// requests. Immutable content, i.e., behind a content addressed path, may be cached forever
+// by the client or, w/ CachePublic, by shared caches too.
+func ServeFileContent(w http.ResponseWriter, r *http.Request, content io.ReadSeeker, hash string, media string, name string, cache ContentCache) {
+	header := w.Header()
+	if media != "" {
+		header.Set("Content-Type", media)
+	}
+	header.Set("ETag", `"`+hash+`"`)
+	switch cache {
+	case CachePrivate:
+		header.Set("Cache-Control", "private, max-age=31536000, immutable")
+	case CachePublic:
+		header.Set("Cache-Control", "public, max-age=31536000, immutable")
+	}
+	http.ServeContent(w, r, name, time.Time{}, content)
+}
+
+// AbortResponse closes the connection of a partially written response so that clients
+// see an error rather than a truncated body
+func AbortResponse(w http.ResponseWriter) {
+	hj, ok := w.(http.Hijacker)
+	if !ok {
+		return
+	}
+	conn, _, err := hj.Hijack()
+	if err != nil {
+		return
+	}
+	_ = conn.Close()
+}
+
 func (t *Textile) TargetNodeKeys(node ipld.Node) (*pb.Keys, error) {
 	keys := &pb.Keys{Files: make(map[string]string)}
 
@@ -0,0 +1,184 @@
+package core
+
+import (
+	"sort"
+	"strings"
+
+	icid "github.com/ipfs/go-cid"
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// ForkThread adds a new thread seeded with copies of the origin thread's files and
+// messages, up to and including head. Omitting head forks from the current heads.
+// When blocks is not empty, only those blocks are copied.
+// Note: Copied files are re-keyed for the new thread, the file data is not duplicated
+func (t *Textile) ForkThread(id string, conf pb.AddThreadConfig, sk libp2pc.PrivKey, head string, blocks []string) (*Thread, error) {
+	origin := t.Thread(id)
+	if origin == nil {
+		return nil, ErrThreadNotFound
+	}
+	if !origin.readable(t.config.Account.Address) {
+		return nil, ErrNotReadable
+	}
+
+	var heads []*pb.Block
+	if head != "" {
+		block := t.datastore.Blocks().Get(head)
+		if block == nil || block.Thread != origin.Id {
+			return nil, ErrBlockNotFound
+		}
+		heads = append(heads, block)
+	} else {
+		nheads, err := origin.Heads()
+		if err != nil {
+			return nil, err
+		}
+		heads = origin.blocksFromNodes(nheads)
+	}
+	var headIds []string
+	for _, h := range heads {
+		headIds = append(headIds, h.Id)
+	}
+
+	seeds := origin.forkSeeds(heads)
+	if len(blocks) > 0 {
+		selected := make(map[string]struct{})
+		for _, b := range blocks {
+			selected[b] = struct{}{}
+		}
+		var filtered []*pb.Block
+		for _, seed := range seeds {
+			if _, ok := selected[seed.Id]; ok {
+				filtered = append(filtered, seed)
+				delete(selected, seed.Id)
+			}
+		}
+		if len(selected) > 0 {
+			return nil, ErrBlockNotFound
+		}
+		seeds = filtered
+	}
+
+	// files must validate against the origin schema
+	if conf.Schema == nil && origin.schemaId != "" {
+		conf.Schema = &pb.AddThreadConfig_Schema{
+			Id: origin.schemaId,
+		}
+	}
+
+	thread, err := t.AddThread(conf, sk, t.account.Address(), true, true)
+	if err != nil {
+		return nil, err
+	}
+	err = t.datastore.Threads().UpdateFork(thread.Id, origin.Id, strings.Join(headIds, ","))
+	if err != nil {
+		t.removeFork(thread.Id)
+		return nil, err
+	}
+
+	// don't leave a partial fork behind if a copy fails
+	err = t.seedFork(thread, seeds)
+	if err != nil {
+		t.removeFork(thread.Id)
+		return nil, err
+	}
+
+	log.Debugf("forked thread %s from %s with %d blocks", thread.Id, origin.Id, len(seeds))
+
+	return thread, nil
+}
+
+// seedFork adds copies of the seed blocks to a new fork
+func (t *Textile) seedFork(thread *Thread, seeds []*pb.Block) error {
+	for _, seed := range seeds {
+		switch seed.Type {
+		case pb.Block_TEXT:
+			_, err := thread.AddMessage("", seed.Body)
+			if err != nil {
+				return err
+			}
+		case pb.Block_FILES:
+			dcid, err := icid.Decode(seed.Data)
+			if err != nil {
+				return err
+			}
+			node, err := ipfs.NodeAtCid(t.node, dcid)
+			if err != nil {
+				return err
+			}
+			keys, err := t.TargetNodeKeys(node)
+			if err != nil {
+				return err
+			}
+
+			// keys are sealed with the new thread key in the new block
+			_, err = thread.AddFiles(node, "", seed.Body, keys.Files)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// removeFork removes a fork that failed to build along w/ its blocks
+func (t *Textile) removeFork(id string) {
+	_, err := t.RemoveThread(id)
+	if err != nil {
+		log.Errorf("error removing fork %s: %s", id, err)
+	}
+	err = t.datastore.Blocks().DeleteByThread(id)
+	if err != nil {
+		log.Errorf("error removing fork %s blocks: %s", id, err)
+	}
+}
+
+// forkSeeds returns the files and message blocks reachable from heads, oldest first
+func (t *Thread) forkSeeds(heads []*pb.Block) []*pb.Block {
+	var seeds []*pb.Block
+	visited := make(map[string]struct{})
+	queue := heads
+	for len(queue) > 0 {
+		block := queue[0]
+		queue = queue[1:]
+		if _, ok := visited[block.Id]; ok {
+			continue
+		}
+		visited[block.Id] = struct{}{}
+
+		switch block.Type {
+		case pb.Block_TEXT, pb.Block_FILES:
+			seeds = append(seeds, block)
+		}
+		queue = append(queue, t.blocksFromNodes(block.Parents)...)
+	}
+
+	sort.SliceStable(seeds, func(i, j int) bool {
+		return util.ProtoTime(seeds[i].Date).Before(util.ProtoTime(seeds[j].Date))
+	})
+	return seeds
+}
+
+// blocksFromNodes returns the locally indexed blocks wrapped by the given nodes
+func (t *Thread) blocksFromNodes(nodes []string) []*pb.Block {
+	var blocks []*pb.Block
+	for _, n := range nodes {
+		if n == "" {
+			continue // some old blocks may contain empty string parents
+		}
+		id, err := blockCIDFromNode(t.node(), n)
+		if err != nil {
+			log.Warningf("error getting block from node %s: %s", n, err)
+			continue
+		}
+		block := t.datastore.Blocks().Get(id)
+		if block == nil || block.Thread != t.Id || block.Status != pb.Block_READY {
+			continue
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"fmt"
+
+	"github.com/textileio/go-textile/pb"
+)
+
+// ThreadGraph returns a page of a thread's dag, newest first, laid out for drawing.
+// Blocks are ordered causally and each is assigned a lane such that a lane continues
+// into a block's first parent, additional parents open new lanes, and lanes converge
+// where branches are merged.
+func (t *Textile) ThreadGraph(id string, offset string, limit int) (*pb.ThreadGraph, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+
+	query := fmt.Sprintf("threadId='%s'", thread.Id)
+	blocks := t.datastore.Blocks().ListCausal(offset, limit, query).Items
+
+	graph := &pb.ThreadGraph{Thread: thread.Id}
+	if len(blocks) == 0 {
+		return graph, nil
+	}
+
+	nheads, err := thread.Heads()
+	if err != nil {
+		return nil, err
+	}
+	heads := make(map[string]struct{})
+	for _, h := range thread.blocksFromNodes(nheads) {
+		heads[h.Id] = struct{}{}
+	}
+
+	rows := make(map[string]int)
+	for i, b := range blocks {
+		rows[b.Id] = i
+	}
+
+	// resolve parent nodes to blocks
+	edges := make(map[string][]*pb.ThreadGraph_Edge)
+	children := make(map[string]int)
+	for _, b := range blocks {
+		for _, p := range b.Parents {
+			if p == "" {
+				continue // some old blocks may contain empty string parents
+			}
+			edge := &pb.ThreadGraph_Edge{
+				From: b.Id,
+				Node: p,
+			}
+			pid, err := blockCIDFromNode(t.node, p)
+			if err != nil || t.datastore.Blocks().Get(pid) == nil {
+				edge.Missing = true
+			} else {
+				edge.To = pid
+				children[pid]++
+				if _, ok := rows[pid]; !ok {
+					edge.Outside = true
+				}
+			}
+			edges[b.Id] = append(edges[b.Id], edge)
+			graph.Edges = append(graph.Edges, edge)
+		}
+	}
+
+	authors := make(map[string]struct{})
+	var lanes []string // the block each lane is waiting for
+	for i, b := range blocks {
+		lane := -1
+		for l, w := range lanes {
+			if w != b.Id {
+				continue
+			}
+			if lane == -1 {
+				lane = l
+			} else {
+				lanes[l] = "" // branches converge here
+			}
+		}
+		if lane == -1 {
+			lane = freeLane(&lanes)
+		}
+		lanes[lane] = ""
+
+		first := true
+		for _, e := range edges[b.Id] {
+			if e.To == "" || waiting(lanes, e.To) {
+				continue
+			}
+			if first {
+				lanes[lane] = e.To
+				first = false
+			} else {
+				lanes[freeLane(&lanes)] = e.To
+			}
+		}
+
+		if _, ok := authors[b.Author]; !ok && b.Author != "" {
+			authors[b.Author] = struct{}{}
+			graph.Authors = append(graph.Authors, b.Author)
+		}
+		_, head := heads[b.Id]
+
+		graph.Nodes = append(graph.Nodes, &pb.ThreadGraph_Node{
+			Id:     b.Id,
+			Type:   b.Type,
+			Author: b.Author,
+			Date:   b.Date,
+			Clock:  b.Clock,
+			Status: b.Status,
+			Lane:   int32(lane),
+			Row:    int32(i),
+			Merge:  len(edges[b.Id]) > 1,
+			Fork:   children[b.Id] > 1,
+			Head:   head,
+		})
+		if int32(len(lanes)) > graph.Lanes {
+			graph.Lanes = int32(len(lanes))
+		}
+	}
+
+	if len(blocks) == limit {
+		last := blocks[len(blocks)-1].Id
+		if len(t.datastore.Blocks().ListCausal(last, 1, query).Items) > 0 {
+			graph.Next = last
+		}
+	}
+
+	return graph, nil
+}
+
+// freeLane returns the first unused lane, adding one if needed
+func freeLane(lanes *[]string) int {
+	for l, w := range *lanes {
+		if w == "" {
+			return l
+		}
+	}
+	*lanes = append(*lanes, "")
+	return len(*lanes) - 1
+}
+
+// waiting returns whether or not a lane is already waiting for the block
+func waiting(lanes []string, id string) bool {
+	for _, w := range lanes {
+		if w == id {
+			return true
+		}
+	}
+	return false
+}
@@ -247,6 +247,11 @@ func (t *Textile) handleThreadAdd(plaintext []byte, parents []string) (mh.Multih
 		// the first update
 		_ = thread.followParents(parents)
 
+		err = thread.reclock()
+		if err != nil {
+			log.Warningf("error clocking blocks: %s", err)
+		}
+
 		// notify discovered peers
 		err = thread.sendWelcome()
 		if err != nil {
@@ -0,0 +1,120 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// queueError records the most recent error seen by a queue
+type queueError struct {
+	msg  string
+	date *timestamp.Timestamp
+	lock sync.Mutex
+}
+
+// set records an error
+func (e *queueError) set(err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.msg = err.Error()
+	e.date = ptypes.TimestampNow()
+}
+
+// get returns the last error and when it occurred
+func (e *queueError) get() (string, *timestamp.Timestamp) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return e.msg, e.date
+}
+
+// Queues returns stats for all queues
+func (t *Textile) Queues() *pb.QueueStatsList {
+	var queues []int
+	for q := range pb.QueueStats_Queue_name {
+		queues = append(queues, int(q))
+	}
+	sort.Ints(queues)
+
+	list := &pb.QueueStatsList{}
+	for _, q := range queues {
+		stats, err := t.Queue(pb.QueueStats_Queue(q))
+		if err != nil {
+			log.Errorf("error getting queue stats: %s", err)
+			continue
+		}
+		list.Items = append(list.Items, stats)
+	}
+	return list
+}
+
+// Queue returns stats for a single queue, including counts by status and peer,
+// the age of the oldest item, and the last error seen
+func (t *Textile) Queue(queue pb.QueueStats_Queue) (*pb.QueueStats, error) {
+	var stats *pb.QueueStats
+	var qerr *queueError
+	switch queue {
+	case pb.QueueStats_BLOCK_MESSAGES:
+		stats = t.datastore.BlockMessages().Stats()
+		qerr = &t.blockOutbox.lastErr
+	case pb.QueueStats_BLOCK_DOWNLOADS:
+		stats = t.datastore.Blocks().Stats(pb.Block_PENDING)
+		qerr = &t.blockDownloads.lastErr
+	case pb.QueueStats_BLOCK_POSTS:
+		stats = t.datastore.Blocks().Stats(pb.Block_QUEUED)
+		qerr = &t.postErr
+	case pb.QueueStats_CAFE_REQUESTS:
+		stats = t.datastore.CafeRequests().Stats()
+		qerr = &t.cafe.lastErr
+	case pb.QueueStats_CAFE_INBOX:
+		stats = t.datastore.CafeMessages().Stats()
+		qerr = &t.cafeInbox.lastErr
+	default:
+		return nil, fmt.Errorf("invalid queue: %s", queue.String())
+	}
+	stats.Queue = queue
+	stats.LastError, stats.LastErrorDate = qerr.get()
+
+	// dead letters may hold a more recent error, e.g., from before a restart
+	if dq, ok := pb.DeadLetter_Queue_value[queue.String()]; ok {
+		query := fmt.Sprintf("queue=%d", dq)
+		stats.Dead = int32(t.datastore.DeadLetters().Count(query))
+		letters := t.datastore.DeadLetters().List("", 1, query).Items
+		if len(letters) > 0 && (stats.LastErrorDate == nil ||
+			util.ProtoTsIsNewer(letters[0].Date, stats.LastErrorDate)) {
+			stats.LastError = letters[0].Error
+			stats.LastErrorDate = letters[0].Date
+		}
+	}
+
+	return stats, nil
+}
+
+// FlushQueue processes a single queue now. If the queue is already being flushed,
+// it returns w/o waiting for that flush to finish.
+func (t *Textile) FlushQueue(queue pb.QueueStats_Queue) error {
+	if !t.Online() {
+		return ErrOffline
+	}
+
+	switch queue {
+	case pb.QueueStats_BLOCK_MESSAGES:
+		t.blockOutbox.Flush()
+	case pb.QueueStats_BLOCK_DOWNLOADS:
+		t.blockDownloads.Flush()
+	case pb.QueueStats_BLOCK_POSTS:
+		t.FlushBlocks()
+	case pb.QueueStats_CAFE_REQUESTS:
+		t.cafeOutbox.Flush(true)
+	case pb.QueueStats_CAFE_INBOX:
+		t.cafeInbox.Flush()
+	default:
+		return fmt.Errorf("invalid queue: %s", queue.String())
+	}
+	return nil
+}
@@ -265,6 +265,7 @@ func (t *Thread) followParent(parent string) ([]string, error) {
 			Target:  bnode.target,
 			Data:    bnode.data,
 			Status:  pb.Block_PENDING,
+			Clock:   t.clock(bnode.parents),
 		})
 		if err != nil {
 			if db.ConflictError(err) {
@@ -580,6 +581,7 @@ func (t *Thread) commitNode(index *pb.Block, additionalParents []string, addInde
 			Body:     index.Body,
 			Status:   pb.Block_READY,
 			Attempts: index.Attempts,
+			Clock:    t.clock(heads),
 		})
 		if err != nil {
 			return nil, err
@@ -610,6 +612,17 @@ func (t *Thread) commitNode(index *pb.Block, additionalParents []string, addInde
 // indexBlock stores off index info for this block type
 func (t *Thread) indexBlock(index *pb.Block, replace bool) error {
 	var err error
+	if index.Clock == 0 {
+		parents := index.Parents
+		if index.Status == pb.Block_QUEUED {
+			// queued blocks will be posted on top of the current heads
+			parents, err = t.Heads()
+			if err != nil {
+				return err
+			}
+		}
+		index.Clock = t.clock(parents)
+	}
 	if replace {
 		err = t.datastore.Blocks().Replace(index)
 	} else {
@@ -657,7 +670,115 @@ outer:
 		add(h)
 	}
 
-	return t.updateHead(next, true)
+	err = t.updateHead(next, true)
+	if err != nil {
+		return err
+	}
+
+	// blocks found during back prop are indexed before their parents
+	return t.reclock()
+}
+
+// clock returns the causal clock for a block with the given parent nodes,
+// or zero if a parent's clock is not yet known
+func (t *Thread) clock(parents []string) int64 {
+	var max int64
+	for _, p := range parents {
+		if p == "" {
+			continue // some old blocks may contain empty string parents
+		}
+		id, err := blockCIDFromNode(t.node(), p)
+		if err != nil {
+			return 0
+		}
+		parent := t.datastore.Blocks().Get(id)
+		if parent == nil || parent.Clock == 0 {
+			return 0
+		}
+		if parent.Clock > max {
+			max = parent.Clock
+		}
+	}
+	return max + 1
+}
+
+// reclock computes clocks for blocks which were indexed before their parents
+// Note: Parents which are not indexed are treated as genesis blocks
+func (t *Thread) reclock() error {
+	query := fmt.Sprintf("threadId='%s' and clock=0", t.Id)
+	unknown := t.datastore.Blocks().List("", -1, query).Items
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	ids := make(map[string]string)
+	blockId := func(node string) string {
+		if id, ok := ids[node]; ok {
+			return id
+		}
+		id, err := blockCIDFromNode(t.node(), node)
+		if err != nil {
+			log.Warningf("error getting block from node %s: %s", node, err)
+		}
+		ids[node] = id
+		return id
+	}
+
+	var resolve func(block *pb.Block) (int64, error)
+	resolve = func(block *pb.Block) (int64, error) {
+		if block.Clock > 0 {
+			return block.Clock, nil
+		}
+
+		parents := block.Parents
+		if block.Status == pb.Block_QUEUED {
+			var err error
+			parents, err = t.Heads()
+			if err != nil {
+				return 0, err
+			}
+		}
+
+		var max int64
+		for _, p := range parents {
+			if p == "" {
+				continue
+			}
+			id := blockId(p)
+			if id == "" {
+				continue
+			}
+			parent := t.datastore.Blocks().Get(id)
+			if parent == nil {
+				continue
+			}
+			clock, err := resolve(parent)
+			if err != nil {
+				return 0, err
+			}
+			if clock > max {
+				max = clock
+			}
+		}
+
+		block.Clock = max + 1
+		err := t.datastore.Blocks().UpdateClock(block.Id, block.Clock)
+		if err != nil {
+			return 0, err
+		}
+		return block.Clock, nil
+	}
+
+	for _, block := range unknown {
+		_, err := resolve(block)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debugf("clocked %d blocks in %s", len(unknown), t.Id)
+
+	return nil
 }
 
 // addHead adds an additional (usually temporary) head
@@ -925,18 +1046,39 @@ func extractNode(ipfsNode *core.IpfsNode, node ipld.Node, downloadBlock bool) (*
 		return nil, err
 	}
 
+	bnode, pnode, err := readNode(ipfsNode, node)
+	if err != nil {
+		return nil, err
+	}
+	err = ipfs.PinNode(ipfsNode, pnode, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if downloadBlock {
+		bnode.ciphertext, err = ipfs.DataAtPath(ipfsNode, bnode.hash)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return bnode, nil
+}
+
+// readNode reads block components from an ipld node without pinning or downloading
+// Note: The parents node is also returned
+func readNode(ipfsNode *core.IpfsNode, node ipld.Node) (*blockNode, ipld.Node, error) {
 	bnode := &blockNode{}
 	links := node.Links()
 
 	// get parents
 	plink := schema.LinkByName(links, []string{parentsLinkName})
-	pnode, err := ipfs.NodeAtLink(ipfsNode, plink)
-	if err != nil {
-		return nil, err
+	if plink == nil {
+		return nil, nil, ErrInvalidNode
 	}
-	err = ipfs.PinNode(ipfsNode, pnode, false)
+	pnode, err := ipfs.NodeAtLink(ipfsNode, plink)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, l := range pnode.Links() {
 		bnode.parents = append(bnode.parents, l.Cid.Hash().B58String())
@@ -956,15 +1098,12 @@ func extractNode(ipfsNode *core.IpfsNode, node ipld.Node, downloadBlock bool) (*
 
 	// get block
 	blink := schema.LinkByName(links, []string{blockLinkName})
-	bnode.hash = blink.Cid.Hash().B58String()
-	if downloadBlock {
-		bnode.ciphertext, err = ipfs.DataAtPath(ipfsNode, bnode.hash)
-		if err != nil {
-			return nil, err
-		}
+	if blink == nil {
+		return nil, nil, ErrInvalidNode
 	}
+	bnode.hash = blink.Cid.Hash().B58String()
 
-	return bnode, nil
+	return bnode, pnode, nil
 }
 
 // blockCIDFromNode returns the inner block id from its ipld wrapper
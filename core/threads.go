@@ -211,6 +211,12 @@ func (t *Textile) AddOrUpdateThread(thread *pb.Thread) error {
 		if err != nil {
 			return err
 		}
+		if thread.ForkThread != "" {
+			err = t.datastore.Threads().UpdateFork(nthread.Id, thread.ForkThread, thread.ForkHead)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	// have we joined?
@@ -11,6 +11,9 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+// ErrCafeTokenNotFound indicates a token is not stored by this cafe
+var ErrCafeTokenNotFound = fmt.Errorf("cafe token not found")
+
 // CafeTokens lists all locally-stored (bcrypt hashed) tokens
 func (t *Textile) CafeTokens() ([]string, error) {
 	tokens := t.datastore.CafeTokens().List()
@@ -79,7 +82,7 @@ func (t *Textile) ValidateCafeToken(token string) (bool, error) {
 	}
 
 	encodedToken := t.datastore.CafeTokens().Get(hex.EncodeToString(plainBytes[:12]))
-	if encodedToken == nil {
+	if encodedToken == nil || encodedToken.Disabled {
 		return false, err
 	}
 	if err := bcrypt.CompareHashAndPassword(encodedToken.Value, plainBytes[12:]); err != nil {
@@ -90,13 +93,45 @@ func (t *Textile) ValidateCafeToken(token string) (bool, error) {
 
 // RemoveCafeToken removes a given cafe token from the local store
 func (t *Textile) RemoveCafeToken(token string) error {
+	id, err := cafeTokenId(token)
+	if err != nil {
+		return err
+	}
+	return t.datastore.CafeTokens().Delete(id)
+}
+
+// DisableCafeToken stops a cafe token from being used for new registrations.
+// Sessions already issued to clients registered w/ the token remain valid.
+func (t *Textile) DisableCafeToken(token string) error {
+	return t.setCafeTokenDisabled(token, true)
+}
+
+// EnableCafeToken allows a disabled cafe token to be used for registrations again
+func (t *Textile) EnableCafeToken(token string) error {
+	return t.setCafeTokenDisabled(token, false)
+}
+
+// setCafeTokenDisabled updates the disabled flag of a stored token
+func (t *Textile) setCafeTokenDisabled(token string, disabled bool) error {
+	id, err := cafeTokenId(token)
+	if err != nil {
+		return err
+	}
+	if t.datastore.CafeTokens().Get(id) == nil {
+		return ErrCafeTokenNotFound
+	}
+	return t.datastore.CafeTokens().UpdateDisabled(id, disabled)
+}
+
+// cafeTokenId returns the store id of a base58 encoded token
+func cafeTokenId(token string) (string, error) {
 	// dev tokens are actually base58(id+token)
 	plainBytes, err := base58.FastBase58Decoding(token)
 	if err != nil {
-		return err
+		return "", err
 	}
 	if len(plainBytes) < 44 {
-		return fmt.Errorf("invalid token format")
+		return "", fmt.Errorf("invalid token format")
 	}
-	return t.datastore.CafeTokens().Delete(hex.EncodeToString(plainBytes[:12]))
+	return hex.EncodeToString(plainBytes[:12]), nil
 }
@@ -0,0 +1,355 @@
+package core
+
+import (
+	"fmt"
+
+	icid "github.com/ipfs/go-cid"
+	ipld "github.com/ipfs/go-ipld-format"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema"
+)
+
+// VerifyThread walks a thread's dag from its heads, checking that each node and block
+// is locally available, readable with the thread key, indexed, and pinned. Files blocks
+// are also checked for file indexes and pinned data nodes.
+// When repair is true, missing and stuck blocks are re-queued for download and
+// unpinned nodes are re-pinned.
+// Note: Blocks are not individually signed, a block that decrypts w/ the thread key is authentic
+func (t *Textile) VerifyThread(id string, repair bool) (*pb.ThreadVerification, error) {
+	thread := t.Thread(id)
+	if thread == nil {
+		return nil, ErrThreadNotFound
+	}
+	if !thread.readable(t.config.Account.Address) {
+		return nil, ErrNotReadable
+	}
+
+	return thread.verify(repair)
+}
+
+// verify walks the dag from the current heads
+func (t *Thread) verify(repair bool) (*pb.ThreadVerification, error) {
+	heads, err := t.Heads()
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ThreadVerification{Thread: t.Id}
+	visited := make(map[string]struct{})
+	queue := heads
+	for len(queue) > 0 {
+		nhash := queue[0]
+		queue = queue[1:]
+		if nhash == "" {
+			continue // some old blocks may contain empty string parents
+		}
+		if _, ok := visited[nhash]; ok {
+			continue
+		}
+		visited[nhash] = struct{}{}
+		res.Count++
+
+		v := &nodeVerifier{thread: t, node: nhash, repair: repair}
+		queue = append(queue, v.verify()...)
+		res.Issues = append(res.Issues, v.issues...)
+	}
+
+	log.Debugf("verified %d nodes in %s, found %d issues", res.Count, t.Id, len(res.Issues))
+
+	return res, nil
+}
+
+// nodeVerifier checks a single dag node
+type nodeVerifier struct {
+	thread *Thread
+	node   string
+	repair bool
+	issues []*pb.ThreadVerification_Issue
+}
+
+// verify returns the parents which should be walked next
+func (v *nodeVerifier) verify() []string {
+	t := v.thread
+
+	ncid, err := icid.Decode(v.node)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_INVALID_NODE, "", err.Error(), nil)
+		return nil
+	}
+	if !t.local(ncid) {
+		v.report(pb.ThreadVerification_Issue_MISSING_NODE, "", "node is not available locally", v.follow)
+		return nil
+	}
+	node, err := ipfs.NodeAtCid(t.node(), ncid)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_MISSING_NODE, "", err.Error(), v.follow)
+		return nil
+	}
+
+	bnode := &blockNode{}
+	var parents []string
+	if len(node.Links()) == 0 {
+		// older block, parents are only known from the index
+		bnode.hash = v.node
+	} else {
+		err = validateNode(node)
+		if err != nil {
+			v.report(pb.ThreadVerification_Issue_INVALID_NODE, "", err.Error(), nil)
+			return nil
+		}
+		plink := schema.LinkByName(node.Links(), []string{parentsLinkName})
+		if plink == nil || !t.local(plink.Cid) {
+			v.report(pb.ThreadVerification_Issue_MISSING_NODE, "", "parents are not available locally", v.follow)
+			return nil
+		}
+		var pnode ipld.Node
+		bnode, pnode, err = readNode(t.node(), node)
+		if err != nil {
+			v.report(pb.ThreadVerification_Issue_INVALID_NODE, "", err.Error(), nil)
+			return nil
+		}
+		parents = bnode.parents
+
+		v.checkPinned(node, false)
+		v.checkPinned(pnode, false)
+	}
+
+	index := t.datastore.Blocks().Get(bnode.hash)
+	if index == nil {
+		v.report(pb.ThreadVerification_Issue_MISSING_BLOCK, bnode.hash, "block is not indexed", func() error {
+			return t.requeue(bnode)
+		})
+		return parents
+	}
+	if parents == nil {
+		parents = index.Parents
+	}
+	if index.Thread != t.Id {
+		msg := fmt.Sprintf("block is indexed in thread %s", index.Thread)
+		v.report(pb.ThreadVerification_Issue_INDEX_MISMATCH, bnode.hash, msg, nil)
+		return parents
+	}
+	if index.Status == pb.Block_PENDING {
+		msg := fmt.Sprintf("block is pending after %d attempts", index.Attempts)
+		v.report(pb.ThreadVerification_Issue_PENDING_BLOCK, bnode.hash, msg, func() error {
+			return t.requeue(bnode)
+		})
+		return parents
+	}
+
+	bcid, err := icid.Decode(bnode.hash)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_INVALID_BLOCK, bnode.hash, err.Error(), nil)
+		return parents
+	}
+	if !t.local(bcid) {
+		v.report(pb.ThreadVerification_Issue_MISSING_BLOCK, bnode.hash, "block is not available locally", func() error {
+			return t.requeue(bnode)
+		})
+		return parents
+	}
+	bnd, err := ipfs.NodeAtCid(t.node(), bcid)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_MISSING_BLOCK, bnode.hash, err.Error(), nil)
+		return parents
+	}
+	v.checkPinned(bnd, true)
+
+	bnode.ciphertext, err = ipfs.DataAtPath(t.node(), bnode.hash)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_MISSING_BLOCK, bnode.hash, err.Error(), nil)
+		return parents
+	}
+	block, err := t.unmarshalBlock(bnode.ciphertext)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_INVALID_BLOCK, bnode.hash, err.Error(), nil)
+		return parents
+	}
+	if block.Type != index.Type || block.Header.Author != index.Author {
+		v.report(pb.ThreadVerification_Issue_INDEX_MISMATCH, bnode.hash, "block does not match its index", func() error {
+			return v.rehandle(bnode, index)
+		})
+		return parents
+	}
+
+	if index.Type == pb.Block_FILES {
+		v.checkFiles(bnode, index)
+	}
+
+	return parents
+}
+
+// checkPinned reports an issue if the node is not pinned
+func (v *nodeVerifier) checkPinned(node ipld.Node, recursive bool) {
+	id := node.Cid().Hash().B58String()
+	list, err := ipfs.NotPinned(v.thread.node(), []string{id})
+	if err != nil {
+		log.Warningf("error checking pin for %s: %s", id, err)
+		return
+	}
+	if len(list) == 0 {
+		return
+	}
+
+	v.report(pb.ThreadVerification_Issue_UNPINNED, "", id+" is not pinned", func() error {
+		return ipfs.PinNode(v.thread.node(), node, recursive)
+	})
+}
+
+// checkFiles reports issues with a files block's data node and file indexes
+func (v *nodeVerifier) checkFiles(bnode *blockNode, index *pb.Block) {
+	t := v.thread
+	if index.Data == "" {
+		return
+	}
+
+	query := fmt.Sprintf("target='%s' and type=%d", index.Id, pb.Block_IGNORE)
+	if len(t.datastore.Blocks().List("", 1, query).Items) > 0 {
+		return // ignored files are not pinned or indexed
+	}
+
+	fix := func() error {
+		return v.rehandle(bnode, index)
+	}
+
+	dcid, err := icid.Decode(index.Data)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_MISSING_FILE, index.Id, err.Error(), nil)
+		return
+	}
+	if !t.local(dcid) {
+		v.report(pb.ThreadVerification_Issue_MISSING_FILE, index.Id, "data is not available locally", fix)
+		return
+	}
+	dnode, err := ipfs.NodeAtCid(t.node(), dcid)
+	if err != nil {
+		v.report(pb.ThreadVerification_Issue_MISSING_FILE, index.Id, err.Error(), fix)
+		return
+	}
+	v.checkPinned(dnode, false)
+
+	var files []ipld.Node
+	var missing []string
+	for _, link := range dnode.Links() {
+		if !t.local(link.Cid) {
+			missing = append(missing, link.Cid.Hash().B58String())
+			continue
+		}
+		nd, err := ipfs.NodeAtLink(t.node(), link)
+		if err != nil {
+			missing = append(missing, link.Cid.Hash().B58String())
+			continue
+		}
+
+		if looksLikeFileNode(nd) {
+			files = append(files, nd)
+			continue
+		}
+		for _, l := range nd.Links() {
+			if !t.local(l.Cid) {
+				missing = append(missing, l.Cid.Hash().B58String())
+				continue
+			}
+			n, err := ipfs.NodeAtLink(t.node(), l)
+			if err != nil {
+				missing = append(missing, l.Cid.Hash().B58String())
+				continue
+			}
+			files = append(files, n)
+		}
+	}
+	for _, hash := range missing {
+		v.report(pb.ThreadVerification_Issue_MISSING_FILE, index.Id, hash+" is not available locally", fix)
+		fix = nil // one re-handle covers all files in the block
+	}
+
+	for _, f := range files {
+		dlink := schema.LinkByName(f.Links(), ValidContentLinkNames)
+		if dlink == nil {
+			continue
+		}
+		hash := dlink.Cid.Hash().B58String()
+		file := t.datastore.Files().Get(hash)
+		if file == nil {
+			v.report(pb.ThreadVerification_Issue_MISSING_FILE, index.Id, hash+" is not indexed", fix)
+			fix = nil
+		} else if !targetIn(index.Data, file.Targets) {
+			v.report(pb.ThreadVerification_Issue_MISSING_FILE, index.Id, hash+" is not targeted by the block data", func() error {
+				return t.datastore.Files().AddTarget(hash, index.Data)
+			})
+		}
+	}
+}
+
+// rehandle re-processes a locally available block, restoring its index, files and pins
+func (v *nodeVerifier) rehandle(bnode *blockNode, index *pb.Block) error {
+	if index.Parents != nil && bnode.parents == nil {
+		bnode.parents = index.Parents
+	}
+	_, err := v.thread.handle(bnode, true)
+	return err
+}
+
+// follow re-fetches the node, queueing downloads along the way
+func (v *nodeVerifier) follow() error {
+	_, err := v.thread.followParent(v.node)
+	return err
+}
+
+// report adds an issue, attempting a fix if repairing
+func (v *nodeVerifier) report(typ pb.ThreadVerification_Issue_Type, block string, msg string, fix func() error) {
+	issue := &pb.ThreadVerification_Issue{
+		Type:  typ,
+		Node:  v.node,
+		Block: block,
+		Msg:   msg,
+	}
+	if v.repair && fix != nil {
+		err := fix()
+		if err != nil {
+			log.Warningf("failed to repair %s: %s", v.node, err)
+			issue.Msg += " (repair failed: " + err.Error() + ")"
+		} else {
+			issue.Repaired = true
+		}
+	}
+	v.issues = append(v.issues, issue)
+}
+
+// requeue (re)adds a block download with a fresh attempt count
+func (t *Thread) requeue(bnode *blockNode) error {
+	err := t.datastore.Blocks().Delete(bnode.hash)
+	if err != nil {
+		return err
+	}
+	return t.blockDownloads.Add(&pb.Block{
+		Id:      bnode.hash,
+		Thread:  t.Id,
+		Parents: bnode.parents,
+		Target:  bnode.target,
+		Data:    bnode.data,
+		Status:  pb.Block_PENDING,
+		Clock:   t.clock(bnode.parents),
+	})
+}
+
+// local returns whether or not a cid is in the local blockstore
+func (t *Thread) local(id icid.Cid) bool {
+	has, err := t.node().Blockstore.Has(id)
+	if err != nil {
+		log.Warningf("error checking blockstore for %s: %s", id.Hash().B58String(), err)
+		return false
+	}
+	return has
+}
+
+// targetIn returns whether or not target is in the list
+func targetIn(target string, targets []string) bool {
+	for _, t := range targets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,359 @@
+package gateway
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	files "github.com/ipfs/go-ipfs-files"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/ipfs"
+	"github.com/textileio/go-textile/pb"
+)
+
+// archiveTimeout bounds the time spent streaming an archive
+const archiveTimeout = time.Minute * 10
+
+// archiveLimits bound the content of an archive
+var archiveLimits = struct {
+	size    int64
+	entries int
+}{
+	size:    4 << 30,
+	entries: 10000,
+}
+
+// errArchiveTooLarge indicates an archive would exceed the size or entry limits
+var errArchiveTooLarge = fmt.Errorf("archive too large")
+
+// listing is a directory listing for JSON rendering
+type listing struct {
+	Path    string         `json:"path"`
+	Entries []listingEntry `json:"entries"`
+}
+
+// listingEntry is a directory entry for JSON rendering
+type listingEntry struct {
+	Name  string `json:"name"`
+	Cid   string `json:"cid"`
+	Size  uint64 `json:"size"`
+	Type  string `json:"type"`
+	Media string `json:"media,omitempty"`
+}
+
+// serveListing writes the entries of the directory at path as JSON
+func (g *Gateway) serveListing(c *gin.Context, pth string) {
+	entries, err := ipfs.EntriesAtPath(g.Node.Ipfs(), pth)
+	if err != nil {
+		log.Debugf("error listing %s: %s", pth, err)
+		c.JSON(http.StatusNotFound, gin.H{"error": err.Error()})
+		return
+	}
+
+	list := listing{Path: c.Request.URL.Path, Entries: make([]listingEntry, 0)}
+	for _, entry := range entries {
+		list.Entries = append(list.Entries, listingEntry{
+			Name: entry.Name,
+			Cid:  entry.Cid.String(),
+			Size: entry.Size,
+			Type: entry.Type.String(),
+		})
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// serveFilesListing writes the files of a thread files block as JSON. Entries are named
+// like archive entries, by file index and link path, e.g., 0/large.
+func (g *Gateway) serveFilesListing(c *gin.Context, block *pb.Files) {
+	list := listing{Path: c.Request.URL.Path, Entries: make([]listingEntry, 0)}
+	add := func(name string, file *pb.FileIndex) {
+		list.Entries = append(list.Entries, listingEntry{
+			Name:  name,
+			Cid:   file.Hash,
+			Size:  uint64(file.Size),
+			Type:  "file",
+			Media: file.Media,
+		})
+	}
+	for i, f := range block.Files {
+		dir := strconv.Itoa(i)
+		if f.File != nil {
+			add(dir, f.File)
+			continue
+		}
+		for _, name := range linkNames(f) {
+			add(archiveName(dir, name), f.Links[name])
+		}
+	}
+	c.JSON(http.StatusOK, list)
+}
+
+// serveArchive streams the subtree of the directory at path as a tar or zip archive
+func (g *Gateway) serveArchive(c *gin.Context, pth string, format string) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), archiveTimeout)
+	defer cancel()
+
+	dir, err := ipfs.DirectoryAtPath(ctx, g.Node.Ipfs(), pth)
+	if err != nil {
+		log.Debugf("error getting directory %s: %s", pth, err)
+		g.render404(c)
+		return
+	}
+	defer dir.Close()
+
+	archive, err := g.startArchive(c, format, path.Base(pth))
+	if err != nil {
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	g.finishArchive(c, archive, pth, writeDirectory(ctx, archive, dir, ""))
+}
+
+// serveFilesArchive streams the decrypted files of a thread files block as a tar or zip
+// archive. Entries are named by file index, link path, and file name, e.g., 0/large/a.jpg.
+func (g *Gateway) serveFilesArchive(c *gin.Context, block *pb.Files, format string) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), archiveTimeout)
+	defer cancel()
+
+	archive, err := g.startArchive(c, format, block.Block)
+	if err != nil {
+		c.String(http.StatusBadRequest, err.Error())
+		return
+	}
+	g.finishArchive(c, archive, block.Block, g.writeFiles(ctx, archive, block))
+}
+
+// writeFiles writes the decrypted files of a thread files block to an archive
+func (g *Gateway) writeFiles(ctx context.Context, archive archiveWriter, block *pb.Files) error {
+	for i, f := range block.Files {
+		dir := strconv.Itoa(i)
+		if f.File != nil {
+			if err := g.writeFileIndex(ctx, archive, dir, f.File); err != nil {
+				return err
+			}
+			continue
+		}
+
+		for _, name := range linkNames(f) {
+			if err := g.writeFileIndex(ctx, archive, archiveName(dir, name), f.Links[name]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// finishArchive closes an archive, or if writing it failed, aborts the response so that
+// the client doesn't mistake a partial archive for a whole one
+func (g *Gateway) finishArchive(c *gin.Context, archive archiveWriter, name string, err error) {
+	if err != nil {
+		log.Errorf("error writing archive of %s: %s", name, err)
+		core.AbortResponse(c.Writer)
+		return
+	}
+	if err := archive.Close(); err != nil {
+		log.Errorf("error closing archive of %s: %s", name, err)
+	}
+}
+
+// writeFileIndex writes the decrypted content of a file to an archive under dir
+func (g *Gateway) writeFileIndex(ctx context.Context, archive archiveWriter, dir string, file *pb.FileIndex) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	content, err := g.Node.FileIndexReader(file)
+	if err != nil {
+		return err
+	}
+	defer content.Close()
+
+	size, err := content.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err := content.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	name := file.Name
+	if name == "" {
+		name = file.Hash
+	}
+	return archive.WriteFile(archiveName(dir, path.Base(name)), size, content)
+}
+
+// startArchive writes the headers of an archive download and returns its writer
+func (g *Gateway) startArchive(c *gin.Context, format string, name string) (archiveWriter, error) {
+	var archive archiveWriter
+	var media string
+	switch format {
+	case "tar":
+		archive = &tarArchive{w: tar.NewWriter(c.Writer)}
+		media = "application/x-tar"
+	case "zip":
+		archive = &zipArchive{w: zip.NewWriter(c.Writer)}
+		media = "application/zip"
+	default:
+		return nil, fmt.Errorf("invalid download format: %s", format)
+	}
+
+	c.Header("Content-Type", media)
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, name, format))
+	c.Status(http.StatusOK)
+	return &limitedArchive{
+		archiveWriter: archive,
+		size:          archiveLimits.size,
+		entries:       archiveLimits.entries,
+	}, nil
+}
+
+// writeDirectory recursively writes the entries of a directory to an archive under prefix
+func writeDirectory(ctx context.Context, archive archiveWriter, dir files.Directory, prefix string) error {
+	it := dir.Entries()
+	for it.Next() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		name := archiveName(prefix, it.Name())
+		switch nd := it.Node().(type) {
+		case files.Directory:
+			if err := archive.WriteDir(name); err != nil {
+				return err
+			}
+			if err := writeDirectory(ctx, archive, nd, name); err != nil {
+				return err
+			}
+		case files.File:
+			size, err := nd.Size()
+			if err != nil {
+				return err
+			}
+			if err := archive.WriteFile(name, size, nd); err != nil {
+				return err
+			}
+		}
+	}
+	return it.Err()
+}
+
+// linkNames returns the sorted link names of a file
+func linkNames(file *pb.File) []string {
+	var names []string
+	for name := range file.Links {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// archiveName joins the parts of an entry name, keeping it within the archive root
+func archiveName(parts ...string) string {
+	return strings.TrimPrefix(path.Join(append([]string{"/"}, parts...)...), "/")
+}
+
+// archiveWriter writes entries to an archive
+type archiveWriter interface {
+	WriteDir(name string) error
+	WriteFile(name string, size int64, content io.Reader) error
+	Close() error
+}
+
+// limitedArchive is an archiveWriter that fails once its size or entry limits are exceeded
+type limitedArchive struct {
+	archiveWriter
+	size    int64 // remaining bytes
+	entries int   // remaining entries
+}
+
+func (a *limitedArchive) WriteDir(name string) error {
+	if err := a.add(0); err != nil {
+		return err
+	}
+	return a.archiveWriter.WriteDir(name)
+}
+
+func (a *limitedArchive) WriteFile(name string, size int64, content io.Reader) error {
+	if err := a.add(size); err != nil {
+		return err
+	}
+	return a.archiveWriter.WriteFile(name, size, io.LimitReader(content, size))
+}
+
+// add counts an entry of size against the limits
+func (a *limitedArchive) add(size int64) error {
+	if a.entries <= 0 || size > a.size {
+		return errArchiveTooLarge
+	}
+	a.entries--
+	a.size -= size
+	return nil
+}
+
+// tarArchive is a tar archiveWriter
+type tarArchive struct {
+	w *tar.Writer
+}
+
+func (a *tarArchive) WriteDir(name string) error {
+	return a.w.WriteHeader(&tar.Header{
+		Name:     name + "/",
+		Typeflag: tar.TypeDir,
+		Mode:     0755,
+		ModTime:  time.Now(),
+	})
+}
+
+func (a *tarArchive) WriteFile(name string, size int64, content io.Reader) error {
+	err := a.w.WriteHeader(&tar.Header{
+		Name:     name,
+		Typeflag: tar.TypeReg,
+		Size:     size,
+		Mode:     0644,
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(a.w, content)
+	return err
+}
+
+func (a *tarArchive) Close() error {
+	return a.w.Close()
+}
+
+// zipArchive is a zip archiveWriter
+type zipArchive struct {
+	w *zip.Writer
+}
+
+func (a *zipArchive) WriteDir(name string) error {
+	_, err := a.w.Create(name + "/")
+	return err
+}
+
+func (a *zipArchive) WriteFile(name string, size int64, content io.Reader) error {
+	w, err := a.w.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(w, content)
+	return err
+}
+
+func (a *zipArchive) Close() error {
+	return a.w.Close()
+}
@@ -0,0 +1,151 @@
+package gateway
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestArchiveName(t *testing.T) {
+	tests := map[string][]string{
+		"0/large/a.jpg": {"0", "large", "a.jpg"},
+		"a/b":           {"", "a/b"},
+		"etc/passwd":    {"..", "../etc/passwd"},
+		"x":             {"/x"},
+	}
+	for expected, parts := range tests {
+		if name := archiveName(parts...); name != expected {
+			t.Errorf("expected %s, got %s", expected, name)
+		}
+	}
+}
+
+func TestGateway_DirectoryListing(t *testing.T) {
+	g := testGateway(t)
+	id := testDirectory(t, g)
+
+	req := httptest.NewRequest(http.MethodGet, "/ipfs/"+id, nil)
+	req.Header.Set("Accept", "application/json")
+	res := testRequest(g, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", res.Code, res.Body.String())
+	}
+
+	var list listing
+	if err := json.Unmarshal(res.Body.Bytes(), &list); err != nil {
+		t.Fatal(err)
+	}
+	types := make(map[string]string)
+	for _, entry := range list.Entries {
+		types[entry.Name] = entry.Type
+	}
+	if len(types) != 2 || types["a.txt"] != "file" || types["sub"] != "directory" {
+		t.Fatalf("unexpected entries: %v", list.Entries)
+	}
+}
+
+func TestGateway_DirectoryArchive(t *testing.T) {
+	g := testGateway(t)
+	id := testDirectory(t, g)
+
+	expected := map[string]string{
+		"a.txt":     "hello",
+		"sub/":      "",
+		"sub/b.txt": "world",
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/ipfs/"+id+"?download=tar", nil)
+	res := testRequest(g, req)
+	if res.Code != http.StatusOK || res.Header().Get("Content-Type") != "application/x-tar" {
+		t.Fatalf("expected a tar archive, got %d: %s", res.Code, res.Header().Get("Content-Type"))
+	}
+	entries := make(map[string]string)
+	tr := tar.NewReader(res.Body)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries[hdr.Name] = string(data)
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("unexpected tar entries: %v", entries)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/ipfs/"+id+"?download=zip", nil)
+	res = testRequest(g, req)
+	if res.Code != http.StatusOK || res.Header().Get("Content-Type") != "application/zip" {
+		t.Fatalf("expected a zip archive, got %d: %s", res.Code, res.Header().Get("Content-Type"))
+	}
+	zr, err := zip.NewReader(bytes.NewReader(res.Body.Bytes()), int64(res.Body.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries = make(map[string]string)
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := ioutil.ReadAll(rc)
+		_ = rc.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries[f.Name] = string(data)
+	}
+	if !reflect.DeepEqual(entries, expected) {
+		t.Fatalf("unexpected zip entries: %v", entries)
+	}
+
+	req = httptest.NewRequest(http.MethodGet, "/ipfs/"+id+"?download=rar", nil)
+	if res = testRequest(g, req); res.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d", res.Code)
+	}
+}
+
+func TestGateway_DirectoryArchiveLimits(t *testing.T) {
+	g := testGateway(t)
+	id := testDirectory(t, g)
+	server := httptest.NewServer(g.server.Handler)
+	defer server.Close()
+
+	limits := archiveLimits
+	defer func() {
+		archiveLimits = limits
+	}()
+
+	for _, limit := range []struct {
+		size    int64
+		entries int
+	}{
+		{size: limits.size, entries: 2},
+		{size: 7, entries: limits.entries},
+	} {
+		archiveLimits = limit
+		res, err := http.Get(server.URL + "/ipfs/" + id + "?download=tar")
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the connection is dropped rather than ending a partial archive cleanly
+		_, err = ioutil.ReadAll(res.Body)
+		_ = res.Body.Close()
+		if err == nil {
+			t.Fatalf("expected a read error w/ limits %v", limit)
+		}
+	}
+}
@@ -1,11 +1,16 @@
 package gateway
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"fmt"
 	"html/template"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,6 +18,8 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/render"
 	"github.com/golang/protobuf/jsonpb"
+	icid "github.com/ipfs/go-cid"
+	files "github.com/ipfs/go-ipfs-files"
 	ipld "github.com/ipfs/go-ipld-format"
 	logging "github.com/ipfs/go-log"
 	ipfspath "github.com/ipfs/go-path"
@@ -39,6 +46,9 @@ type Gateway struct {
 	Node   *core.Textile
 	Bots   *bots.Service
 	server *http.Server
+	images *imageCache
+
+	decryptedImages *imageCache
 }
 
 // Start creates a gateway server
@@ -58,6 +68,20 @@ func (g *Gateway) Start(addr string) {
 
 	router.SetHTMLTemplate(parseTemplates())
 
+	images, err := newImageCache(filepath.Join(g.Node.RepoPath(), "gateway", "images"), conf.Gateway.Images.CacheSize)
+	if err != nil {
+		log.Errorf("error loading image cache: %s", err)
+		images, _ = newImageCache("", 0)
+	}
+	g.images = images
+
+	// decrypted transforms are only cached in memory, and not at all w/ caching disabled
+	var decryptedSize int64 = decryptedImageCacheSize
+	if conf.Gateway.Images.CacheSize <= 0 {
+		decryptedSize = 0
+	}
+	g.decryptedImages, _ = newImageCache("", decryptedSize)
+
 	router.GET("/health", func(c *gin.Context) {
 		c.Writer.WriteHeader(http.StatusNoContent)
 	})
@@ -81,6 +105,11 @@ func (g *Gateway) Start(addr string) {
 	router.GET("/ipns/:root", g.ipnsHandler)
 	router.GET("/ipns/:root/*path", g.ipnsHandler)
 
+	router.GET("/threads/:id", g.threadHandler)
+	router.GET("/threads/:id/files/:block", g.threadFilesHandler)
+	router.GET("/threads/:id/files/:block/:index", g.threadFileHandler)
+	router.GET("/threads/:id/files/:block/:index/:path", g.threadFileHandler)
+
 	router.GET("/", g.cafeHandler)
 	router.GET("/cafe", g.cafeHandler)
 	router.GET("/cafes", g.cafesHandler)
@@ -138,10 +167,11 @@ func (g *Gateway) Addr() string {
 func (g *Gateway) ipfsHandler(c *gin.Context) {
 	contentPath := c.Param("root") + c.Param("path")
 
-	data := g.getDataAtPath(c, contentPath)
-	if data == nil {
+	file, id := g.getFileAtPath(c, contentPath)
+	if file == nil {
 		return
 	}
+	defer file.Close()
 
 	// attempt decrypt if key present
 	key, exists := c.GetQuery("key")
@@ -152,17 +182,30 @@ func (g *Gateway) ipfsHandler(c *gin.Context) {
 			g.render404(c)
 			return
 		}
+		// decrypt in full, gcm must authenticate the entire ciphertext
+		data, err := ioutil.ReadAll(file)
+		if err != nil {
+			log.Debugf("error reading %s: %s", contentPath, err)
+			g.render404(c)
+			return
+		}
 		plain, err := crypto.DecryptAES(data, keyb)
 		if err != nil {
 			log.Debugf("error decrypting %s: %s", contentPath, err)
 			g.render404(c)
 			return
 		}
-		c.Render(200, render.Data{Data: plain})
+		if g.serveTransform(c, bytes.NewReader(plain), id.String(), true, true) {
+			return
+		}
+		g.serveContent(c, bytes.NewReader(plain), id, true, true)
 		return
 	}
 
-	c.Render(200, render.Data{Data: data})
+	if g.serveTransform(c, file, id.String(), false, true) {
+		return
+	}
+	g.serveContent(c, file, id, false, true)
 }
 
 // ipnsHandler renders data behind an IPNS address
@@ -186,12 +229,157 @@ func (g *Gateway) ipnsHandler(c *gin.Context) {
 		return
 	}
 
-	data := g.getDataAtPath(c, pth.String()+pathp)
-	if data == nil {
+	file, id := g.getFileAtPath(c, pth.String()+pathp)
+	if file == nil {
 		return
 	}
+	defer file.Close()
 
-	c.Render(200, render.Data{Data: data})
+	// names are mutable, so content is not cached forever
+	if g.serveTransform(c, file, id.String(), false, false) {
+		return
+	}
+	g.serveContent(c, file, id, false, false)
+}
+
+// errUnauthorized indicates a request has neither an api key nor a signed link
+var errUnauthorized = fmt.Errorf("unauthorized")
+
+// threadFileHandler serves the decrypted content of a thread file, using the key from its
+// file index. Unless the thread is public, requests must have a bearer api key w/ read
+// access to the thread or be signed w/ a link from the node's API.
+func (g *Gateway) threadFileHandler(c *gin.Context) {
+	threadId := c.Param("id")
+	blockId := c.Param("block")
+	index, err := strconv.Atoi(c.Param("index"))
+	if err != nil {
+		g.render404(c)
+		return
+	}
+
+	files := g.threadFiles(c, core.FileLinkPath(threadId, blockId, index, c.Param("path")))
+	if files == nil {
+		return
+	}
+	file, err := core.FilesFile(files, index, c.Param("path"))
+	if err != nil {
+		g.render404(c)
+		return
+	}
+
+	content, err := g.Node.FileIndexReader(file)
+	if err != nil {
+		log.Debugf("error reading file %s: %s", file.Hash, err)
+		g.render404(c)
+		return
+	}
+	defer content.Close()
+
+	// access may be revoked, so only the client may cache content
+	c.Header("Cache-Control", "private")
+	if g.serveTransform(c, content, file.Hash, file.Key != "", false) {
+		return
+	}
+	core.ServeFileContent(c.Writer, c.Request, content, file.Hash, file.Media, file.Name, core.CacheNone)
+}
+
+// threadFilesHandler lists the files of a thread files block as JSON or, w/ a download param
+// of tar or zip, streams their decrypted content as an archive. Access is checked like
+// thread files, using the block's gateway path for signed links.
+func (g *Gateway) threadFilesHandler(c *gin.Context) {
+	files := g.threadFiles(c, core.FilesLinkPath(c.Param("id"), c.Param("block")))
+	if files == nil {
+		return
+	}
+
+	c.Header("Cache-Control", "private")
+	if format := c.Query("download"); format != "" {
+		g.serveFilesArchive(c, files, format)
+		return
+	}
+	g.serveFilesListing(c, files)
+}
+
+// threadFiles authorizes a request for the files block param at the gateway path pth,
+// rendering an error and returning nil if it fails or the block is not in the thread param
+func (g *Gateway) threadFiles(c *gin.Context, pth string) *pb.Files {
+	threadId := c.Param("id")
+	blockId := c.Param("block")
+	if err, code := g.authorizeFile(c, threadId, pth); err != nil {
+		if code == http.StatusUnauthorized {
+			c.Header("WWW-Authenticate", "Bearer")
+		}
+		c.String(code, err.Error())
+		return nil
+	}
+
+	block, err := g.Node.Block(blockId)
+	if err != nil || block.Thread != threadId {
+		g.render404(c)
+		return nil
+	}
+	files, err := g.Node.File(blockId)
+	if err != nil {
+		log.Debugf("error getting files %s: %s", blockId, err)
+		g.render404(c)
+		return nil
+	}
+	return files
+}
+
+// authorizeFile checks a thread file request for a public thread, a valid signed link,
+// or a bearer api key w/ read access to the thread
+func (g *Gateway) authorizeFile(c *gin.Context, threadId string, pth string) (error, int) {
+	if g.publicThread(threadId) != nil {
+		return nil, http.StatusOK
+	}
+	if sig := c.Query("sig"); sig != "" {
+		if err := g.Node.VerifyFileLink(pth, c.Query("expires"), sig); err != nil {
+			return err, http.StatusForbidden
+		}
+		return nil, http.StatusOK
+	}
+
+	header := c.GetHeader("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return errUnauthorized, http.StatusUnauthorized
+	}
+	key, err := g.Node.ValidateApiKey(strings.TrimSpace(strings.TrimPrefix(header, "Bearer ")))
+	if err != nil {
+		return err, http.StatusUnauthorized
+	}
+	if !core.ApiKeyAllows(key, pb.ApiKey_READ, threadId) {
+		return fmt.Errorf("api key does not have the read scope"), http.StatusForbidden
+	}
+	return nil, http.StatusOK
+}
+
+// serveContent writes content w/ its cid as ETag, honoring conditional and range requests.
+// The media type and name are taken from the content's file index, if known. Encrypted files
+// are only given their media type once decrypted.
+func (g *Gateway) serveContent(c *gin.Context, content io.ReadSeeker, id icid.Cid, decrypted bool, immutable bool) {
+	var media, name string
+	if file := g.Node.Datastore().Files().Get(id.Hash().B58String()); file != nil {
+		media = file.Media
+		if file.Key != "" && !decrypted {
+			media = "application/octet-stream"
+		}
+		name = file.Name
+	}
+
+	core.ServeFileContent(c.Writer, c.Request, content, id.String(), media, name, contentCache(decrypted, immutable))
+}
+
+// contentCache returns the cache policy of content. Decrypted content must not be stored by
+// shared caches, since anyone could then read it w/o the key.
+func contentCache(decrypted bool, immutable bool) core.ContentCache {
+	if !immutable {
+		return core.CacheNone
+	}
+	if decrypted {
+		return core.CachePrivate
+	}
+	return core.CachePublic
 }
 
 // cafeHandler returns this peer's cafe info
@@ -267,16 +455,27 @@ type link struct {
 	Size string
 }
 
-// getDataAtPath get raw data or directory links at path
-func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
-	data, err := g.Node.DataAtPath(pth)
+// getFileAtPath gets a file reader and its cid or renders directory links at path.
+// Directories are listed as JSON if accepted, or streamed as a tar or zip archive w/
+// a download param.
+func (g *Gateway) getFileAtPath(c *gin.Context, pth string) (files.File, icid.Cid) {
+	file, id, err := ipfs.FileAtPath(g.Node.Ipfs(), pth)
 	if err != nil {
 		if err == iface.ErrIsDir {
+			if format := c.Query("download"); format != "" {
+				g.serveArchive(c, pth, format)
+				return nil, icid.Undef
+			}
+			if c.NegotiateFormat(gin.MIMEHTML, gin.MIMEJSON) == gin.MIMEJSON {
+				g.serveListing(c, pth)
+				return nil, icid.Undef
+			}
+
 			root, err := ipfspath.ParsePath(pth)
 			if err != nil {
 				log.Debugf("error parsing path %s: %s", pth, err)
 				g.render404(c)
-				return nil
+				return nil, icid.Undef
 			}
 
 			var back string
@@ -293,7 +492,7 @@ func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
 			if err != nil {
 				log.Debugf("error getting links %s: %s", pth, err)
 				g.render404(c)
-				return nil
+				return nil, icid.Undef
 			}
 
 			var links []link
@@ -302,7 +501,7 @@ func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
 				if err != nil {
 					log.Debugf("error parsing path %s: %s", pth, err)
 					g.render404(c)
-					return nil
+					return nil, icid.Undef
 				}
 				links = append(links, link{
 					Path: ipath,
@@ -316,14 +515,14 @@ func (g *Gateway) getDataAtPath(c *gin.Context, pth string) []byte {
 				"back":  back,
 				"links": links,
 			})
-			return nil
+			return nil, icid.Undef
 		}
 
 		log.Debugf("error getting path %s: %s", pth, err)
 		g.render404(c)
-		return nil
+		return nil, icid.Undef
 	}
-	return data
+	return file, id
 }
 
 // render404 renders the 404 template
@@ -355,6 +554,10 @@ func parseTemplates() *template.Template {
 	if err != nil {
 		panic(err)
 	}
+	temp, err = temp.New("thread").Parse(templates.Thread)
+	if err != nil {
+		panic(err)
+	}
 	return temp
 }
 
@@ -0,0 +1,308 @@
+package gateway
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/mill"
+)
+
+// imageQuality is the jpeg quality of transforms w/o a q param
+const imageQuality = "80"
+
+// imageSourceSize is the maximum size of a source image w/o a configured limit
+const imageSourceSize = 32 << 20
+
+// decryptedImageCacheSize is the maximum size of decrypted transforms cached in memory
+const decryptedImageCacheSize = 32 << 20
+
+// imageSourcePixels is the maximum width x height of a source image w/o a configured limit
+const imageSourcePixels = 50000000
+
+// imageParams are the query params of an image transform
+var imageParams = []string{"w", "h", "fit", "format", "q"}
+
+// errTransformNotAllowed indicates an image transform is not in the allow-list
+var errTransformNotAllowed = fmt.Errorf("image transform not allowed")
+
+// errImageTooLarge indicates a source image exceeds the size limit
+var errImageTooLarge = fmt.Errorf("image too large")
+
+// imageTransform returns the canonical form of the image transform params of a query,
+// e.g., "fit=cover,h=100,w=100", or an empty string if there are none
+func imageTransform(query url.Values) string {
+	var parts []string
+	for _, param := range imageParams {
+		if val := query.Get(param); val != "" {
+			parts = append(parts, param+"="+val)
+		}
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+// allowsTransform returns whether or not a canonical transform is in the allow-list
+func (g *Gateway) allowsTransform(transform string) bool {
+	for _, allowed := range g.Node.Config().Gateway.Images.Transforms {
+		query := url.Values{}
+		for _, part := range strings.Split(allowed, ",") {
+			kv := strings.SplitN(strings.TrimSpace(part), "=", 2)
+			if len(kv) == 2 {
+				query.Set(kv[0], kv[1])
+			}
+		}
+		if imageTransform(query) == transform {
+			return true
+		}
+	}
+	return false
+}
+
+// serveTransform serves content resized by image transform query params, returning false
+// if the request has none. Content must already be authorized and, if needed, decrypted.
+// Decrypted and plaintext sources are cached separately so that a transform of decrypted
+// content is never served to a request for its ciphertext, nor written to disk.
+func (g *Gateway) serveTransform(c *gin.Context, content io.Reader, hash string, decrypted bool, immutable bool) bool {
+	transform := imageTransform(c.Request.URL.Query())
+	if transform == "" {
+		return false
+	}
+	if !g.allowsTransform(transform) {
+		c.String(http.StatusBadRequest, errTransformNotAllowed.Error())
+		return true
+	}
+
+	// decrypted transforms are only cached in memory so they never touch the disk
+	cache := g.images
+	key := "plain/" + hash + "?" + transform
+	if decrypted {
+		cache = g.decryptedImages
+		key = "decrypted/" + hash + "?" + transform
+	}
+
+	data, ok := cache.Get(key)
+	if !ok {
+		max := g.imageSourceSize()
+		input, err := ioutil.ReadAll(io.LimitReader(content, max+1))
+		if err != nil {
+			log.Debugf("error reading %s: %s", hash, err)
+			g.render404(c)
+			return true
+		}
+		if int64(len(input)) > max {
+			c.String(http.StatusRequestEntityTooLarge, errImageTooLarge.Error())
+			return true
+		}
+
+		// check dimensions before decoding, which allocates for every pixel
+		conf, _, err := image.DecodeConfig(bytes.NewReader(input))
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return true
+		}
+		if int64(conf.Width)*int64(conf.Height) > g.imageSourcePixels() {
+			c.String(http.StatusRequestEntityTooLarge, errImageTooLarge.Error())
+			return true
+		}
+
+		query := c.Request.URL.Query()
+		resize := &mill.ImageResize{
+			Opts: mill.ImageResizeOpts{
+				Width:   query.Get("w"),
+				Height:  query.Get("h"),
+				Fit:     query.Get("fit"),
+				Format:  query.Get("format"),
+				Quality: imageQuality,
+			},
+		}
+		if query.Get("q") != "" {
+			resize.Opts.Quality = query.Get("q")
+		}
+		res, err := resize.Mill(input, "")
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return true
+		}
+
+		data = res.File
+		if err := cache.Put(key, data); err != nil {
+			log.Errorf("error caching image transform %s: %s", key, err)
+		}
+	}
+
+	sum := sha256.Sum256([]byte(transform))
+	etag := hash + "-" + hex.EncodeToString(sum[:8])
+	core.ServeFileContent(c.Writer, c.Request, bytes.NewReader(data), etag,
+		http.DetectContentType(data), "", contentCache(decrypted, immutable))
+	return true
+}
+
+// imageSourceSize returns the configured maximum size of a source image
+func (g *Gateway) imageSourceSize() int64 {
+	if size := g.Node.Config().Gateway.Images.SourceSize; size > 0 {
+		return size
+	}
+	return imageSourceSize
+}
+
+// imageSourcePixels returns the configured maximum width x height of a source image
+func (g *Gateway) imageSourcePixels() int64 {
+	if pixels := g.Node.Config().Gateway.Images.SourcePixels; pixels > 0 {
+		return pixels
+	}
+	return imageSourcePixels
+}
+
+// imageCache is a bounded LRU of transformed images, on disk or, w/o a dir, in memory
+type imageCache struct {
+	dir   string
+	max   int64
+	size  int64
+	order *list.List // least recently used at the back
+	items map[string]*list.Element
+	lock  sync.Mutex
+}
+
+// imageCacheEntry is a cached file
+type imageCacheEntry struct {
+	name string
+	size int64
+	data []byte // only set in memory
+}
+
+// newImageCache returns a cache of at most max bytes in dir, loading existing files by
+// last access. An empty dir keeps the cache in memory. A zero max disables the cache.
+func newImageCache(dir string, max int64) (*imageCache, error) {
+	c := &imageCache{
+		dir:   dir,
+		max:   max,
+		order: list.New(),
+		items: make(map[string]*list.Element),
+	}
+	if max <= 0 || dir == "" {
+		return c, nil
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].ModTime().After(infos[j].ModTime())
+	})
+	for _, info := range infos {
+		if info.IsDir() {
+			continue
+		}
+		c.items[info.Name()] = c.order.PushBack(&imageCacheEntry{name: info.Name(), size: info.Size()})
+		c.size += info.Size()
+	}
+	c.evict()
+
+	return c, nil
+}
+
+// Get returns the cached data of key
+func (c *imageCache) Get(key string) ([]byte, bool) {
+	if c.max <= 0 {
+		return nil, false
+	}
+	name := c.name(key)
+
+	c.lock.Lock()
+	elem, ok := c.items[name]
+	if ok {
+		c.order.MoveToFront(elem)
+	}
+	c.lock.Unlock()
+	if !ok {
+		return nil, false
+	}
+	if c.dir == "" {
+		return elem.Value.(*imageCacheEntry).data, true
+	}
+
+	pth := filepath.Join(c.dir, name)
+	data, err := ioutil.ReadFile(pth)
+	if err != nil {
+		return nil, false
+	}
+	// keep the access order across restarts
+	now := time.Now()
+	_ = os.Chtimes(pth, now, now)
+
+	return data, true
+}
+
+// Put caches data under key, evicting the least recently used files as needed
+func (c *imageCache) Put(key string, data []byte) error {
+	size := int64(len(data))
+	if c.max <= 0 || size > c.max {
+		return nil
+	}
+	name := c.name(key)
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if elem, ok := c.items[name]; ok {
+		c.order.MoveToFront(elem)
+		return nil
+	}
+
+	entry := &imageCacheEntry{name: name, size: size}
+	if c.dir == "" {
+		entry.data = data
+	} else if err := ioutil.WriteFile(filepath.Join(c.dir, name), data, 0644); err != nil {
+		return err
+	}
+	c.items[name] = c.order.PushFront(entry)
+	c.size += size
+	c.evict()
+
+	return nil
+}
+
+// evict removes the least recently used files until the cache is within its bounds
+func (c *imageCache) evict() {
+	for c.size > c.max {
+		elem := c.order.Back()
+		if elem == nil {
+			return
+		}
+		entry := elem.Value.(*imageCacheEntry)
+		if c.dir != "" {
+			err := os.Remove(filepath.Join(c.dir, entry.name))
+			if err != nil && !os.IsNotExist(err) {
+				log.Errorf("error removing cached image %s: %s", entry.name, err)
+			}
+		}
+		c.order.Remove(elem)
+		delete(c.items, entry.name)
+		c.size -= entry.size
+	}
+}
+
+// name returns the file name of a key
+func (c *imageCache) name(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-textile/ipfs"
+)
+
+func TestImageTransform(t *testing.T) {
+	query, err := url.ParseQuery("w=100&x=1&fit=cover&h=100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transform := imageTransform(query); transform != "fit=cover,h=100,w=100" {
+		t.Fatalf("wrong transform: %s", transform)
+	}
+	if transform := imageTransform(url.Values{}); transform != "" {
+		t.Fatalf("expected no transform, got %s", transform)
+	}
+}
+
+func TestImageCache(t *testing.T) {
+	dir := "testdata/.images"
+	_ = os.RemoveAll(dir)
+	defer os.RemoveAll(dir)
+
+	cache, err := newImageCache(dir, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b"} {
+		if err := cache.Put(key, bytes.Repeat([]byte(key), 4)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := cache.Get("a"); !ok {
+		t.Fatal("expected a to be cached")
+	}
+
+	// b is least recently used
+	if err := cache.Put("c", []byte("cccc")); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get("b"); ok {
+		t.Fatal("expected b to be evicted")
+	}
+	data, ok := cache.Get("c")
+	if !ok || string(data) != "cccc" {
+		t.Fatal("expected c to be cached")
+	}
+
+	// too large to cache
+	if err := cache.Put("d", make([]byte, 11)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := cache.Get("d"); ok {
+		t.Fatal("expected d to be skipped")
+	}
+
+	// reload w/ a smaller bound
+	cache, err = newImageCache(dir, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cache.size != 4 || len(cache.items) != 1 {
+		t.Fatalf("expected one file after reload, got %d", len(cache.items))
+	}
+}
+
+func TestGateway_ImageSourceSize(t *testing.T) {
+	g := testGateway(t)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 200, 200))); err != nil {
+		t.Fatal(err)
+	}
+	id, err := ipfs.AddData(g.Node.Ipfs(), bytes.NewReader(buf.Bytes()), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := &g.Node.Config().Gateway.Images
+	size := conf.SourceSize
+	defer func() {
+		conf.SourceSize = size
+	}()
+
+	conf.SourceSize = int64(buf.Len())
+	req := httptest.NewRequest(http.MethodGet, "/ipfs/"+id.String()+"?w=100", nil)
+	if res := testRequest(g, req); res.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", res.Code, res.Body.String())
+	}
+
+	conf.SourceSize = int64(buf.Len()) - 1
+	req = httptest.NewRequest(http.MethodGet, "/ipfs/"+id.String()+"?w=320", nil)
+	if res := testRequest(g, req); res.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status 413, got %d: %s", res.Code, res.Body.String())
+	}
+}
+
+func TestGateway_ImageSourcePixels(t *testing.T) {
+	g := testGateway(t)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 300, 200))); err != nil {
+		t.Fatal(err)
+	}
+	id, err := ipfs.AddData(g.Node.Ipfs(), bytes.NewReader(buf.Bytes()), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := &g.Node.Config().Gateway.Images
+	pixels := conf.SourcePixels
+	defer func() {
+		conf.SourcePixels = pixels
+	}()
+
+	conf.SourcePixels = 300*200 - 1
+	req := httptest.NewRequest(http.MethodGet, "/ipfs/"+id.String()+"?w=100", nil)
+	if res := testRequest(g, req); res.Code != http.StatusRequestEntityTooLarge {
+		t.Fatalf("expected status 413, got %d: %s", res.Code, res.Body.String())
+	}
+
+	conf.SourcePixels = 300 * 200
+	if res := testRequest(g, req); res.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", res.Code, res.Body.String())
+	}
+
+	// not an image
+	id, err = ipfs.AddData(g.Node.Ipfs(), bytes.NewReader([]byte("nope")), false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req = httptest.NewRequest(http.MethodGet, "/ipfs/"+id.String()+"?w=100", nil)
+	if res := testRequest(g, req); res.Code != http.StatusBadRequest {
+		t.Fatalf("expected status 400, got %d: %s", res.Code, res.Body.String())
+	}
+}
+
+func TestImageCache_Memory(t *testing.T) {
+	cache, err := newImageCache("", 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b", "c"} {
+		if err := cache.Put(key, bytes.Repeat([]byte(key), 4)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, ok := cache.Get("a"); ok {
+		t.Fatal("expected a to be evicted")
+	}
+	data, ok := cache.Get("c")
+	if !ok || string(data) != "cccc" {
+		t.Fatal("expected c to be cached")
+	}
+}
+
+func TestGateway_DecryptedImageCache(t *testing.T) {
+	g := testGateway(t)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 120, 120))); err != nil {
+		t.Fatal(err)
+	}
+	serve := func(hash string, decrypted bool) {
+		res := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(res)
+		c.Request = httptest.NewRequest(http.MethodGet, "/?w=100", nil)
+		if !g.serveTransform(c, bytes.NewReader(buf.Bytes()), hash, decrypted, true) {
+			t.Fatal("expected a transform")
+		}
+		if res.Code != http.StatusOK {
+			t.Fatalf("expected status 200, got %d: %s", res.Code, res.Body.String())
+		}
+	}
+
+	disk := len(g.images.items)
+	serve("decrypted", true)
+	if len(g.images.items) != disk {
+		t.Fatal("decrypted transform should not be cached on disk")
+	}
+	if _, ok := g.decryptedImages.Get("decrypted/decrypted?w=100"); !ok {
+		t.Fatal("expected decrypted transform to be cached in memory")
+	}
+
+	serve("plain", false)
+	if len(g.images.items) != disk+1 {
+		t.Fatal("expected plaintext transform to be cached on disk")
+	}
+}
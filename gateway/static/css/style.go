@@ -67,4 +67,38 @@ li:nth-child(odd) {
 .aligner-item {
     max-width: 50%;
 }
+
+ul.feed li.post {
+    padding: 1em;
+    line-height: 1.5em;
+}
+
+li.post .meta, li.post .likes, li.post .event {
+    font-size: 0.85em;
+}
+
+li.post .body {
+    color: #DDDDDD;
+    white-space: pre-wrap;
+    margin: 0.5em 0;
+}
+
+li.post a.file {
+    display: inline-block;
+    margin: 0.5em 0.5em 0.5em 0;
+}
+
+li.post a.file img {
+    max-width: 320px;
+    max-height: 320px;
+}
+
+li.post .comment .user {
+    color: #999999;
+}
+
+.more {
+    margin-top: 1em;
+    font-size: 0.9em;
+}
 `
@@ -0,0 +1,35 @@
+package templates
+
+const Thread = `
+<html>
+    <head>
+        <title>{{.name}}</title>
+        <meta name="viewport" content="width=device-width, initial-scale=1">
+        <link href="/static/css/style.css" rel="stylesheet" type="text/css">
+    </head>
+    <body>
+        <div class="title">{{.name}}</div>
+        <ul class="feed">
+            {{range .posts}}
+                <li id="{{.Id}}" class="post">
+                    <div class="meta">{{.User}}<span class="right">{{.Date}}</span></div>
+                    {{if .Event}}<div class="event">{{.Event}}</div>{{end}}
+                    {{if .Body}}<div class="body">{{.Body}}</div>{{end}}
+                    {{range .Files}}
+                        <a class="file" href="{{.Href}}">
+                            {{if .Thumb}}<img src="{{.Thumb}}" alt="{{.Name}}">{{else}}{{.Name}}{{end}}
+                        </a>
+                    {{end}}
+                    {{if .Likes}}<div class="likes">{{.Likes}} like{{if ne .Likes 1}}s{{end}}</div>{{end}}
+                    {{range .Comments}}
+                        <div class="comment"><span class="user">{{.User}}</span> {{.Body}}</div>
+                    {{end}}
+                </li>
+            {{else}}
+                <li>No posts yet</li>
+            {{end}}
+        </ul>
+        {{if .next}}<div class="more"><a href="?offset={{.next}}&limit={{.limit}}">Older posts</a></div>{{end}}
+    </body>
+</html>
+`
@@ -0,0 +1,105 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	uio "github.com/ipfs/go-unixfs/io"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/ipfs"
+)
+
+var vars = struct {
+	initConfig core.InitConfig
+
+	once    sync.Once
+	gateway *Gateway
+	err     error
+}{
+	initConfig: core.InitConfig{
+		BaseRepoPath: "testdata/.textile2",
+		ApiAddr:      "127.0.0.1:0",
+		GrpcAddr:     "127.0.0.1:0",
+		GatewayAddr:  "127.0.0.1:0",
+	},
+}
+
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if vars.gateway != nil {
+		_ = vars.gateway.Stop()
+		_ = vars.gateway.Node.Stop()
+	}
+	_ = os.RemoveAll(vars.initConfig.BaseRepoPath)
+	os.Exit(code)
+}
+
+// testGateway returns a gateway w/ an online node shared by the package tests.
+// Its handler is used directly.
+func testGateway(t *testing.T) *Gateway {
+	vars.once.Do(func() {
+		node, err := core.CreateAndStartPeer(vars.initConfig, true)
+		if err != nil {
+			vars.err = err
+			return
+		}
+		// nothing else consumes account updates, which would block once the buffer fills
+		go func() {
+			for range node.UpdateCh() {
+			}
+		}()
+
+		vars.gateway = &Gateway{Node: node}
+		vars.gateway.Start(vars.initConfig.GatewayAddr)
+	})
+	if vars.err != nil {
+		t.Fatalf("start gateway failed: %s", vars.err)
+	}
+	return vars.gateway
+}
+
+// testRequest serves a request w/ the gateway's handler
+func testRequest(g *Gateway, req *http.Request) *httptest.ResponseRecorder {
+	res := httptest.NewRecorder()
+	g.server.Handler.ServeHTTP(res, req)
+	return res
+}
+
+// testDirectory adds a plaintext directory w/ a.txt and sub/b.txt, returning its cid
+func testDirectory(t *testing.T, g *Gateway) string {
+	node := g.Node.Ipfs()
+	ctx := context.Background()
+
+	inner := uio.NewDirectory(node.DAG)
+	if _, err := ipfs.AddDataToDirectory(node, inner, "b.txt", strings.NewReader("world")); err != nil {
+		t.Fatal(err)
+	}
+	innerNode, err := inner.GetNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.DAG.Add(ctx, innerNode); err != nil {
+		t.Fatal(err)
+	}
+
+	outer := uio.NewDirectory(node.DAG)
+	if _, err := ipfs.AddDataToDirectory(node, outer, "a.txt", strings.NewReader("hello")); err != nil {
+		t.Fatal(err)
+	}
+	if err := outer.AddChild(ctx, "sub", innerNode); err != nil {
+		t.Fatal(err)
+	}
+	outerNode, err := outer.GetNode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.DAG.Add(ctx, outerNode); err != nil {
+		t.Fatal(err)
+	}
+	return outerNode.Cid().String()
+}
@@ -0,0 +1,214 @@
+package gateway
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// threadFeedLimit is the default number of posts per thread page
+const threadFeedLimit = 10
+
+// maxThreadFeedLimit limits the limit param of a thread page
+const maxThreadFeedLimit = 50
+
+// thumbLink is the schema link used for file thumbnails
+const thumbLink = "small"
+
+// post represents a feed item for HTML rendering
+type post struct {
+	Id       string
+	User     string
+	Date     string
+	Event    string
+	Body     string
+	Files    []postFile
+	Comments []postComment
+	Likes    int
+}
+
+// postFile represents a file of a post for HTML rendering
+type postFile struct {
+	Name  string
+	Href  string
+	Thumb string
+}
+
+// postComment represents a comment on a post for HTML rendering
+type postComment struct {
+	User string
+	Body string
+}
+
+// threadHandler renders a read-only feed of a public thread
+func (g *Gateway) threadHandler(c *gin.Context) {
+	thrd := g.publicThread(c.Param("id"))
+	if thrd == nil {
+		g.render404(c)
+		return
+	}
+
+	limit := threadFeedLimit
+	if c.Query("limit") != "" {
+		var err error
+		limit, err = strconv.Atoi(c.Query("limit"))
+		if err != nil || limit < 1 {
+			c.String(http.StatusBadRequest, "invalid limit")
+			return
+		}
+		if limit > maxThreadFeedLimit {
+			limit = maxThreadFeedLimit
+		}
+	}
+
+	list, err := g.Node.Feed(&pb.FeedRequest{
+		Thread: thrd.Id,
+		Offset: c.Query("offset"),
+		Limit:  int32(limit),
+		Mode:   pb.FeedRequest_ANNOTATED,
+	})
+	if err != nil {
+		log.Debugf("error getting feed %s: %s", thrd.Id, err)
+		g.render404(c)
+		return
+	}
+
+	posts := make([]post, 0)
+	for _, item := range list.Items {
+		p, err := g.post(thrd.Id, item)
+		if err != nil {
+			log.Debugf("error rendering feed item %s: %s", item.Block, err)
+			continue
+		}
+		posts = append(posts, p)
+	}
+
+	name := thrd.Name
+	if name == "" {
+		name = thrd.Id
+	}
+	c.Header("Cache-Control", "no-cache")
+	c.HTML(http.StatusOK, "thread", gin.H{
+		"name":  name,
+		"posts": posts,
+		"next":  list.Next,
+		"limit": limit,
+	})
+}
+
+// publicThread returns a thread if it's PUBLIC or OPEN and published to the gateway
+func (g *Gateway) publicThread(id string) *pb.Thread {
+	var published bool
+	for _, tid := range g.Node.Config().Gateway.PublicThreads {
+		if tid == id {
+			published = true
+			break
+		}
+	}
+	if !published {
+		return nil
+	}
+
+	thrd, err := g.Node.ThreadView(id)
+	if err != nil {
+		return nil
+	}
+	if thrd.Type != pb.Thread_PUBLIC && thrd.Type != pb.Thread_OPEN {
+		return nil
+	}
+	return thrd
+}
+
+// post converts a feed item to a post
+func (g *Gateway) post(threadId string, item *pb.FeedItem) (post, error) {
+	var payload ptypes.DynamicAny
+	if err := ptypes.UnmarshalAny(item.Payload, &payload); err != nil {
+		return post{}, err
+	}
+
+	p := post{Id: item.Block}
+	switch msg := payload.Message.(type) {
+	case *pb.Text:
+		p.setHeader(msg.User, msg.Date)
+		p.Body = msg.Body
+		p.setAnnotations(msg.Comments, msg.Likes)
+	case *pb.Files:
+		p.setHeader(msg.User, msg.Date)
+		p.Body = msg.Caption
+		p.Files = postFiles(threadId, msg)
+		p.setAnnotations(msg.Comments, msg.Likes)
+	case *pb.Join:
+		p.setHeader(msg.User, msg.Date)
+		p.Event = "joined"
+	case *pb.Leave:
+		p.setHeader(msg.User, msg.Date)
+		p.Event = "left"
+	}
+	return p, nil
+}
+
+// setHeader sets the user and date of a post
+func (p *post) setHeader(user *pb.User, date *timestamp.Timestamp) {
+	p.User = userName(user)
+	if date != nil {
+		p.Date = util.ProtoTime(date).Format("Jan 2, 2006 15:04")
+	}
+}
+
+// setAnnotations sets the comments and like count of a post
+func (p *post) setAnnotations(comments []*pb.Comment, likes []*pb.Like) {
+	for _, c := range comments {
+		p.Comments = append(p.Comments, postComment{
+			User: userName(c.User),
+			Body: c.Body,
+		})
+	}
+	p.Likes = len(likes)
+}
+
+// postFiles returns the files of a files block, linking to the single file or the schema's
+// large link (the first link by name w/o one), w/ a thumbnail from its small link, if present
+func postFiles(threadId string, files *pb.Files) []postFile {
+	var list []postFile
+	for i, f := range files.Files {
+		pf := postFile{}
+		if f.File != nil {
+			pf.Name = f.File.Name
+			pf.Href = core.FileLinkPath(threadId, files.Block, i, "")
+		} else {
+			names := linkNames(f)
+			if len(names) == 0 {
+				continue
+			}
+
+			name := names[0]
+			if _, ok := f.Links["large"]; ok {
+				name = "large"
+			}
+			pf.Name = f.Links[name].Name
+			pf.Href = core.FileLinkPath(threadId, files.Block, i, name)
+			if _, ok := f.Links[thumbLink]; ok {
+				pf.Thumb = core.FileLinkPath(threadId, files.Block, i, thumbLink)
+			}
+		}
+		list = append(list, pf)
+	}
+	return list
+}
+
+// userName returns a user's name, falling back to their address
+func userName(user *pb.User) string {
+	if user == nil {
+		return ""
+	}
+	if user.Name != "" {
+		return user.Name
+	}
+	return user.Address
+}
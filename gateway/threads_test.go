@@ -0,0 +1,104 @@
+package gateway
+
+import (
+	"crypto/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	libp2pc "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/segmentio/ksuid"
+	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/mill"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/schema/textile"
+)
+
+func TestGateway_ThreadHandler(t *testing.T) {
+	g := testGateway(t)
+	schema, err := g.Node.AddSchema(textile.Blob, "blob")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	public := testGatewayThread(t, g, pb.Thread_PUBLIC, schema.Hash)
+	private := testGatewayThread(t, g, pb.Thread_PRIVATE, schema.Hash)
+	unpublished := testGatewayThread(t, g, pb.Thread_PUBLIC, schema.Hash)
+
+	if _, err := public.AddMessage("", "hello gateway"); err != nil {
+		t.Fatal(err)
+	}
+	file, err := g.Node.AddFileIndex(&mill.Blob{}, core.AddFileConfig{
+		Input: []byte("secret"),
+		Name:  "secret.txt",
+		Media: "text/plain",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if file.Key == "" {
+		t.Fatal("expected an encrypted file")
+	}
+	nd, keys, err := g.Node.AddNodeFromFiles([]*pb.FileIndex{file})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := public.AddFiles(nd, "", "a file", keys.Files); err != nil {
+		t.Fatal(err)
+	}
+
+	conf := &g.Node.Config().Gateway
+	published := conf.PublicThreads
+	defer func() {
+		conf.PublicThreads = published
+	}()
+	conf.PublicThreads = []string{public.Id, private.Id}
+
+	// only published PUBLIC or OPEN threads are rendered
+	for _, id := range []string{private.Id, unpublished.Id, "nope"} {
+		req := httptest.NewRequest(http.MethodGet, "/threads/"+id, nil)
+		if res := testRequest(g, req); res.Code != http.StatusNotFound {
+			t.Fatalf("expected status 404 for %s, got %d", id, res.Code)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/threads/"+public.Id, nil)
+	res := testRequest(g, req)
+	if res.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d: %s", res.Code, res.Body.String())
+	}
+	body := res.Body.String()
+	for _, expected := range []string{"hello gateway", "a file", "secret.txt", "/threads/" + public.Id + "/files/"} {
+		if !strings.Contains(body, expected) {
+			t.Fatalf("expected page to contain %s", expected)
+		}
+	}
+	for _, key := range keys.Files {
+		if strings.Contains(body, key) {
+			t.Fatal("page exposes a file key")
+		}
+	}
+	if strings.Contains(body, file.Key) || strings.Contains(body, file.Hash) {
+		t.Fatal("page exposes file content addresses")
+	}
+}
+
+// testGatewayThread adds a thread of type w/ schema to the gateway node
+func testGatewayThread(t *testing.T, g *Gateway, typ pb.Thread_Type, schemaId string) *core.Thread {
+	sk, _, err := libp2pc.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	thrd, err := g.Node.AddThread(pb.AddThreadConfig{
+		Key:     ksuid.New().String(),
+		Name:    "test",
+		Type:    typ,
+		Sharing: pb.Thread_NOT_SHARED,
+		Schema:  &pb.AddThreadConfig_Schema{Id: schemaId},
+	}, sk, g.Node.Account().Address(), true, false)
+	if err != nil {
+		t.Fatalf("add thread failed: %s", err)
+	}
+	return thrd
+}
@@ -0,0 +1,224 @@
+package ipfs
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	blocks "github.com/ipfs/go-block-format"
+	icid "github.com/ipfs/go-cid"
+	"github.com/ipfs/go-ipfs/core"
+	cbor "github.com/ipfs/go-ipld-cbor"
+	ipld "github.com/ipfs/go-ipld-format"
+	mh "github.com/multiformats/go-multihash"
+)
+
+// carVersion is the only CAR format version supported
+const carVersion = 1
+
+// maxCarSection limits the size of a section, i.e., a 2MiB block plus its cid
+const maxCarSection = 2<<20 + 1024
+
+// ErrInvalidCar indicates a CAR archive could not be parsed
+var ErrInvalidCar = fmt.Errorf("invalid car archive")
+
+// carHeader is the CBOR encoded CARv1 header
+type carHeader struct {
+	Roots   []icid.Cid
+	Version uint64
+}
+
+func init() {
+	cbor.RegisterCborType(carHeader{})
+}
+
+// CarWriter writes blocks to a CARv1 archive
+type CarWriter struct {
+	w    io.Writer
+	seen map[string]struct{}
+}
+
+// NewCarWriter writes the archive header with the given roots
+func NewCarWriter(w io.Writer, roots []icid.Cid) (*CarWriter, error) {
+	header, err := cbor.DumpObject(&carHeader{
+		Roots:   roots,
+		Version: carVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = writeCarSection(w, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CarWriter{
+		w:    w,
+		seen: make(map[string]struct{}),
+	}, nil
+}
+
+// Put writes a block, skipping blocks which have already been written
+func (cw *CarWriter) Put(blk blocks.Block) error {
+	key := blk.Cid().KeyString()
+	if _, ok := cw.seen[key]; ok {
+		return nil
+	}
+	cw.seen[key] = struct{}{}
+
+	return writeCarSection(cw.w, append(blk.Cid().Bytes(), blk.RawData()...))
+}
+
+// PutDag writes the local dag under id, returning the number of blocks
+// that were not available locally
+// Note: Only the local blockstore is consulted, missing blocks are not fetched
+func (cw *CarWriter) PutDag(node *core.IpfsNode, id icid.Cid) (int, error) {
+	if _, ok := cw.seen[id.KeyString()]; ok {
+		return 0, nil
+	}
+
+	has, err := node.Blockstore.Has(id)
+	if err != nil {
+		return 0, err
+	}
+	if !has {
+		return 1, nil
+	}
+	blk, err := node.Blockstore.Get(id)
+	if err != nil {
+		return 0, err
+	}
+	err = cw.Put(blk)
+	if err != nil {
+		return 0, err
+	}
+
+	nd, err := ipld.Decode(blk)
+	if err != nil {
+		return 0, err
+	}
+
+	var missing int
+	for _, link := range nd.Links() {
+		m, err := cw.PutDag(node, link.Cid)
+		if err != nil {
+			return missing, err
+		}
+		missing += m
+	}
+
+	return missing, nil
+}
+
+// CarReader reads blocks from a CARv1 archive
+type CarReader struct {
+	r     *bufio.Reader
+	Roots []icid.Cid
+}
+
+// NewCarReader reads the archive header
+func NewCarReader(r io.Reader) (*CarReader, error) {
+	cr := &CarReader{r: bufio.NewReader(r)}
+
+	data, err := cr.readSection()
+	if err != nil {
+		return nil, err
+	}
+	var header carHeader
+	err = cbor.DecodeInto(data, &header)
+	if err != nil {
+		return nil, err
+	}
+	if header.Version != carVersion {
+		return nil, fmt.Errorf("unsupported car version: %d", header.Version)
+	}
+	cr.Roots = header.Roots
+
+	return cr, nil
+}
+
+// Next returns the next block in the archive, or io.EOF when done
+func (cr *CarReader) Next() (blocks.Block, error) {
+	data, err := cr.readSection()
+	if err != nil {
+		return nil, err
+	}
+
+	n, id, err := readCid(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// ensure the data matches its cid
+	check, err := id.Prefix().Sum(data[n:])
+	if err != nil {
+		return nil, err
+	}
+	if !check.Equals(id) {
+		return nil, ErrInvalidCar
+	}
+
+	return blocks.NewBlockWithCid(data[n:], id)
+}
+
+// readSection reads a single varint length-prefixed section
+func (cr *CarReader) readSection() ([]byte, error) {
+	size, err := binary.ReadUvarint(cr.r)
+	if err != nil {
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		return nil, ErrInvalidCar
+	}
+	if size > maxCarSection {
+		return nil, ErrInvalidCar
+	}
+
+	data := make([]byte, size)
+	_, err = io.ReadFull(cr.r, data)
+	if err != nil {
+		return nil, ErrInvalidCar
+	}
+	return data, nil
+}
+
+// readCid reads the cid at the start of a section, returning its length
+func readCid(data []byte) (int, icid.Cid, error) {
+	// v0 cids are bare sha256 multihashes
+	if len(data) >= 34 && data[0] == mh.SHA2_256 && data[1] == 32 {
+		id, err := icid.Cast(data[:34])
+		return 34, id, err
+	}
+
+	// version, codec, multihash code, multihash length
+	var n int
+	var size uint64
+	for i := 0; i < 4; i++ {
+		v, c := binary.Uvarint(data[n:])
+		if c <= 0 {
+			return 0, icid.Cid{}, ErrInvalidCar
+		}
+		n += c
+		size = v
+	}
+	if size > uint64(len(data)-n) {
+		return 0, icid.Cid{}, ErrInvalidCar
+	}
+	n += int(size)
+
+	id, err := icid.Cast(data[:n])
+	return n, id, err
+}
+
+// writeCarSection writes a single varint length-prefixed section
+func writeCarSection(w io.Writer, data []byte) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	_, err := w.Write(buf[:n])
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
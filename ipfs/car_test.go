@@ -0,0 +1,68 @@
+package ipfs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"testing"
+
+	blocks "github.com/ipfs/go-block-format"
+	icid "github.com/ipfs/go-cid"
+)
+
+func TestCarReader_RoundTrip(t *testing.T) {
+	blk := blocks.NewBlock([]byte("hello"))
+
+	var buf bytes.Buffer
+	cw, err := NewCarWriter(&buf, []icid.Cid{blk.Cid()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cw.Put(blk); err != nil {
+		t.Fatal(err)
+	}
+
+	cr, err := NewCarReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cr.Roots) != 1 || !cr.Roots[0].Equals(blk.Cid()) {
+		t.Fatalf("unexpected roots: %v", cr.Roots)
+	}
+	got, err := cr.Next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Cid().Equals(blk.Cid()) || !bytes.Equal(got.RawData(), blk.RawData()) {
+		t.Fatal("block mismatch")
+	}
+	if _, err := cr.Next(); err != io.EOF {
+		t.Fatalf("expected EOF, got %v", err)
+	}
+}
+
+func TestCarReader_HugeSection(t *testing.T) {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, 1<<62)
+
+	if _, err := NewCarReader(bytes.NewReader(buf[:n])); err != ErrInvalidCar {
+		t.Fatalf("expected invalid car error, got %v", err)
+	}
+
+	n = binary.PutUvarint(buf, maxCarSection+1)
+	if _, err := NewCarReader(bytes.NewReader(buf[:n])); err != ErrInvalidCar {
+		t.Fatalf("expected invalid car error, got %v", err)
+	}
+}
+
+func TestCarReader_HugeCidLength(t *testing.T) {
+	// cid v1, raw codec, sha2-256, w/ a huge digest length
+	data := []byte{0x01, 0x55, 0x12}
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, 1<<62)
+	data = append(data, buf[:n]...)
+
+	if _, _, err := readCid(data); err != ErrInvalidCar {
+		t.Fatalf("expected invalid car error, got %v", err)
+	}
+}
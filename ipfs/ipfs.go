@@ -58,6 +58,40 @@ func DataAtPath(node *core.IpfsNode, pth string) ([]byte, error) {
 	return ioutil.ReadAll(file)
 }
 
+// FileAtPath returns a seekable reader of the file under an ipfs path and its resolved cid.
+// Unlike DataAtPath, only the blocks needed by reads are fetched. The caller must close the file.
+func FileAtPath(node *core.IpfsNode, pth string) (files.File, icid.Cid, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, icid.Undef, err
+	}
+
+	ctx, cancel := context.WithTimeout(node.Context(), CatTimeout)
+	defer cancel()
+
+	resolved, err := api.ResolvePath(ctx, path.New(pth))
+	if err != nil {
+		return nil, icid.Undef, err
+	}
+
+	// reads outlive the resolve timeout
+	f, err := api.Unixfs().Get(node.Context(), resolved)
+	if err != nil {
+		return nil, icid.Undef, err
+	}
+
+	switch f := f.(type) {
+	case files.File:
+		return f, resolved.Cid(), nil
+	case files.Directory:
+		_ = f.Close()
+		return nil, icid.Undef, iface.ErrIsDir
+	default:
+		_ = f.Close()
+		return nil, icid.Undef, iface.ErrNotSupported
+	}
+}
+
 // LinksAtPath return ipld links under a path
 func LinksAtPath(node *core.IpfsNode, pth string) ([]*ipld.Link, error) {
 	api, err := coreapi.NewCoreAPI(node)
@@ -85,6 +119,62 @@ func LinksAtPath(node *core.IpfsNode, pth string) ([]*ipld.Link, error) {
 	return links, nil
 }
 
+// EntriesAtPath returns the unixfs entries, w/ their types and sizes, of the directory
+// under an ipfs path
+func EntriesAtPath(node *core.IpfsNode, pth string) ([]iface.DirEntry, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(node.Context(), CatTimeout)
+	defer cancel()
+
+	res, err := api.Unixfs().Ls(ctx, path.New(pth), options.Unixfs.ResolveChildren(true))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]iface.DirEntry, 0)
+	for entry := range res {
+		if entry.Err != nil {
+			return nil, entry.Err
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// DirectoryAtPath returns the directory under an ipfs path. Like FileAtPath, only the
+// blocks needed by reads are fetched, which are bound by ctx. The caller must close the directory.
+func DirectoryAtPath(ctx context.Context, node *core.IpfsNode, pth string) (files.Directory, error) {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return nil, err
+	}
+
+	rctx, cancel := context.WithTimeout(ctx, CatTimeout)
+	defer cancel()
+
+	resolved, err := api.ResolvePath(rctx, path.New(pth))
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := api.Unixfs().Get(ctx, resolved)
+	if err != nil {
+		return nil, err
+	}
+
+	dir, ok := f.(files.Directory)
+	if !ok {
+		_ = f.Close()
+		return nil, iface.ErrNotSupported
+	}
+	return dir, nil
+}
+
 // AddDataToDirectory adds reader bytes to a virtual dir
 func AddDataToDirectory(node *core.IpfsNode, dir uio.Directory, fname string, reader io.Reader) (*icid.Cid, error) {
 	api, err := coreapi.NewCoreAPI(node)
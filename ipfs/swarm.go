@@ -8,6 +8,8 @@ import (
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/ipfs/go-ipfs/core/coreapi"
 	inet "github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
 )
 
 // SwarmConnect opens a direct connection to a list of peer multi addresses
@@ -160,3 +162,33 @@ func SwarmConnected(node *core.IpfsNode, peerId string) (bool, error) {
 
 	return false, nil
 }
+
+// SwarmConnectRelay connects to a peer through a circuit relay, useful for
+// reaching peers behind NATs which can't be dialed directly
+func SwarmConnectRelay(node *core.IpfsNode, relayId string, peerId string) error {
+	api, err := coreapi.NewCoreAPI(node)
+	if err != nil {
+		return err
+	}
+
+	rid, err := peer.IDB58Decode(relayId)
+	if err != nil {
+		return err
+	}
+	pid, err := peer.IDB58Decode(peerId)
+	if err != nil {
+		return err
+	}
+	addr, err := ma.NewMultiaddr("/p2p/" + rid.Pretty() + "/p2p-circuit")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(node.Context(), ConnectTimeout)
+	defer cancel()
+
+	return api.Swarm().Connect(ctx, peer.AddrInfo{
+		ID:    pid,
+		Addrs: []ma.Multiaddr{addr},
+	})
+}
@@ -0,0 +1,438 @@
+// Package metrics is a minimal registry that renders the Prometheus text format.
+// It implements go-metrics-interface so metrics created by ipfs are included.
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	imetrics "github.com/ipfs/go-metrics-interface"
+)
+
+// ContentType is the Prometheus text exposition format content type
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// DefaultBuckets are histogram buckets suited to request latencies in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// Default is the process-wide registry
+var Default = NewRegistry()
+
+// Labels are metric label pairs
+type Labels map[string]string
+
+// Type is a Prometheus metric type
+type Type string
+
+const (
+	CounterType   Type = "counter"
+	GaugeType     Type = "gauge"
+	HistogramType Type = "histogram"
+	SummaryType   Type = "summary"
+)
+
+// Sample is a single value reported by a collector at scrape time
+type Sample struct {
+	Name   string
+	Help   string
+	Type   Type
+	Labels Labels
+	Value  float64
+}
+
+// Collector returns samples computed at scrape time
+type Collector func() []Sample
+
+// series is a single metric w/ a fixed label set
+type series interface {
+	write(w io.Writer, name string, labels Labels)
+}
+
+// family is a group of series sharing a name
+type family struct {
+	help   string
+	typ    Type
+	series map[string]series
+	labels map[string]Labels
+}
+
+// Registry holds metric families
+type Registry struct {
+	families map[string]*family
+	lock     sync.Mutex
+}
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// Inject registers the default registry as the go-metrics-interface implementation.
+// This must happen before an ipfs node is constructed, and only once per process.
+func Inject() {
+	err := imetrics.InjectImpl(func(name string, help string) imetrics.Creator {
+		return &creator{reg: Default, name: Name(name), help: help}
+	})
+	if err != nil && err != imetrics.ErrImplemented {
+		panic(err)
+	}
+}
+
+// Counter returns the counter w/ name and labels, creating it if needed
+func (r *Registry) Counter(name string, help string, labels Labels) *Counter {
+	return r.get(name, help, CounterType, labels, func() series {
+		return &Counter{}
+	}).(*Counter)
+}
+
+// Gauge returns the gauge w/ name and labels, creating it if needed
+func (r *Registry) Gauge(name string, help string, labels Labels) *Gauge {
+	return r.get(name, help, GaugeType, labels, func() series {
+		return &Gauge{}
+	}).(*Gauge)
+}
+
+// Histogram returns the histogram w/ name and labels, creating it w/ buckets if needed
+func (r *Registry) Histogram(name string, help string, labels Labels, buckets []float64) *Histogram {
+	return r.get(name, help, HistogramType, labels, func() series {
+		return newHistogram(buckets)
+	}).(*Histogram)
+}
+
+// Summary returns the summary w/ name and labels, creating it if needed.
+// Only the sum and count are tracked.
+func (r *Registry) Summary(name string, help string, labels Labels) *Summary {
+	return r.get(name, help, SummaryType, labels, func() series {
+		return &Summary{}
+	}).(*Summary)
+}
+
+// ServeHTTP writes all metrics in the text format
+func (r *Registry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+	r.WriteTo(w)
+}
+
+// WriteTo writes all metrics in the text format, followed by samples from collectors
+func (r *Registry) WriteTo(w io.Writer, collectors ...Collector) {
+	r.lock.Lock()
+	var names []string
+	for name := range r.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var buf bytes.Buffer
+	for _, name := range names {
+		f := r.families[name]
+		writeHeader(&buf, name, f.help, f.typ)
+		var keys []string
+		for key := range f.series {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			f.series[key].write(&buf, name, f.labels[key])
+		}
+	}
+	r.lock.Unlock()
+
+	for _, collect := range collectors {
+		WriteSamples(&buf, collect())
+	}
+	_, _ = buf.WriteTo(w)
+}
+
+// WriteSamples writes samples in the text format, grouped by name
+func WriteSamples(w io.Writer, samples []Sample) {
+	written := make(map[string]struct{})
+	for i, s := range samples {
+		if _, ok := written[s.Name]; ok {
+			continue
+		}
+		written[s.Name] = struct{}{}
+		writeHeader(w, s.Name, s.Help, s.Type)
+		for _, o := range samples[i:] {
+			if o.Name == s.Name {
+				writeValue(w, o.Name, o.Labels, o.Value)
+			}
+		}
+	}
+}
+
+// Name converts a dot separated go-metrics-interface name to a valid metric name
+func Name(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == ':':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// get returns an existing series or adds a new one
+func (r *Registry) get(name string, help string, typ Type, labels Labels, create func() series) series {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	f, ok := r.families[name]
+	if !ok {
+		f = &family{
+			help:   help,
+			typ:    typ,
+			series: make(map[string]series),
+			labels: make(map[string]Labels),
+		}
+		r.families[name] = f
+	}
+	if f.typ != typ {
+		panic(fmt.Sprintf("metric %s registered as %s, not %s", name, f.typ, typ))
+	}
+	key := formatLabels(labels)
+	s, ok := f.series[key]
+	if !ok {
+		s = create()
+		f.series[key] = s
+		f.labels[key] = labels
+	}
+	return s
+}
+
+// Counter only increases
+type Counter struct {
+	value float64
+	lock  sync.Mutex
+}
+
+// Inc adds one
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add adds a positive value
+func (c *Counter) Add(v float64) {
+	if v < 0 {
+		return
+	}
+	c.lock.Lock()
+	c.value += v
+	c.lock.Unlock()
+}
+
+// Value returns the current count
+func (c *Counter) Value() float64 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.value
+}
+
+func (c *Counter) write(w io.Writer, name string, labels Labels) {
+	writeValue(w, name, labels, c.Value())
+}
+
+// Gauge increases and decreases
+type Gauge struct {
+	value float64
+	lock  sync.Mutex
+}
+
+// Set sets the value
+func (g *Gauge) Set(v float64) {
+	g.lock.Lock()
+	g.value = v
+	g.lock.Unlock()
+}
+
+// Inc adds one
+func (g *Gauge) Inc() {
+	g.Add(1)
+}
+
+// Dec subtracts one
+func (g *Gauge) Dec() {
+	g.Add(-1)
+}
+
+// Add adds a value
+func (g *Gauge) Add(v float64) {
+	g.lock.Lock()
+	g.value += v
+	g.lock.Unlock()
+}
+
+// Sub subtracts a value
+func (g *Gauge) Sub(v float64) {
+	g.Add(-v)
+}
+
+// Value returns the current value
+func (g *Gauge) Value() float64 {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	return g.value
+}
+
+func (g *Gauge) write(w io.Writer, name string, labels Labels) {
+	writeValue(w, name, labels, g.Value())
+}
+
+// Histogram counts observations in cumulative buckets
+type Histogram struct {
+	buckets []float64
+	counts  []uint64
+	count   uint64
+	sum     float64
+	lock    sync.Mutex
+}
+
+func newHistogram(buckets []float64) *Histogram {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	sorted := append([]float64{}, buckets...)
+	sort.Float64s(sorted)
+	return &Histogram{
+		buckets: sorted,
+		counts:  make([]uint64, len(sorted)),
+	}
+}
+
+// Observe adds an observation
+func (h *Histogram) Observe(v float64) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for i, b := range h.buckets {
+		if v <= b {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+// Count returns the number of observations
+func (h *Histogram) Count() uint64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.count
+}
+
+func (h *Histogram) write(w io.Writer, name string, labels Labels) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for i, b := range h.buckets {
+		writeValue(w, name+"_bucket", withLabel(labels, "le", formatFloat(b)), float64(h.counts[i]))
+	}
+	writeValue(w, name+"_bucket", withLabel(labels, "le", "+Inf"), float64(h.count))
+	writeValue(w, name+"_sum", labels, h.sum)
+	writeValue(w, name+"_count", labels, float64(h.count))
+}
+
+// Summary tracks the sum and count of observations
+type Summary struct {
+	count uint64
+	sum   float64
+	lock  sync.Mutex
+}
+
+// Observe adds an observation
+func (s *Summary) Observe(v float64) {
+	s.lock.Lock()
+	s.count++
+	s.sum += v
+	s.lock.Unlock()
+}
+
+func (s *Summary) write(w io.Writer, name string, labels Labels) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	writeValue(w, name+"_sum", labels, s.sum)
+	writeValue(w, name+"_count", labels, float64(s.count))
+}
+
+// creator implements go-metrics-interface's Creator
+type creator struct {
+	reg  *Registry
+	name string
+	help string
+}
+
+func (c *creator) Counter() imetrics.Counter {
+	return c.reg.Counter(c.name, c.help, nil)
+}
+
+func (c *creator) Gauge() imetrics.Gauge {
+	return c.reg.Gauge(c.name, c.help, nil)
+}
+
+func (c *creator) Histogram(buckets []float64) imetrics.Histogram {
+	return c.reg.Histogram(c.name, c.help, nil, buckets)
+}
+
+func (c *creator) Summary(_ imetrics.SummaryOpts) imetrics.Summary {
+	return c.reg.Summary(c.name, c.help, nil)
+}
+
+func writeHeader(w io.Writer, name string, help string, typ Type) {
+	if help != "" {
+		_, _ = fmt.Fprintf(w, "# HELP %s %s\n", name, escape(help, false))
+	}
+	_, _ = fmt.Fprintf(w, "# TYPE %s %s\n", name, typ)
+}
+
+func writeValue(w io.Writer, name string, labels Labels, value float64) {
+	_, _ = fmt.Fprintf(w, "%s%s %s\n", name, formatLabels(labels), formatFloat(value))
+}
+
+// formatLabels returns labels sorted by name in the text format
+func formatLabels(labels Labels) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	var names []string
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var pairs []string
+	for _, name := range names {
+		pairs = append(pairs, name+`="`+escape(labels[name], true)+`"`)
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func withLabel(labels Labels, name string, value string) Labels {
+	out := Labels{name: value}
+	for k, v := range labels {
+		out[k] = v
+	}
+	return out
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+func escape(str string, quotes bool) string {
+	str = strings.Replace(str, `\`, `\\`, -1)
+	str = strings.Replace(str, "\n", `\n`, -1)
+	if quotes {
+		str = strings.Replace(str, `"`, `\"`, -1)
+	}
+	return str
+}
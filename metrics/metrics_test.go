@@ -0,0 +1,57 @@
+package metrics_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/textileio/go-textile/metrics"
+)
+
+func TestRegistry_WriteTo(t *testing.T) {
+	reg := NewRegistry()
+	reg.Counter("requests_total", "Requests handled", Labels{"type": "PING"}).Inc()
+	reg.Counter("requests_total", "Requests handled", Labels{"type": "PING"}).Add(2)
+	reg.Gauge("depth", "Queue depth", nil).Set(4)
+	hist := reg.Histogram("latency_seconds", "", Labels{"type": "PING"}, []float64{0.1, 1})
+	hist.Observe(0.05)
+	hist.Observe(0.5)
+	hist.Observe(5)
+
+	var buf bytes.Buffer
+	reg.WriteTo(&buf, func() []Sample {
+		return []Sample{
+			{Name: "inbox", Help: "Inbox depth", Type: GaugeType, Labels: Labels{"client": `a"b`}, Value: 1},
+			{Name: "inbox", Type: GaugeType, Labels: Labels{"client": "c"}, Value: 2},
+		}
+	})
+	out := buf.String()
+
+	expected := []string{
+		"# HELP requests_total Requests handled\n# TYPE requests_total counter\n",
+		`requests_total{type="PING"} 3`,
+		"# TYPE depth gauge\ndepth 4\n",
+		`latency_seconds_bucket{le="0.1",type="PING"} 1`,
+		`latency_seconds_bucket{le="1",type="PING"} 2`,
+		`latency_seconds_bucket{le="+Inf",type="PING"} 3`,
+		`latency_seconds_sum{type="PING"} 5.55`,
+		`latency_seconds_count{type="PING"} 3`,
+		"# HELP inbox Inbox depth\n# TYPE inbox gauge\n",
+		`inbox{client="a\"b"} 1`,
+		`inbox{client="c"} 2`,
+	}
+	for _, e := range expected {
+		if !strings.Contains(out, e) {
+			t.Fatalf("expected output to contain %s, got:\n%s", e, out)
+		}
+	}
+	if strings.Count(out, "# TYPE inbox") != 1 {
+		t.Fatal("samples should be grouped under one header")
+	}
+}
+
+func TestName(t *testing.T) {
+	if Name("ipfs.bitswap.recv-all.blocks") != "ipfs_bitswap_recv_all_blocks" {
+		t.Fatal("bad name conversion")
+	}
+}
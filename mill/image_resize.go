@@ -25,6 +25,16 @@ const (
 	GIF  Format = "gif"
 )
 
+// Fit modes used when an image is resized to both a width and height
+const (
+	// FitContain scales an image to fit within the box
+	FitContain = "contain"
+	// FitCover scales and crops an image to cover the box
+	FitCover = "cover"
+	// FitFill stretches an image to the box
+	FitFill = "fill"
+)
+
 type ImageSize struct {
 	Width  int
 	Height int
@@ -32,6 +42,9 @@ type ImageSize struct {
 
 type ImageResizeOpts struct {
 	Width   string `json:"width"`
+	Height  string `json:"height,omitempty"`
+	Fit     string `json:"fit,omitempty"`
+	Format  string `json:"format,omitempty"`
 	Quality string `json:"quality"`
 }
 
@@ -75,16 +88,42 @@ func (m *ImageResize) Mill(input []byte, name string) (*Result, error) {
 		return nil, err
 	}
 
-	width, err := strconv.Atoi(m.Opts.Width)
-	if err != nil {
-		return nil, fmt.Errorf("invalid width: " + m.Opts.Width)
+	var width, height int
+	if m.Opts.Width != "" || m.Opts.Height == "" {
+		width, err = strconv.Atoi(m.Opts.Width)
+		if err != nil || width < 1 {
+			return nil, fmt.Errorf("invalid width: " + m.Opts.Width)
+		}
+	}
+	if m.Opts.Height != "" {
+		height, err = strconv.Atoi(m.Opts.Height)
+		if err != nil || height < 1 {
+			return nil, fmt.Errorf("invalid height: " + m.Opts.Height)
+		}
 	}
 	quality, err := strconv.Atoi(m.Opts.Quality)
 	if err != nil {
 		return nil, fmt.Errorf("invalid quality: " + m.Opts.Quality)
 	}
 
-	buff, rect, err := encodeImage(clean, format, width, quality)
+	fit := m.Opts.Fit
+	switch fit {
+	case "":
+		fit = FitContain
+	case FitContain, FitCover, FitFill:
+	default:
+		return nil, fmt.Errorf("invalid fit: " + m.Opts.Fit)
+	}
+
+	out := format
+	if m.Opts.Format != "" {
+		out = Format(m.Opts.Format)
+		if out != JPEG && out != PNG && (out != GIF || format != GIF) {
+			return nil, fmt.Errorf("invalid format: " + m.Opts.Format)
+		}
+	}
+
+	buff, rect, err := encodeImage(clean, format, out, width, height, fit, quality)
 	if err != nil {
 		return nil, err
 	}
@@ -115,26 +154,23 @@ func removeExif(reader io.Reader, img image.Image, format Format) (io.Reader, er
 	return encodeSingleImage(img, format)
 }
 
-// encodeImage creates a jpeg|gif from reader (quality applies to jpeg only)
-// NOTE: format is the reader image format, destination format is chosen accordingly.
-func encodeImage(reader io.Reader, format Format, width int, quality int) (*bytes.Buffer, *image.Rectangle, error) {
+// encodeImage creates a jpeg|png|gif from reader (quality applies to jpeg only)
+// NOTE: format is the reader image format, out is the destination format. Animated gifs
+// are only kept when both are gif, otherwise the first frame is used.
+func encodeImage(reader io.Reader, format Format, out Format, width int, height int, fit string, quality int) (*bytes.Buffer, *image.Rectangle, error) {
 	buff := new(bytes.Buffer)
 	var size image.Rectangle
 
-	if format != GIF {
+	if format != GIF || out != GIF {
 		// encode to png or jpeg
 		img, _, err := image.Decode(reader)
 		if err != nil {
 			return nil, nil, err
 		}
 
-		if img.Bounds().Size().X < width {
-			width = img.Bounds().Size().X
-		}
-
-		resized := imaging.Resize(img, width, 0, imaging.Lanczos)
+		resized := resizeImage(img, width, height, fit)
 
-		if format == PNG {
+		if out == PNG {
 			if err = png.Encode(buff, resized); err != nil {
 				return nil, nil, err
 			}
@@ -156,15 +192,12 @@ func encodeImage(reader io.Reader, format Format, width int, quality int) (*byte
 		}
 
 		firstFrame := img.Image[0].Bounds()
-		if firstFrame.Dx() < width {
-			width = firstFrame.Dx()
-		}
 		rect := image.Rect(0, 0, firstFrame.Dx(), firstFrame.Dy())
 		rgba := image.NewRGBA(rect)
 		for index, frame := range img.Image {
 			bounds := frame.Bounds()
 			draw.Draw(rgba, bounds, frame, bounds.Min, draw.Over)
-			img.Image[index] = imageToPaletted(imaging.Resize(rgba, width, 0, imaging.Lanczos))
+			img.Image[index] = imageToPaletted(resizeImage(rgba, width, height, fit))
 		}
 
 		img.Config.Width = img.Image[0].Bounds().Dx()
@@ -180,6 +213,29 @@ func encodeImage(reader io.Reader, format Format, width int, quality int) (*byte
 	return buff, &size, nil
 }
 
+// resizeImage scales an image to width and/or height w/o enlarging it. When both are given,
+// fit chooses how the image is scaled to the box.
+func resizeImage(img image.Image, width int, height int, fit string) *image.NRGBA {
+	size := img.Bounds().Size()
+	if size.X < width {
+		width = size.X
+	}
+	if size.Y < height {
+		height = size.Y
+	}
+
+	switch {
+	case width == 0 || height == 0:
+		return imaging.Resize(img, width, height, imaging.Lanczos)
+	case fit == FitCover:
+		return imaging.Fill(img, width, height, imaging.Center, imaging.Lanczos)
+	case fit == FitFill:
+		return imaging.Resize(img, width, height, imaging.Lanczos)
+	default:
+		return imaging.Fit(img, width, height, imaging.Lanczos)
+	}
+}
+
 // correctOrientation returns a copy of an image (jpg|png|gif) with exif removed
 func correctOrientation(img image.Image, exf *exif.Exif) (image.Image, error) {
 	if exf == nil {
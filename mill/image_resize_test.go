@@ -3,6 +3,7 @@ package mill
 import (
 	"bytes"
 	"fmt"
+	"image"
 	"io"
 	"io/ioutil"
 	"os"
@@ -50,3 +51,57 @@ func TestImageResize_Mill(t *testing.T) {
 		}
 	}
 }
+
+func TestImageResize_MillBox(t *testing.T) {
+	tests := []struct {
+		opts   ImageResizeOpts
+		width  int
+		height int
+		format string
+	}{
+		{ImageResizeOpts{Height: "100", Quality: "80"}, 0, 100, ""},
+		{ImageResizeOpts{Width: "100", Height: "50", Quality: "80"}, 0, 50, ""},
+		{ImageResizeOpts{Width: "100", Height: "100", Fit: FitCover, Quality: "80"}, 100, 100, ""},
+		{ImageResizeOpts{Width: "100", Height: "50", Fit: FitFill, Format: "png", Quality: "80"}, 100, 50, "png"},
+	}
+
+	for _, i := range testdata.Images {
+		input, err := ioutil.ReadFile(i.Path)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, test := range tests {
+			m := &ImageResize{Opts: test.opts}
+			res, err := m.Mill(input, "test")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.width != 0 && res.Meta["width"] != test.width {
+				t.Errorf("wrong width: %v", res.Meta["width"])
+			}
+			if res.Meta["height"] != test.height {
+				t.Errorf("wrong height: %v", res.Meta["height"])
+			}
+			if test.format != "" {
+				_, format, err := image.DecodeConfig(bytes.NewReader(res.File))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if format != test.format {
+					t.Errorf("wrong format: %s", format)
+				}
+			}
+		}
+	}
+
+	input, err := ioutil.ReadFile(testdata.Images[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := &ImageResize{Opts: ImageResizeOpts{Width: "100", Fit: "zoom", Quality: "80"}}
+	if _, err := m.Mill(input, "test"); err == nil || err.Error() != "invalid fit: zoom" {
+		t.Errorf("expected invalid fit error, got %v", err)
+	}
+}
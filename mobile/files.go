@@ -121,7 +121,7 @@ func (m *Mobile) Files(threadId string, offset string, limit int) ([]byte, error
 		return nil, core.ErrStopped
 	}
 
-	files, err := m.node.Files(offset, limit, threadId)
+	files, err := m.node.Files(offset, limit, threadId, pb.Block_DATE)
 	if err != nil {
 		return nil, err
 	}
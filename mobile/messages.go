@@ -3,6 +3,7 @@ package mobile
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/textileio/go-textile/core"
+	"github.com/textileio/go-textile/pb"
 )
 
 // AddMessage adds a message to a thread
@@ -32,7 +33,7 @@ func (m *Mobile) Messages(offset string, limit int, threadId string) ([]byte, er
 		return nil, core.ErrStopped
 	}
 
-	msgs, err := m.node.Messages(offset, limit, threadId)
+	msgs, err := m.node.Messages(offset, limit, threadId, pb.Block_DATE)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,1863 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: api_service.proto
+
+package pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Ack struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Ack) Reset()         { *m = Ack{} }
+func (m *Ack) String() string { return proto.CompactTextString(m) }
+func (*Ack) ProtoMessage()    {}
+func (*Ack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{0}
+}
+
+func (m *Ack) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Ack.Unmarshal(m, b)
+}
+func (m *Ack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Ack.Marshal(b, m, deterministic)
+}
+func (m *Ack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Ack.Merge(m, src)
+}
+func (m *Ack) XXX_Size() int {
+	return xxx_messageInfo_Ack.Size(m)
+}
+func (m *Ack) XXX_DiscardUnknown() {
+	xxx_messageInfo_Ack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Ack proto.InternalMessageInfo
+
+type ThreadsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadsRequest) Reset()         { *m = ThreadsRequest{} }
+func (m *ThreadsRequest) String() string { return proto.CompactTextString(m) }
+func (*ThreadsRequest) ProtoMessage()    {}
+func (*ThreadsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{1}
+}
+
+func (m *ThreadsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadsRequest.Unmarshal(m, b)
+}
+func (m *ThreadsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadsRequest.Marshal(b, m, deterministic)
+}
+func (m *ThreadsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadsRequest.Merge(m, src)
+}
+func (m *ThreadsRequest) XXX_Size() int {
+	return xxx_messageInfo_ThreadsRequest.Size(m)
+}
+func (m *ThreadsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadsRequest proto.InternalMessageInfo
+
+type ThreadRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadRequest) Reset()         { *m = ThreadRequest{} }
+func (m *ThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*ThreadRequest) ProtoMessage()    {}
+func (*ThreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{2}
+}
+
+func (m *ThreadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadRequest.Unmarshal(m, b)
+}
+func (m *ThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadRequest.Marshal(b, m, deterministic)
+}
+func (m *ThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadRequest.Merge(m, src)
+}
+func (m *ThreadRequest) XXX_Size() int {
+	return xxx_messageInfo_ThreadRequest.Size(m)
+}
+func (m *ThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadRequest proto.InternalMessageInfo
+
+func (m *ThreadRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type BlocksRequest struct {
+	Thread               string          `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Offset               string          `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort                 Block_BlockSort `protobuf:"varint,4,opt,name=sort,proto3,enum=Block_BlockSort" json:"sort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *BlocksRequest) Reset()         { *m = BlocksRequest{} }
+func (m *BlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*BlocksRequest) ProtoMessage()    {}
+func (*BlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{3}
+}
+
+func (m *BlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlocksRequest.Unmarshal(m, b)
+}
+func (m *BlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *BlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlocksRequest.Merge(m, src)
+}
+func (m *BlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_BlocksRequest.Size(m)
+}
+func (m *BlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlocksRequest proto.InternalMessageInfo
+
+func (m *BlocksRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *BlocksRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *BlocksRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *BlocksRequest) GetSort() Block_BlockSort {
+	if m != nil {
+		return m.Sort
+	}
+	return Block_DATE
+}
+
+type BlockRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BlockRequest) Reset()         { *m = BlockRequest{} }
+func (m *BlockRequest) String() string { return proto.CompactTextString(m) }
+func (*BlockRequest) ProtoMessage()    {}
+func (*BlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{4}
+}
+
+func (m *BlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockRequest.Unmarshal(m, b)
+}
+func (m *BlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockRequest.Marshal(b, m, deterministic)
+}
+func (m *BlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockRequest.Merge(m, src)
+}
+func (m *BlockRequest) XXX_Size() int {
+	return xxx_messageInfo_BlockRequest.Size(m)
+}
+func (m *BlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockRequest proto.InternalMessageInfo
+
+func (m *BlockRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type AddMessageRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Body                 string   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddMessageRequest) Reset()         { *m = AddMessageRequest{} }
+func (m *AddMessageRequest) String() string { return proto.CompactTextString(m) }
+func (*AddMessageRequest) ProtoMessage()    {}
+func (*AddMessageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{5}
+}
+
+func (m *AddMessageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddMessageRequest.Unmarshal(m, b)
+}
+func (m *AddMessageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddMessageRequest.Marshal(b, m, deterministic)
+}
+func (m *AddMessageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMessageRequest.Merge(m, src)
+}
+func (m *AddMessageRequest) XXX_Size() int {
+	return xxx_messageInfo_AddMessageRequest.Size(m)
+}
+func (m *AddMessageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMessageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMessageRequest proto.InternalMessageInfo
+
+func (m *AddMessageRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *AddMessageRequest) GetBody() string {
+	if m != nil {
+		return m.Body
+	}
+	return ""
+}
+
+type FilesRequest struct {
+	Thread               string          `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Offset               string          `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32           `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Sort                 Block_BlockSort `protobuf:"varint,4,opt,name=sort,proto3,enum=Block_BlockSort" json:"sort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *FilesRequest) Reset()         { *m = FilesRequest{} }
+func (m *FilesRequest) String() string { return proto.CompactTextString(m) }
+func (*FilesRequest) ProtoMessage()    {}
+func (*FilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{6}
+}
+
+func (m *FilesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FilesRequest.Unmarshal(m, b)
+}
+func (m *FilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FilesRequest.Marshal(b, m, deterministic)
+}
+func (m *FilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FilesRequest.Merge(m, src)
+}
+func (m *FilesRequest) XXX_Size() int {
+	return xxx_messageInfo_FilesRequest.Size(m)
+}
+func (m *FilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FilesRequest proto.InternalMessageInfo
+
+func (m *FilesRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *FilesRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *FilesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *FilesRequest) GetSort() Block_BlockSort {
+	if m != nil {
+		return m.Sort
+	}
+	return Block_DATE
+}
+
+type ObserveRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Types                []string `protobuf:"bytes,2,rep,name=types,proto3" json:"types,omitempty"`
+	Since                string   `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObserveRequest) Reset()         { *m = ObserveRequest{} }
+func (m *ObserveRequest) String() string { return proto.CompactTextString(m) }
+func (*ObserveRequest) ProtoMessage()    {}
+func (*ObserveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{7}
+}
+
+func (m *ObserveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObserveRequest.Unmarshal(m, b)
+}
+func (m *ObserveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObserveRequest.Marshal(b, m, deterministic)
+}
+func (m *ObserveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserveRequest.Merge(m, src)
+}
+func (m *ObserveRequest) XXX_Size() int {
+	return xxx_messageInfo_ObserveRequest.Size(m)
+}
+func (m *ObserveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserveRequest proto.InternalMessageInfo
+
+func (m *ObserveRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ObserveRequest) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+func (m *ObserveRequest) GetSince() string {
+	if m != nil {
+		return m.Since
+	}
+	return ""
+}
+
+type CafesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafesRequest) Reset()         { *m = CafesRequest{} }
+func (m *CafesRequest) String() string { return proto.CompactTextString(m) }
+func (*CafesRequest) ProtoMessage()    {}
+func (*CafesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{8}
+}
+
+func (m *CafesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafesRequest.Unmarshal(m, b)
+}
+func (m *CafesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafesRequest.Marshal(b, m, deterministic)
+}
+func (m *CafesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafesRequest.Merge(m, src)
+}
+func (m *CafesRequest) XXX_Size() int {
+	return xxx_messageInfo_CafesRequest.Size(m)
+}
+func (m *CafesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafesRequest proto.InternalMessageInfo
+
+type RegisterCafeRequest struct {
+	Cafe                 string   `protobuf:"bytes,1,opt,name=cafe,proto3" json:"cafe,omitempty"`
+	Token                string   `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterCafeRequest) Reset()         { *m = RegisterCafeRequest{} }
+func (m *RegisterCafeRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterCafeRequest) ProtoMessage()    {}
+func (*RegisterCafeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{9}
+}
+
+func (m *RegisterCafeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterCafeRequest.Unmarshal(m, b)
+}
+func (m *RegisterCafeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterCafeRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterCafeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterCafeRequest.Merge(m, src)
+}
+func (m *RegisterCafeRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterCafeRequest.Size(m)
+}
+func (m *RegisterCafeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterCafeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterCafeRequest proto.InternalMessageInfo
+
+func (m *RegisterCafeRequest) GetCafe() string {
+	if m != nil {
+		return m.Cafe
+	}
+	return ""
+}
+
+func (m *RegisterCafeRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type DeregisterCafeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeregisterCafeRequest) Reset()         { *m = DeregisterCafeRequest{} }
+func (m *DeregisterCafeRequest) String() string { return proto.CompactTextString(m) }
+func (*DeregisterCafeRequest) ProtoMessage()    {}
+func (*DeregisterCafeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{10}
+}
+
+func (m *DeregisterCafeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeregisterCafeRequest.Unmarshal(m, b)
+}
+func (m *DeregisterCafeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeregisterCafeRequest.Marshal(b, m, deterministic)
+}
+func (m *DeregisterCafeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeregisterCafeRequest.Merge(m, src)
+}
+func (m *DeregisterCafeRequest) XXX_Size() int {
+	return xxx_messageInfo_DeregisterCafeRequest.Size(m)
+}
+func (m *DeregisterCafeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeregisterCafeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeregisterCafeRequest proto.InternalMessageInfo
+
+func (m *DeregisterCafeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ContactsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactsRequest) Reset()         { *m = ContactsRequest{} }
+func (m *ContactsRequest) String() string { return proto.CompactTextString(m) }
+func (*ContactsRequest) ProtoMessage()    {}
+func (*ContactsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{11}
+}
+
+func (m *ContactsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactsRequest.Unmarshal(m, b)
+}
+func (m *ContactsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactsRequest.Marshal(b, m, deterministic)
+}
+func (m *ContactsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactsRequest.Merge(m, src)
+}
+func (m *ContactsRequest) XXX_Size() int {
+	return xxx_messageInfo_ContactsRequest.Size(m)
+}
+func (m *ContactsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactsRequest proto.InternalMessageInfo
+
+type ContactRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactRequest) Reset()         { *m = ContactRequest{} }
+func (m *ContactRequest) String() string { return proto.CompactTextString(m) }
+func (*ContactRequest) ProtoMessage()    {}
+func (*ContactRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{12}
+}
+
+func (m *ContactRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContactRequest.Unmarshal(m, b)
+}
+func (m *ContactRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContactRequest.Marshal(b, m, deterministic)
+}
+func (m *ContactRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequest.Merge(m, src)
+}
+func (m *ContactRequest) XXX_Size() int {
+	return xxx_messageInfo_ContactRequest.Size(m)
+}
+func (m *ContactRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactRequest proto.InternalMessageInfo
+
+func (m *ContactRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type InvitesRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InvitesRequest) Reset()         { *m = InvitesRequest{} }
+func (m *InvitesRequest) String() string { return proto.CompactTextString(m) }
+func (*InvitesRequest) ProtoMessage()    {}
+func (*InvitesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{13}
+}
+
+func (m *InvitesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvitesRequest.Unmarshal(m, b)
+}
+func (m *InvitesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvitesRequest.Marshal(b, m, deterministic)
+}
+func (m *InvitesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvitesRequest.Merge(m, src)
+}
+func (m *InvitesRequest) XXX_Size() int {
+	return xxx_messageInfo_InvitesRequest.Size(m)
+}
+func (m *InvitesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvitesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvitesRequest proto.InternalMessageInfo
+
+type CreateInviteRequest struct {
+	Thread               string   `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateInviteRequest) Reset()         { *m = CreateInviteRequest{} }
+func (m *CreateInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateInviteRequest) ProtoMessage()    {}
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{14}
+}
+
+func (m *CreateInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateInviteRequest.Unmarshal(m, b)
+}
+func (m *CreateInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateInviteRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateInviteRequest.Merge(m, src)
+}
+func (m *CreateInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateInviteRequest.Size(m)
+}
+func (m *CreateInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateInviteRequest proto.InternalMessageInfo
+
+func (m *CreateInviteRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *CreateInviteRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type InviteRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteRequest) Reset()         { *m = InviteRequest{} }
+func (m *InviteRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteRequest) ProtoMessage()    {}
+func (*InviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{15}
+}
+
+func (m *InviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteRequest.Unmarshal(m, b)
+}
+func (m *InviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteRequest.Marshal(b, m, deterministic)
+}
+func (m *InviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteRequest.Merge(m, src)
+}
+func (m *InviteRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteRequest.Size(m)
+}
+func (m *InviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteRequest proto.InternalMessageInfo
+
+func (m *InviteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type AcceptInviteRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AcceptInviteRequest) Reset()         { *m = AcceptInviteRequest{} }
+func (m *AcceptInviteRequest) String() string { return proto.CompactTextString(m) }
+func (*AcceptInviteRequest) ProtoMessage()    {}
+func (*AcceptInviteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{16}
+}
+
+func (m *AcceptInviteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AcceptInviteRequest.Unmarshal(m, b)
+}
+func (m *AcceptInviteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AcceptInviteRequest.Marshal(b, m, deterministic)
+}
+func (m *AcceptInviteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AcceptInviteRequest.Merge(m, src)
+}
+func (m *AcceptInviteRequest) XXX_Size() int {
+	return xxx_messageInfo_AcceptInviteRequest.Size(m)
+}
+func (m *AcceptInviteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AcceptInviteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AcceptInviteRequest proto.InternalMessageInfo
+
+func (m *AcceptInviteRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AcceptInviteRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type NotificationsRequest struct {
+	Offset               string   `protobuf:"bytes,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationsRequest) Reset()         { *m = NotificationsRequest{} }
+func (m *NotificationsRequest) String() string { return proto.CompactTextString(m) }
+func (*NotificationsRequest) ProtoMessage()    {}
+func (*NotificationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{17}
+}
+
+func (m *NotificationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationsRequest.Unmarshal(m, b)
+}
+func (m *NotificationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationsRequest.Marshal(b, m, deterministic)
+}
+func (m *NotificationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationsRequest.Merge(m, src)
+}
+func (m *NotificationsRequest) XXX_Size() int {
+	return xxx_messageInfo_NotificationsRequest.Size(m)
+}
+func (m *NotificationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationsRequest proto.InternalMessageInfo
+
+func (m *NotificationsRequest) GetOffset() string {
+	if m != nil {
+		return m.Offset
+	}
+	return ""
+}
+
+func (m *NotificationsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type NotificationRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NotificationRequest) Reset()         { *m = NotificationRequest{} }
+func (m *NotificationRequest) String() string { return proto.CompactTextString(m) }
+func (*NotificationRequest) ProtoMessage()    {}
+func (*NotificationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dac1f622be3e5824, []int{18}
+}
+
+func (m *NotificationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NotificationRequest.Unmarshal(m, b)
+}
+func (m *NotificationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NotificationRequest.Marshal(b, m, deterministic)
+}
+func (m *NotificationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationRequest.Merge(m, src)
+}
+func (m *NotificationRequest) XXX_Size() int {
+	return xxx_messageInfo_NotificationRequest.Size(m)
+}
+func (m *NotificationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationRequest proto.InternalMessageInfo
+
+func (m *NotificationRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Ack)(nil), "Ack")
+	proto.RegisterType((*ThreadsRequest)(nil), "ThreadsRequest")
+	proto.RegisterType((*ThreadRequest)(nil), "ThreadRequest")
+	proto.RegisterType((*BlocksRequest)(nil), "BlocksRequest")
+	proto.RegisterType((*BlockRequest)(nil), "BlockRequest")
+	proto.RegisterType((*AddMessageRequest)(nil), "AddMessageRequest")
+	proto.RegisterType((*FilesRequest)(nil), "FilesRequest")
+	proto.RegisterType((*ObserveRequest)(nil), "ObserveRequest")
+	proto.RegisterType((*CafesRequest)(nil), "CafesRequest")
+	proto.RegisterType((*RegisterCafeRequest)(nil), "RegisterCafeRequest")
+	proto.RegisterType((*DeregisterCafeRequest)(nil), "DeregisterCafeRequest")
+	proto.RegisterType((*ContactsRequest)(nil), "ContactsRequest")
+	proto.RegisterType((*ContactRequest)(nil), "ContactRequest")
+	proto.RegisterType((*InvitesRequest)(nil), "InvitesRequest")
+	proto.RegisterType((*CreateInviteRequest)(nil), "CreateInviteRequest")
+	proto.RegisterType((*InviteRequest)(nil), "InviteRequest")
+	proto.RegisterType((*AcceptInviteRequest)(nil), "AcceptInviteRequest")
+	proto.RegisterType((*NotificationsRequest)(nil), "NotificationsRequest")
+	proto.RegisterType((*NotificationRequest)(nil), "NotificationRequest")
+}
+
+func init() { proto.RegisterFile("api_service.proto", fileDescriptor_dac1f622be3e5824) }
+
+var fileDescriptor_dac1f622be3e5824 = []byte{
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xdf, 0x8f, 0xda, 0x46,
+	0x10, 0x16, 0xbf, 0xee, 0x8e, 0x39, 0xdb, 0xc0, 0x40, 0x22, 0x64, 0x55, 0x0d, 0x75, 0x69, 0x0e,
+	0xb5, 0xd1, 0xb6, 0xba, 0xaa, 0xea, 0x53, 0x15, 0x11, 0xd2, 0xa0, 0x93, 0xfa, 0x43, 0x72, 0x4e,
+	0x7d, 0xe8, 0x4b, 0x65, 0xec, 0x81, 0xae, 0x00, 0x2f, 0xb5, 0xb7, 0xe4, 0xd2, 0xfe, 0x15, 0xfd,
+	0x8f, 0x2b, 0xef, 0xae, 0xc1, 0xa6, 0xa6, 0xf7, 0x98, 0x17, 0xd8, 0xef, 0xf3, 0xec, 0x7c, 0xb3,
+	0xde, 0xf9, 0xc6, 0xd0, 0x0b, 0x76, 0xfc, 0xb7, 0x94, 0x92, 0x3d, 0x0f, 0x89, 0xed, 0x12, 0x21,
+	0x85, 0x7b, 0xbd, 0x15, 0x11, 0x6d, 0x0c, 0x80, 0x3d, 0xa7, 0x77, 0x7a, 0xed, 0xb5, 0xa0, 0x31,
+	0x0d, 0xd7, 0x5e, 0x17, 0x9c, 0xfb, 0xdf, 0x13, 0x0a, 0xa2, 0xd4, 0xa7, 0x3f, 0xfe, 0xa4, 0x54,
+	0x7a, 0xcf, 0xc0, 0xd6, 0x8c, 0x21, 0xd0, 0x81, 0x3a, 0x8f, 0x86, 0xb5, 0x51, 0x6d, 0xd2, 0xf6,
+	0xeb, 0x3c, 0xf2, 0xfe, 0x06, 0xfb, 0xd5, 0x46, 0x84, 0xeb, 0x7c, 0x07, 0x3e, 0x85, 0x0b, 0xa9,
+	0x76, 0x98, 0x20, 0x83, 0x32, 0x5e, 0x2c, 0x97, 0x29, 0xc9, 0x61, 0x5d, 0xf3, 0x1a, 0xe1, 0x00,
+	0x5a, 0x1b, 0xbe, 0xe5, 0x72, 0xd8, 0x18, 0xd5, 0x26, 0x2d, 0x5f, 0x03, 0x1c, 0x43, 0x33, 0x15,
+	0x89, 0x1c, 0x36, 0x47, 0xb5, 0x89, 0x73, 0xdb, 0x65, 0x4a, 0x43, 0xff, 0xbe, 0x15, 0x89, 0xf4,
+	0xd5, 0x53, 0xef, 0x63, 0xb0, 0x14, 0x75, 0xae, 0xb8, 0x97, 0xd0, 0x9b, 0x46, 0xd1, 0x8f, 0x94,
+	0xa6, 0xc1, 0x8a, 0x1e, 0x2b, 0x10, 0xa1, 0xb9, 0x10, 0xd1, 0x7b, 0x53, 0x9e, 0x5a, 0x7b, 0x7f,
+	0x81, 0xf5, 0x86, 0x6f, 0xe8, 0x83, 0x1c, 0xee, 0x1e, 0x9c, 0x9f, 0x17, 0xd9, 0xfd, 0x3d, 0x5a,
+	0xf9, 0x00, 0x5a, 0xf2, 0xfd, 0x8e, 0xd2, 0x61, 0x7d, 0xd4, 0x98, 0xb4, 0x7d, 0x0d, 0x32, 0x36,
+	0xe5, 0x71, 0x48, 0x4a, 0xbb, 0xed, 0x6b, 0xe0, 0x39, 0x60, 0xcd, 0x82, 0xe5, 0xe1, 0x44, 0xde,
+	0x4b, 0xe8, 0xfb, 0xb4, 0xe2, 0xa9, 0xa4, 0x24, 0xe3, 0x73, 0x29, 0x84, 0x66, 0x18, 0x2c, 0xc9,
+	0x08, 0xa9, 0xb5, 0x92, 0x11, 0x6b, 0x8a, 0xcd, 0x19, 0x35, 0xf0, 0x6e, 0xe0, 0xc9, 0x6b, 0x4a,
+	0x2a, 0x52, 0x9c, 0x5e, 0x46, 0x0f, 0x3a, 0x33, 0x11, 0xcb, 0x20, 0x94, 0x07, 0xf1, 0xcf, 0xc1,
+	0x31, 0x54, 0xbe, 0x69, 0x08, 0x97, 0x41, 0x14, 0x25, 0x94, 0xa6, 0x66, 0x67, 0x0e, 0xb3, 0xde,
+	0xbc, 0x8b, 0xf7, 0x5c, 0x1e, 0x4b, 0x9f, 0x43, 0x7f, 0x96, 0x50, 0x20, 0x49, 0xf3, 0x8f, 0xbd,
+	0xa5, 0x42, 0xea, 0x7a, 0x39, 0xf5, 0x33, 0xb0, 0xcb, 0x29, 0x4e, 0x4b, 0xff, 0x16, 0xfa, 0xd3,
+	0x30, 0xa4, 0x9d, 0xfc, 0xdf, 0x30, 0xec, 0x42, 0x63, 0x4d, 0x79, 0x03, 0x65, 0x4b, 0xef, 0x35,
+	0x0c, 0x7e, 0x12, 0x92, 0x2f, 0x79, 0x18, 0x48, 0x2e, 0xe2, 0x62, 0x1f, 0x99, 0x7e, 0xa9, 0x55,
+	0xf7, 0x4b, 0xbd, 0xd0, 0x2f, 0xde, 0x67, 0xd0, 0x2f, 0x66, 0x39, 0x23, 0x7f, 0xfb, 0xcf, 0x15,
+	0x34, 0xa6, 0x3b, 0x8e, 0x5f, 0xc0, 0xf5, 0x0f, 0x3c, 0x95, 0xc6, 0xc9, 0xd8, 0x61, 0x65, 0x4f,
+	0xbb, 0xd7, 0x86, 0xc8, 0x82, 0x70, 0x0c, 0xed, 0x39, 0x99, 0x58, 0x74, 0x58, 0xc9, 0xec, 0xee,
+	0xa5, 0xc1, 0xf8, 0x1c, 0xda, 0xd3, 0x28, 0x32, 0xa0, 0xcb, 0x0e, 0xeb, 0x99, 0x88, 0x97, 0x7c,
+	0x75, 0x8c, 0x1b, 0x83, 0xe5, 0xd3, 0x56, 0xec, 0xe9, 0x4c, 0xc2, 0x26, 0x9b, 0x86, 0x6b, 0x9c,
+	0x00, 0x64, 0xda, 0x7a, 0x6e, 0xa0, 0xc3, 0x4a, 0x03, 0xc4, 0x05, 0x8d, 0x55, 0x75, 0x9f, 0xc0,
+	0xd5, 0x9c, 0x74, 0x20, 0xda, 0xac, 0xe8, 0x75, 0xf7, 0x42, 0x43, 0xbc, 0x01, 0x38, 0x7a, 0x1c,
+	0x91, 0xfd, 0xc7, 0xf0, 0x6e, 0x8b, 0xdd, 0xd3, 0x83, 0xcc, 0xce, 0x90, 0xe5, 0x54, 0x7e, 0x46,
+	0x9b, 0x15, 0x7d, 0xed, 0x82, 0x86, 0x05, 0xcd, 0x3c, 0xec, 0x44, 0x53, 0xd3, 0x9f, 0x42, 0xf3,
+	0x0d, 0x51, 0x84, 0x16, 0xcb, 0xfe, 0xf2, 0xa7, 0xb6, 0x42, 0x77, 0x92, 0xb6, 0x2a, 0xcf, 0x0d,
+	0x5c, 0x1a, 0xff, 0x62, 0x87, 0x95, 0x9d, 0xec, 0xb6, 0x0f, 0xa1, 0x5f, 0xd5, 0xf0, 0x85, 0x2e,
+	0x4c, 0xd9, 0x12, 0x6d, 0x56, 0xb4, 0xa7, 0xdb, 0x55, 0xf0, 0x2d, 0xa5, 0x29, 0x17, 0xb1, 0x4a,
+	0x7b, 0x9b, 0xbd, 0xe2, 0xa3, 0xdb, 0x70, 0xc0, 0x2a, 0xfc, 0xeb, 0x5a, 0xc5, 0x7d, 0xc8, 0xc0,
+	0x29, 0x7b, 0x14, 0x9f, 0xb2, 0x4a, 0xd3, 0x9a, 0x0b, 0x62, 0x60, 0xa9, 0x8a, 0x8c, 0x5d, 0xb1,
+	0xcb, 0x4e, 0x9c, 0xeb, 0x5a, 0x39, 0x63, 0x8e, 0x0a, 0x73, 0xca, 0xc3, 0xb1, 0xc3, 0xca, 0xa6,
+	0x76, 0xaf, 0x72, 0x02, 0x3f, 0x52, 0x97, 0x95, 0xa3, 0x03, 0x6f, 0x64, 0x9f, 0x83, 0xad, 0xbb,
+	0xe7, 0x6c, 0x26, 0x1d, 0xf7, 0xa5, 0x6e, 0x70, 0x33, 0x0e, 0xb0, 0xc3, 0xca, 0x83, 0xc1, 0xcd,
+	0x89, 0x5f, 0x38, 0xbd, 0x53, 0xf5, 0x7d, 0x03, 0x56, 0x71, 0x52, 0xe0, 0x80, 0x55, 0x0c, 0x0e,
+	0xb7, 0xc3, 0xbe, 0x7f, 0x90, 0x94, 0xc4, 0xc1, 0xc6, 0x84, 0xbd, 0x00, 0xab, 0x68, 0x7b, 0x1c,
+	0xb0, 0x8a, 0x29, 0x70, 0x68, 0xc4, 0x31, 0x58, 0x77, 0xab, 0x58, 0x24, 0xb9, 0x88, 0xc3, 0xca,
+	0x71, 0xba, 0xf6, 0xef, 0xa0, 0x97, 0x95, 0x54, 0x9a, 0x0a, 0xf8, 0x84, 0x55, 0x4d, 0x09, 0xb7,
+	0x57, 0xa2, 0xd5, 0x49, 0x18, 0x74, 0x7d, 0x0a, 0xa2, 0x22, 0x8f, 0x03, 0x56, 0x31, 0x1d, 0xb4,
+	0xdc, 0xab, 0x3e, 0xd8, 0x5c, 0x30, 0x49, 0x0f, 0x92, 0x6f, 0x88, 0xed, 0x16, 0xbf, 0xd6, 0x77,
+	0x8b, 0xc5, 0x85, 0xfa, 0xe8, 0x7f, 0xfd, 0xef, 0x00, 0x44, 0xa0, 0xf1, 0xdb, 0x22, 0x08, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ApiClient is the client API for Api service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApiClient interface {
+	// threads
+	ListThreads(ctx context.Context, in *ThreadsRequest, opts ...grpc.CallOption) (*ThreadList, error)
+	GetThread(ctx context.Context, in *ThreadRequest, opts ...grpc.CallOption) (*Thread, error)
+	AddThread(ctx context.Context, in *AddThreadConfig, opts ...grpc.CallOption) (*Thread, error)
+	RemoveThread(ctx context.Context, in *ThreadRequest, opts ...grpc.CallOption) (*Ack, error)
+	// blocks
+	ListBlocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (*BlockList, error)
+	GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error)
+	// messages
+	AddMessage(ctx context.Context, in *AddMessageRequest, opts ...grpc.CallOption) (*Text, error)
+	// files
+	ListFiles(ctx context.Context, in *FilesRequest, opts ...grpc.CallOption) (*FilesList, error)
+	GetFiles(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Files, error)
+	// feed
+	Feed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*FeedItemList, error)
+	Observe(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (Api_ObserveClient, error)
+	// cafes
+	ListCafes(ctx context.Context, in *CafesRequest, opts ...grpc.CallOption) (*CafeSessionList, error)
+	RegisterCafe(ctx context.Context, in *RegisterCafeRequest, opts ...grpc.CallOption) (*CafeSession, error)
+	DeregisterCafe(ctx context.Context, in *DeregisterCafeRequest, opts ...grpc.CallOption) (*Ack, error)
+	// contacts
+	ListContacts(ctx context.Context, in *ContactsRequest, opts ...grpc.CallOption) (*ContactList, error)
+	GetContact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error)
+	AddContact(ctx context.Context, in *Contact, opts ...grpc.CallOption) (*Ack, error)
+	RemoveContact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Ack, error)
+	// invites
+	ListInvites(ctx context.Context, in *InvitesRequest, opts ...grpc.CallOption) (*InviteViewList, error)
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*ExternalInvite, error)
+	AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*Block, error)
+	IgnoreInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*Ack, error)
+	// notifications
+	ListNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error)
+	ReadNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*Ack, error)
+}
+
+type apiClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewApiClient(cc *grpc.ClientConn) ApiClient {
+	return &apiClient{cc}
+}
+
+func (c *apiClient) ListThreads(ctx context.Context, in *ThreadsRequest, opts ...grpc.CallOption) (*ThreadList, error) {
+	out := new(ThreadList)
+	err := c.cc.Invoke(ctx, "/Api/ListThreads", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetThread(ctx context.Context, in *ThreadRequest, opts ...grpc.CallOption) (*Thread, error) {
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, "/Api/GetThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddThread(ctx context.Context, in *AddThreadConfig, opts ...grpc.CallOption) (*Thread, error) {
+	out := new(Thread)
+	err := c.cc.Invoke(ctx, "/Api/AddThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveThread(ctx context.Context, in *ThreadRequest, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/Api/RemoveThread", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListBlocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (*BlockList, error) {
+	out := new(BlockList)
+	err := c.cc.Invoke(ctx, "/Api/ListBlocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetBlock(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/Api/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddMessage(ctx context.Context, in *AddMessageRequest, opts ...grpc.CallOption) (*Text, error) {
+	out := new(Text)
+	err := c.cc.Invoke(ctx, "/Api/AddMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListFiles(ctx context.Context, in *FilesRequest, opts ...grpc.CallOption) (*FilesList, error) {
+	out := new(FilesList)
+	err := c.cc.Invoke(ctx, "/Api/ListFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetFiles(ctx context.Context, in *BlockRequest, opts ...grpc.CallOption) (*Files, error) {
+	out := new(Files)
+	err := c.cc.Invoke(ctx, "/Api/GetFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Feed(ctx context.Context, in *FeedRequest, opts ...grpc.CallOption) (*FeedItemList, error) {
+	out := new(FeedItemList)
+	err := c.cc.Invoke(ctx, "/Api/Feed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) Observe(ctx context.Context, in *ObserveRequest, opts ...grpc.CallOption) (Api_ObserveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Api_serviceDesc.Streams[0], "/Api/Observe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiObserveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Api_ObserveClient interface {
+	Recv() (*FeedItem, error)
+	grpc.ClientStream
+}
+
+type apiObserveClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiObserveClient) Recv() (*FeedItem, error) {
+	m := new(FeedItem)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiClient) ListCafes(ctx context.Context, in *CafesRequest, opts ...grpc.CallOption) (*CafeSessionList, error) {
+	out := new(CafeSessionList)
+	err := c.cc.Invoke(ctx, "/Api/ListCafes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RegisterCafe(ctx context.Context, in *RegisterCafeRequest, opts ...grpc.CallOption) (*CafeSession, error) {
+	out := new(CafeSession)
+	err := c.cc.Invoke(ctx, "/Api/RegisterCafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) DeregisterCafe(ctx context.Context, in *DeregisterCafeRequest, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/Api/DeregisterCafe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListContacts(ctx context.Context, in *ContactsRequest, opts ...grpc.CallOption) (*ContactList, error) {
+	out := new(ContactList)
+	err := c.cc.Invoke(ctx, "/Api/ListContacts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) GetContact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Contact, error) {
+	out := new(Contact)
+	err := c.cc.Invoke(ctx, "/Api/GetContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AddContact(ctx context.Context, in *Contact, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/Api/AddContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) RemoveContact(ctx context.Context, in *ContactRequest, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/Api/RemoveContact", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListInvites(ctx context.Context, in *InvitesRequest, opts ...grpc.CallOption) (*InviteViewList, error) {
+	out := new(InviteViewList)
+	err := c.cc.Invoke(ctx, "/Api/ListInvites", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*ExternalInvite, error) {
+	out := new(ExternalInvite)
+	err := c.cc.Invoke(ctx, "/Api/CreateInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) AcceptInvite(ctx context.Context, in *AcceptInviteRequest, opts ...grpc.CallOption) (*Block, error) {
+	out := new(Block)
+	err := c.cc.Invoke(ctx, "/Api/AcceptInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) IgnoreInvite(ctx context.Context, in *InviteRequest, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/Api/IgnoreInvite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ListNotifications(ctx context.Context, in *NotificationsRequest, opts ...grpc.CallOption) (*NotificationList, error) {
+	out := new(NotificationList)
+	err := c.cc.Invoke(ctx, "/Api/ListNotifications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) ReadNotification(ctx context.Context, in *NotificationRequest, opts ...grpc.CallOption) (*Ack, error) {
+	out := new(Ack)
+	err := c.cc.Invoke(ctx, "/Api/ReadNotification", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiServer is the server API for Api service.
+type ApiServer interface {
+	// threads
+	ListThreads(context.Context, *ThreadsRequest) (*ThreadList, error)
+	GetThread(context.Context, *ThreadRequest) (*Thread, error)
+	AddThread(context.Context, *AddThreadConfig) (*Thread, error)
+	RemoveThread(context.Context, *ThreadRequest) (*Ack, error)
+	// blocks
+	ListBlocks(context.Context, *BlocksRequest) (*BlockList, error)
+	GetBlock(context.Context, *BlockRequest) (*Block, error)
+	// messages
+	AddMessage(context.Context, *AddMessageRequest) (*Text, error)
+	// files
+	ListFiles(context.Context, *FilesRequest) (*FilesList, error)
+	GetFiles(context.Context, *BlockRequest) (*Files, error)
+	// feed
+	Feed(context.Context, *FeedRequest) (*FeedItemList, error)
+	Observe(*ObserveRequest, Api_ObserveServer) error
+	// cafes
+	ListCafes(context.Context, *CafesRequest) (*CafeSessionList, error)
+	RegisterCafe(context.Context, *RegisterCafeRequest) (*CafeSession, error)
+	DeregisterCafe(context.Context, *DeregisterCafeRequest) (*Ack, error)
+	// contacts
+	ListContacts(context.Context, *ContactsRequest) (*ContactList, error)
+	GetContact(context.Context, *ContactRequest) (*Contact, error)
+	AddContact(context.Context, *Contact) (*Ack, error)
+	RemoveContact(context.Context, *ContactRequest) (*Ack, error)
+	// invites
+	ListInvites(context.Context, *InvitesRequest) (*InviteViewList, error)
+	CreateInvite(context.Context, *CreateInviteRequest) (*ExternalInvite, error)
+	AcceptInvite(context.Context, *AcceptInviteRequest) (*Block, error)
+	IgnoreInvite(context.Context, *InviteRequest) (*Ack, error)
+	// notifications
+	ListNotifications(context.Context, *NotificationsRequest) (*NotificationList, error)
+	ReadNotification(context.Context, *NotificationRequest) (*Ack, error)
+}
+
+// UnimplementedApiServer can be embedded to have forward compatible implementations.
+type UnimplementedApiServer struct {
+}
+
+func (*UnimplementedApiServer) ListThreads(ctx context.Context, req *ThreadsRequest) (*ThreadList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListThreads not implemented")
+}
+func (*UnimplementedApiServer) GetThread(ctx context.Context, req *ThreadRequest) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThread not implemented")
+}
+func (*UnimplementedApiServer) AddThread(ctx context.Context, req *AddThreadConfig) (*Thread, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddThread not implemented")
+}
+func (*UnimplementedApiServer) RemoveThread(ctx context.Context, req *ThreadRequest) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveThread not implemented")
+}
+func (*UnimplementedApiServer) ListBlocks(ctx context.Context, req *BlocksRequest) (*BlockList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlocks not implemented")
+}
+func (*UnimplementedApiServer) GetBlock(ctx context.Context, req *BlockRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedApiServer) AddMessage(ctx context.Context, req *AddMessageRequest) (*Text, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMessage not implemented")
+}
+func (*UnimplementedApiServer) ListFiles(ctx context.Context, req *FilesRequest) (*FilesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFiles not implemented")
+}
+func (*UnimplementedApiServer) GetFiles(ctx context.Context, req *BlockRequest) (*Files, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFiles not implemented")
+}
+func (*UnimplementedApiServer) Feed(ctx context.Context, req *FeedRequest) (*FeedItemList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Feed not implemented")
+}
+func (*UnimplementedApiServer) Observe(req *ObserveRequest, srv Api_ObserveServer) error {
+	return status.Errorf(codes.Unimplemented, "method Observe not implemented")
+}
+func (*UnimplementedApiServer) ListCafes(ctx context.Context, req *CafesRequest) (*CafeSessionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCafes not implemented")
+}
+func (*UnimplementedApiServer) RegisterCafe(ctx context.Context, req *RegisterCafeRequest) (*CafeSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCafe not implemented")
+}
+func (*UnimplementedApiServer) DeregisterCafe(ctx context.Context, req *DeregisterCafeRequest) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterCafe not implemented")
+}
+func (*UnimplementedApiServer) ListContacts(ctx context.Context, req *ContactsRequest) (*ContactList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListContacts not implemented")
+}
+func (*UnimplementedApiServer) GetContact(ctx context.Context, req *ContactRequest) (*Contact, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetContact not implemented")
+}
+func (*UnimplementedApiServer) AddContact(ctx context.Context, req *Contact) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddContact not implemented")
+}
+func (*UnimplementedApiServer) RemoveContact(ctx context.Context, req *ContactRequest) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveContact not implemented")
+}
+func (*UnimplementedApiServer) ListInvites(ctx context.Context, req *InvitesRequest) (*InviteViewList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvites not implemented")
+}
+func (*UnimplementedApiServer) CreateInvite(ctx context.Context, req *CreateInviteRequest) (*ExternalInvite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (*UnimplementedApiServer) AcceptInvite(ctx context.Context, req *AcceptInviteRequest) (*Block, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptInvite not implemented")
+}
+func (*UnimplementedApiServer) IgnoreInvite(ctx context.Context, req *InviteRequest) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IgnoreInvite not implemented")
+}
+func (*UnimplementedApiServer) ListNotifications(ctx context.Context, req *NotificationsRequest) (*NotificationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotifications not implemented")
+}
+func (*UnimplementedApiServer) ReadNotification(ctx context.Context, req *NotificationRequest) (*Ack, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadNotification not implemented")
+}
+
+func RegisterApiServer(s *grpc.Server, srv ApiServer) {
+	s.RegisterService(&_Api_serviceDesc, srv)
+}
+
+func _Api_ListThreads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThreadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListThreads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListThreads",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListThreads(ctx, req.(*ThreadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_GetThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).GetThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/GetThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).GetThread(ctx, req.(*ThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddThreadConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddThread(ctx, req.(*AddThreadConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveThread_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThreadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveThread(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveThread",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveThread(ctx, req.(*ThreadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListBlocks(ctx, req.(*BlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).GetBlock(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddMessage(ctx, req.(*AddMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListFiles(ctx, req.(*FilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_GetFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).GetFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/GetFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).GetFiles(ctx, req.(*BlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Feed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).Feed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/Feed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).Feed(ctx, req.(*FeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_Observe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ObserveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiServer).Observe(m, &apiObserveServer{stream})
+}
+
+type Api_ObserveServer interface {
+	Send(*FeedItem) error
+	grpc.ServerStream
+}
+
+type apiObserveServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiObserveServer) Send(m *FeedItem) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Api_ListCafes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CafesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListCafes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListCafes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListCafes(ctx, req.(*CafesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RegisterCafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterCafeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RegisterCafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RegisterCafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RegisterCafe(ctx, req.(*RegisterCafeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_DeregisterCafe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterCafeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).DeregisterCafe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/DeregisterCafe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).DeregisterCafe(ctx, req.(*DeregisterCafeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListContacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListContacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListContacts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListContacts(ctx, req.(*ContactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_GetContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).GetContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/GetContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).GetContact(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AddContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Contact)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AddContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AddContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AddContact(ctx, req.(*Contact))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_RemoveContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).RemoveContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/RemoveContact",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).RemoveContact(ctx, req.(*ContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListInvites",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListInvites(ctx, req.(*InvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/CreateInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_AcceptInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcceptInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).AcceptInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/AcceptInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).AcceptInvite(ctx, req.(*AcceptInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_IgnoreInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).IgnoreInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/IgnoreInvite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).IgnoreInvite(ctx, req.(*InviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ListNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ListNotifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ListNotifications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ListNotifications(ctx, req.(*NotificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_ReadNotification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).ReadNotification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Api/ReadNotification",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).ReadNotification(ctx, req.(*NotificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Api_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "Api",
+	HandlerType: (*ApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListThreads",
+			Handler:    _Api_ListThreads_Handler,
+		},
+		{
+			MethodName: "GetThread",
+			Handler:    _Api_GetThread_Handler,
+		},
+		{
+			MethodName: "AddThread",
+			Handler:    _Api_AddThread_Handler,
+		},
+		{
+			MethodName: "RemoveThread",
+			Handler:    _Api_RemoveThread_Handler,
+		},
+		{
+			MethodName: "ListBlocks",
+			Handler:    _Api_ListBlocks_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _Api_GetBlock_Handler,
+		},
+		{
+			MethodName: "AddMessage",
+			Handler:    _Api_AddMessage_Handler,
+		},
+		{
+			MethodName: "ListFiles",
+			Handler:    _Api_ListFiles_Handler,
+		},
+		{
+			MethodName: "GetFiles",
+			Handler:    _Api_GetFiles_Handler,
+		},
+		{
+			MethodName: "Feed",
+			Handler:    _Api_Feed_Handler,
+		},
+		{
+			MethodName: "ListCafes",
+			Handler:    _Api_ListCafes_Handler,
+		},
+		{
+			MethodName: "RegisterCafe",
+			Handler:    _Api_RegisterCafe_Handler,
+		},
+		{
+			MethodName: "DeregisterCafe",
+			Handler:    _Api_DeregisterCafe_Handler,
+		},
+		{
+			MethodName: "ListContacts",
+			Handler:    _Api_ListContacts_Handler,
+		},
+		{
+			MethodName: "GetContact",
+			Handler:    _Api_GetContact_Handler,
+		},
+		{
+			MethodName: "AddContact",
+			Handler:    _Api_AddContact_Handler,
+		},
+		{
+			MethodName: "RemoveContact",
+			Handler:    _Api_RemoveContact_Handler,
+		},
+		{
+			MethodName: "ListInvites",
+			Handler:    _Api_ListInvites_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _Api_CreateInvite_Handler,
+		},
+		{
+			MethodName: "AcceptInvite",
+			Handler:    _Api_AcceptInvite_Handler,
+		},
+		{
+			MethodName: "IgnoreInvite",
+			Handler:    _Api_IgnoreInvite_Handler,
+		},
+		{
+			MethodName: "ListNotifications",
+			Handler:    _Api_ListNotifications_Handler,
+		},
+		{
+			MethodName: "ReadNotification",
+			Handler:    _Api_ReadNotification_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Observe",
+			Handler:       _Api_Observe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api_service.proto",
+}
@@ -20,6 +20,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type CafeReplicate_Type int32
+
+const (
+	CafeReplicate_STORE          CafeReplicate_Type = 0
+	CafeReplicate_UNSTORE        CafeReplicate_Type = 1
+	CafeReplicate_STORE_THREAD   CafeReplicate_Type = 2
+	CafeReplicate_UNSTORE_THREAD CafeReplicate_Type = 3
+	CafeReplicate_MESSAGE        CafeReplicate_Type = 4
+)
+
+var CafeReplicate_Type_name = map[int32]string{
+	0: "STORE",
+	1: "UNSTORE",
+	2: "STORE_THREAD",
+	3: "UNSTORE_THREAD",
+	4: "MESSAGE",
+}
+
+var CafeReplicate_Type_value = map[string]int32{
+	"STORE":          0,
+	"UNSTORE":        1,
+	"STORE_THREAD":   2,
+	"UNSTORE_THREAD": 3,
+	"MESSAGE":        4,
+}
+
+func (x CafeReplicate_Type) String() string {
+	return proto.EnumName(CafeReplicate_Type_name, int32(x))
+}
+
+func (CafeReplicate_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{25, 0}
+}
+
 type CafeChallenge struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -889,6 +923,100 @@ func (m *CafeDeliverMessage) GetEnv() []byte {
 	return nil
 }
 
+type CafeWebhook struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Secret               string   `protobuf:"bytes,3,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeWebhook) Reset()         { *m = CafeWebhook{} }
+func (m *CafeWebhook) String() string { return proto.CompactTextString(m) }
+func (*CafeWebhook) ProtoMessage()    {}
+func (*CafeWebhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{19}
+}
+
+func (m *CafeWebhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeWebhook.Unmarshal(m, b)
+}
+func (m *CafeWebhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeWebhook.Marshal(b, m, deterministic)
+}
+func (m *CafeWebhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeWebhook.Merge(m, src)
+}
+func (m *CafeWebhook) XXX_Size() int {
+	return xxx_messageInfo_CafeWebhook.Size(m)
+}
+func (m *CafeWebhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeWebhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeWebhook proto.InternalMessageInfo
+
+func (m *CafeWebhook) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *CafeWebhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *CafeWebhook) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type CafeWebhookAck struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeWebhookAck) Reset()         { *m = CafeWebhookAck{} }
+func (m *CafeWebhookAck) String() string { return proto.CompactTextString(m) }
+func (*CafeWebhookAck) ProtoMessage()    {}
+func (*CafeWebhookAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{20}
+}
+
+func (m *CafeWebhookAck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeWebhookAck.Unmarshal(m, b)
+}
+func (m *CafeWebhookAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeWebhookAck.Marshal(b, m, deterministic)
+}
+func (m *CafeWebhookAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeWebhookAck.Merge(m, src)
+}
+func (m *CafeWebhookAck) XXX_Size() int {
+	return xxx_messageInfo_CafeWebhookAck.Size(m)
+}
+func (m *CafeWebhookAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeWebhookAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeWebhookAck proto.InternalMessageInfo
+
+func (m *CafeWebhookAck) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
 type CafeCheckMessages struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -900,7 +1028,7 @@ func (m *CafeCheckMessages) Reset()         { *m = CafeCheckMessages{} }
 func (m *CafeCheckMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeCheckMessages) ProtoMessage()    {}
 func (*CafeCheckMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af259e22dc6e576e, []int{19}
+	return fileDescriptor_af259e22dc6e576e, []int{21}
 }
 
 func (m *CafeCheckMessages) XXX_Unmarshal(b []byte) error {
@@ -939,7 +1067,7 @@ func (m *CafeMessages) Reset()         { *m = CafeMessages{} }
 func (m *CafeMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeMessages) ProtoMessage()    {}
 func (*CafeMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af259e22dc6e576e, []int{20}
+	return fileDescriptor_af259e22dc6e576e, []int{22}
 }
 
 func (m *CafeMessages) XXX_Unmarshal(b []byte) error {
@@ -978,7 +1106,7 @@ func (m *CafeDeleteMessages) Reset()         { *m = CafeDeleteMessages{} }
 func (m *CafeDeleteMessages) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessages) ProtoMessage()    {}
 func (*CafeDeleteMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af259e22dc6e576e, []int{21}
+	return fileDescriptor_af259e22dc6e576e, []int{23}
 }
 
 func (m *CafeDeleteMessages) XXX_Unmarshal(b []byte) error {
@@ -1017,7 +1145,7 @@ func (m *CafeDeleteMessagesAck) Reset()         { *m = CafeDeleteMessagesAck{} }
 func (m *CafeDeleteMessagesAck) String() string { return proto.CompactTextString(m) }
 func (*CafeDeleteMessagesAck) ProtoMessage()    {}
 func (*CafeDeleteMessagesAck) Descriptor() ([]byte, []int) {
-	return fileDescriptor_af259e22dc6e576e, []int{22}
+	return fileDescriptor_af259e22dc6e576e, []int{24}
 }
 
 func (m *CafeDeleteMessagesAck) XXX_Unmarshal(b []byte) error {
@@ -1045,7 +1173,103 @@ func (m *CafeDeleteMessagesAck) GetMore() bool {
 	return false
 }
 
+type CafeReplicate struct {
+	Type                 CafeReplicate_Type  `protobuf:"varint,1,opt,name=type,proto3,enum=CafeReplicate_Type" json:"type,omitempty"`
+	Client               string              `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Address              string              `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Cids                 []string            `protobuf:"bytes,4,rep,name=cids,proto3" json:"cids,omitempty"`
+	Thread               string              `protobuf:"bytes,5,opt,name=thread,proto3" json:"thread,omitempty"`
+	Ciphertext           []byte              `protobuf:"bytes,6,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
+	Message              *CafeDeliverMessage `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	From                 string              `protobuf:"bytes,8,opt,name=from,proto3" json:"from,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CafeReplicate) Reset()         { *m = CafeReplicate{} }
+func (m *CafeReplicate) String() string { return proto.CompactTextString(m) }
+func (*CafeReplicate) ProtoMessage()    {}
+func (*CafeReplicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_af259e22dc6e576e, []int{25}
+}
+
+func (m *CafeReplicate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeReplicate.Unmarshal(m, b)
+}
+func (m *CafeReplicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeReplicate.Marshal(b, m, deterministic)
+}
+func (m *CafeReplicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeReplicate.Merge(m, src)
+}
+func (m *CafeReplicate) XXX_Size() int {
+	return xxx_messageInfo_CafeReplicate.Size(m)
+}
+func (m *CafeReplicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeReplicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeReplicate proto.InternalMessageInfo
+
+func (m *CafeReplicate) GetType() CafeReplicate_Type {
+	if m != nil {
+		return m.Type
+	}
+	return CafeReplicate_STORE
+}
+
+func (m *CafeReplicate) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeReplicate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *CafeReplicate) GetCids() []string {
+	if m != nil {
+		return m.Cids
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *CafeReplicate) GetCiphertext() []byte {
+	if m != nil {
+		return m.Ciphertext
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetMessage() *CafeDeliverMessage {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *CafeReplicate) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("CafeReplicate_Type", CafeReplicate_Type_name, CafeReplicate_Type_value)
 	proto.RegisterType((*CafeChallenge)(nil), "CafeChallenge")
 	proto.RegisterType((*CafeNonce)(nil), "CafeNonce")
 	proto.RegisterType((*CafeRegistration)(nil), "CafeRegistration")
@@ -1065,48 +1289,63 @@ func init() {
 	proto.RegisterType((*CafeUnstoreThread)(nil), "CafeUnstoreThread")
 	proto.RegisterType((*CafeUnstoreThreadAck)(nil), "CafeUnstoreThreadAck")
 	proto.RegisterType((*CafeDeliverMessage)(nil), "CafeDeliverMessage")
+	proto.RegisterType((*CafeWebhook)(nil), "CafeWebhook")
+	proto.RegisterType((*CafeWebhookAck)(nil), "CafeWebhookAck")
 	proto.RegisterType((*CafeCheckMessages)(nil), "CafeCheckMessages")
 	proto.RegisterType((*CafeMessages)(nil), "CafeMessages")
 	proto.RegisterType((*CafeDeleteMessages)(nil), "CafeDeleteMessages")
 	proto.RegisterType((*CafeDeleteMessagesAck)(nil), "CafeDeleteMessagesAck")
+	proto.RegisterType((*CafeReplicate)(nil), "CafeReplicate")
 }
 
 func init() { proto.RegisterFile("cafe_service.proto", fileDescriptor_af259e22dc6e576e) }
 
 var fileDescriptor_af259e22dc6e576e = []byte{
-	// 542 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x51, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0x95, 0x74, 0x1d, 0xeb, 0xb5, 0x1b, 0x25, 0x0c, 0x14, 0x78, 0x98, 0x8a, 0x35, 0x41,
-	0x07, 0x52, 0x1f, 0x86, 0x10, 0xf0, 0xc8, 0x8a, 0x78, 0x82, 0x31, 0x65, 0x20, 0x24, 0x84, 0x84,
-	0x5c, 0xe7, 0xda, 0x98, 0xa6, 0x49, 0x65, 0x7b, 0x15, 0x8f, 0x7c, 0x74, 0x74, 0xb6, 0xd3, 0x46,
-	0x34, 0x15, 0xda, 0xdb, 0xdd, 0xe5, 0xe7, 0xff, 0x9d, 0xef, 0xce, 0x81, 0x48, 0xf0, 0x29, 0xfe,
-	0xd4, 0xa8, 0x56, 0x52, 0xe0, 0x68, 0xa9, 0x4a, 0x53, 0x3e, 0xee, 0x2e, 0xca, 0x14, 0x73, 0xe7,
-	0xb0, 0x33, 0x38, 0x1c, 0xf3, 0x29, 0x8e, 0x33, 0x9e, 0xe7, 0x58, 0xcc, 0x30, 0x8a, 0xe1, 0x0e,
-	0x4f, 0x53, 0x85, 0x5a, 0xc7, 0xc1, 0x20, 0x18, 0x76, 0x92, 0xca, 0x65, 0x4f, 0xa0, 0x43, 0xe8,
-	0x65, 0x59, 0x08, 0x8c, 0x8e, 0xa1, 0xbd, 0xe2, 0xf9, 0x0d, 0x7a, 0xc8, 0x39, 0xec, 0x4f, 0x00,
-	0x7d, 0x62, 0x12, 0x9c, 0x49, 0x6d, 0x14, 0x37, 0xb2, 0x2c, 0x76, 0x2b, 0x6e, 0x44, 0xc2, 0x9a,
-	0x08, 0x45, 0x0b, 0xca, 0x11, 0xb7, 0x5c, 0xd4, 0x3a, 0x51, 0x1f, 0x5a, 0x5a, 0xce, 0xe2, 0xbd,
-	0x41, 0x30, 0xec, 0x25, 0x64, 0x12, 0x67, 0xca, 0x39, 0x16, 0x71, 0xdb, 0x71, 0xd6, 0x61, 0xcf,
-	0x21, 0xa2, 0x0a, 0xde, 0xa3, 0xaa, 0xd7, 0xb0, 0x66, 0x83, 0x3a, 0xfb, 0x0c, 0x1e, 0x6c, 0xb3,
-	0xef, 0xc4, 0x3c, 0x3a, 0x82, 0x50, 0xa6, 0x9e, 0x0d, 0x65, 0xca, 0x3e, 0x38, 0xd1, 0x04, 0xa7,
-	0x0a, 0x75, 0x76, 0x8d, 0x5a, 0x93, 0xe8, 0x43, 0xd8, 0xe7, 0x42, 0x6c, 0xee, 0xe5, 0x3d, 0xba,
-	0xb0, 0x72, 0xa4, 0xbf, 0x58, 0xe5, 0xb2, 0x0b, 0xb8, 0x4b, 0x3a, 0x57, 0x37, 0x93, 0x5c, 0xea,
-	0xec, 0x0a, 0x51, 0x35, 0x57, 0x16, 0x3d, 0x82, 0xbd, 0x25, 0xa2, 0xb2, 0xe7, 0xbb, 0xe7, 0xed,
-	0x11, 0xa1, 0x89, 0x0d, 0xb1, 0x53, 0x57, 0x4b, 0x4d, 0xa3, 0xa9, 0xe2, 0x57, 0x6e, 0x58, 0xd7,
-	0xa6, 0x54, 0xb8, 0x23, 0x47, 0x04, 0x7b, 0x42, 0xa6, 0x3a, 0x0e, 0x07, 0xad, 0x61, 0x27, 0xb1,
-	0x36, 0x3b, 0x81, 0xde, 0xfa, 0x58, 0x93, 0xec, 0x6b, 0xe8, 0xd2, 0xf7, 0xaf, 0x85, 0xbe, 0xa5,
-	0xf0, 0x29, 0x1c, 0xd5, 0x0e, 0x92, 0x74, 0x45, 0x05, 0xdb, 0xd4, 0xe7, 0xc9, 0x2f, 0x14, 0xe6,
-	0xa3, 0xd4, 0xa6, 0x91, 0xfa, 0x01, 0xb0, 0xa1, 0x76, 0xd4, 0xd0, 0x87, 0x96, 0x90, 0xa9, 0xef,
-	0x3f, 0x99, 0xa4, 0x94, 0x72, 0xc3, 0xed, 0x56, 0xf5, 0x12, 0x6b, 0x53, 0xac, 0x28, 0x53, 0xf4,
-	0x5b, 0x65, 0x6d, 0xf6, 0xcd, 0xcd, 0xc8, 0xb6, 0xe0, 0x4b, 0xa6, 0x90, 0xa7, 0x3b, 0x52, 0xb8,
-	0xde, 0x84, 0x55, 0x6f, 0xa2, 0x13, 0x00, 0x21, 0x97, 0x19, 0x2a, 0x83, 0xbf, 0x8d, 0x4f, 0x53,
-	0x8b, 0x54, 0x83, 0xab, 0x09, 0x37, 0x75, 0xf8, 0x2d, 0xdc, 0xab, 0x35, 0xea, 0x36, 0x05, 0xb0,
-	0xa7, 0x70, 0xbc, 0x75, 0xb4, 0x29, 0xc5, 0x65, 0xf5, 0x44, 0x72, 0xb9, 0x42, 0xf5, 0x09, 0xb5,
-	0xe6, 0x33, 0xfc, 0x97, 0xa2, 0xed, 0x16, 0xb9, 0xc4, 0xc2, 0xf8, 0x0c, 0xde, 0xa3, 0xce, 0x62,
-	0xb1, 0xf2, 0xf7, 0x23, 0x93, 0x9d, 0xb9, 0x92, 0xc7, 0x19, 0x8a, 0xb9, 0x57, 0xd3, 0x3b, 0x5e,
-	0xdc, 0x1b, 0xb7, 0x5f, 0x6b, 0x6a, 0x08, 0x07, 0x0b, 0x6f, 0xdb, 0x11, 0x77, 0xcf, 0x7b, 0xa3,
-	0x1a, 0x90, 0xac, 0xbf, 0x6e, 0xde, 0x75, 0x8e, 0x06, 0xff, 0x93, 0xe5, 0x45, 0xf5, 0xae, 0xeb,
-	0xac, 0xdf, 0xb9, 0x45, 0xa9, 0xdc, 0x4f, 0xeb, 0x20, 0xb1, 0xf6, 0xc5, 0x7d, 0x38, 0x94, 0xe5,
-	0x88, 0x26, 0x24, 0x73, 0x1c, 0x2d, 0x27, 0xdf, 0xc3, 0xe5, 0x64, 0xb2, 0x6f, 0xff, 0x8e, 0x2f,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xc5, 0x28, 0x58, 0x40, 0x05, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x5b, 0x6f, 0xe2, 0x46,
+	0x14, 0xae, 0x0d, 0x84, 0x70, 0x20, 0x84, 0x4e, 0x92, 0xca, 0xed, 0x43, 0x94, 0x8e, 0xa2, 0x86,
+	0xb4, 0xaa, 0x1f, 0x52, 0x55, 0x6d, 0x1f, 0x73, 0xa1, 0xbb, 0x0f, 0x1b, 0x92, 0x35, 0x44, 0x91,
+	0x56, 0x2b, 0x45, 0xc6, 0x3e, 0xc0, 0x2c, 0xc6, 0xb6, 0x66, 0x06, 0xb4, 0x79, 0xdb, 0x1f, 0xb8,
+	0x3f, 0x6a, 0x35, 0xe3, 0x31, 0x38, 0x5c, 0xb4, 0xca, 0xdb, 0x39, 0xe3, 0xef, 0x7c, 0xe7, 0x32,
+	0xdf, 0x1c, 0x03, 0x09, 0xfc, 0x21, 0x3e, 0x09, 0xe4, 0x73, 0x16, 0xa0, 0x9b, 0xf2, 0x44, 0x26,
+	0xbf, 0xd4, 0xa7, 0x49, 0x88, 0x51, 0xe6, 0xd0, 0x73, 0xd8, 0xbb, 0xf6, 0x87, 0x78, 0x3d, 0xf6,
+	0xa3, 0x08, 0xe3, 0x11, 0x12, 0x07, 0xaa, 0x7e, 0x18, 0x72, 0x14, 0xc2, 0xb1, 0x4e, 0xac, 0x76,
+	0xcd, 0xcb, 0x5d, 0xfa, 0x2b, 0xd4, 0x14, 0xb4, 0x9b, 0xc4, 0x01, 0x92, 0x43, 0xa8, 0xcc, 0xfd,
+	0x68, 0x86, 0x06, 0x94, 0x39, 0xf4, 0x8b, 0x05, 0x2d, 0x85, 0xf1, 0x70, 0xc4, 0x84, 0xe4, 0xbe,
+	0x64, 0x49, 0xbc, 0x9d, 0x71, 0x49, 0x62, 0x17, 0x48, 0xd4, 0x69, 0xac, 0x72, 0x38, 0xa5, 0xec,
+	0x54, 0x3b, 0xa4, 0x05, 0x25, 0xc1, 0x46, 0x4e, 0xf9, 0xc4, 0x6a, 0x37, 0x3c, 0x65, 0x2a, 0x9c,
+	0x4c, 0x26, 0x18, 0x3b, 0x95, 0x0c, 0xa7, 0x1d, 0xfa, 0x3b, 0x10, 0x55, 0xc1, 0x0d, 0xf2, 0x62,
+	0x0d, 0x0b, 0xac, 0x55, 0xc4, 0x9e, 0xc1, 0xd1, 0x3a, 0xf6, 0x32, 0x98, 0x90, 0x26, 0xd8, 0x2c,
+	0x34, 0x58, 0x9b, 0x85, 0xf4, 0xff, 0x8c, 0xd4, 0xc3, 0x21, 0x47, 0x31, 0xee, 0xa1, 0x10, 0x8a,
+	0xf4, 0x27, 0xd8, 0xf1, 0x83, 0x60, 0xd9, 0x97, 0xf1, 0x54, 0xc3, 0x3c, 0x43, 0x9a, 0xc6, 0x72,
+	0x97, 0x5e, 0xc1, 0xbe, 0xe2, 0xb9, 0x9f, 0x0d, 0x22, 0x26, 0xc6, 0xf7, 0x88, 0x7c, 0x73, 0x65,
+	0xe4, 0x67, 0x28, 0xa7, 0x88, 0x5c, 0xc7, 0xd7, 0x2f, 0x2a, 0xae, 0x82, 0x7a, 0xfa, 0x88, 0x9e,
+	0x02, 0x59, 0xe1, 0xd8, 0x54, 0xf1, 0xdf, 0xd9, 0x65, 0xf5, 0x64, 0xc2, 0x71, 0x4b, 0x0e, 0x02,
+	0xe5, 0x80, 0x85, 0xc2, 0xb1, 0x4f, 0x4a, 0xed, 0x9a, 0xa7, 0x6d, 0x7a, 0x0c, 0x8d, 0x45, 0xd8,
+	0x26, 0xda, 0x7f, 0xa0, 0xae, 0xbe, 0x3f, 0xc4, 0xe2, 0x95, 0xc4, 0xa7, 0xd0, 0x2c, 0x04, 0x2a,
+	0xea, 0x1c, 0x65, 0xad, 0xa3, 0xee, 0x06, 0x9f, 0x30, 0x90, 0xef, 0x98, 0x90, 0x1b, 0x51, 0x1f,
+	0x01, 0x96, 0xa8, 0x2d, 0x35, 0xb4, 0xa0, 0x14, 0xb0, 0xd0, 0xcc, 0x5f, 0x99, 0x8a, 0x29, 0xf4,
+	0xa5, 0xaf, 0x55, 0xd5, 0xf0, 0xb4, 0xad, 0xce, 0xe2, 0x24, 0x44, 0xa3, 0x2a, 0x6d, 0xd3, 0x47,
+	0xd8, 0x5f, 0x8c, 0xa0, 0x3f, 0xe6, 0xe8, 0x87, 0x5b, 0x52, 0x64, 0xb3, 0xb1, 0xf3, 0xd9, 0x90,
+	0x63, 0x80, 0x80, 0xa5, 0x63, 0xe4, 0x12, 0x3f, 0x4b, 0x93, 0xa6, 0x70, 0x92, 0x5f, 0x5c, 0x81,
+	0x78, 0xd3, 0x84, 0xff, 0x83, 0x1f, 0x0b, 0x83, 0x7a, 0x4d, 0x01, 0xf4, 0x37, 0x38, 0x5c, 0x0b,
+	0xdd, 0x94, 0xa2, 0x9b, 0x3f, 0x91, 0x88, 0xcd, 0x91, 0xdf, 0xa2, 0x10, 0xfe, 0x08, 0x57, 0x51,
+	0x4a, 0xdd, 0x41, 0xc4, 0x30, 0x96, 0x26, 0x83, 0xf1, 0xd4, 0x64, 0x31, 0x9e, 0x9b, 0xfe, 0x94,
+	0x49, 0x6f, 0x33, 0x51, 0x3c, 0xe2, 0x60, 0x9c, 0x24, 0x93, 0xed, 0x17, 0x32, 0xe3, 0x51, 0x7e,
+	0x21, 0x33, 0x1e, 0xa9, 0x04, 0x02, 0x03, 0x8e, 0xd2, 0x3c, 0x74, 0xe3, 0x51, 0x0a, 0xcd, 0x02,
+	0x9d, 0x6a, 0xc0, 0xc4, 0x5a, 0x8b, 0x58, 0x7a, 0x9e, 0x4d, 0xe9, 0x7a, 0x8c, 0xc1, 0xc4, 0x34,
+	0x20, 0xb6, 0x3c, 0xf2, 0x7f, 0x33, 0x49, 0x2f, 0x50, 0x6d, 0xd8, 0x9d, 0x1a, 0x5b, 0xab, 0xaa,
+	0x7e, 0xd1, 0x70, 0x0b, 0x00, 0x6f, 0xf1, 0x75, 0xb9, 0x4a, 0x22, 0x94, 0xf8, 0x9d, 0x2c, 0x7f,
+	0xc0, 0xd1, 0x3a, 0xd6, 0xc8, 0x7c, 0x9a, 0xf0, 0x6c, 0x4f, 0xee, 0x7a, 0xda, 0xa6, 0x5f, 0xed,
+	0x6c, 0xeb, 0x7a, 0x98, 0x46, 0x2c, 0xf0, 0x25, 0x92, 0x33, 0x28, 0xcb, 0xe7, 0x34, 0x43, 0x35,
+	0x2f, 0x0e, 0xdc, 0x17, 0x5f, 0xdd, 0xfe, 0x73, 0x8a, 0x9e, 0x06, 0x6c, 0xbd, 0x95, 0xc2, 0x92,
+	0x2d, 0xbd, 0x5c, 0xb2, 0xf9, 0x0b, 0x2a, 0x2f, 0x5f, 0x90, 0x62, 0x91, 0x5a, 0x1e, 0x66, 0x77,
+	0x1a, 0x6f, 0x45, 0xc2, 0x3b, 0xab, 0x12, 0x26, 0x7f, 0x42, 0xd5, 0x4c, 0xc7, 0xa9, 0xea, 0xcd,
+	0x74, 0xe0, 0xae, 0x2b, 0xc9, 0xcb, 0x31, 0x2a, 0xf5, 0x90, 0x27, 0x53, 0x67, 0x57, 0x27, 0xd1,
+	0x36, 0x7d, 0x0f, 0x65, 0xd5, 0x0e, 0xa9, 0x41, 0xa5, 0xd7, 0xbf, 0xf3, 0x3a, 0xad, 0x1f, 0x48,
+	0x1d, 0xaa, 0x0f, 0xdd, 0xcc, 0xb1, 0x48, 0x0b, 0x1a, 0xda, 0x7c, 0xea, 0xbf, 0xf5, 0x3a, 0x97,
+	0x37, 0x2d, 0x9b, 0x10, 0x68, 0x3e, 0x74, 0x5f, 0x9c, 0x95, 0x54, 0xc8, 0x6d, 0xa7, 0xd7, 0xbb,
+	0x7c, 0xd3, 0x69, 0x95, 0xaf, 0x0e, 0x60, 0x8f, 0x25, 0xae, 0x2a, 0x90, 0x45, 0xe8, 0xa6, 0x83,
+	0x0f, 0x76, 0x3a, 0x18, 0xec, 0xe8, 0xff, 0xdb, 0x5f, 0xdf, 0x06, 0x00, 0xce, 0x0f, 0x1d, 0x8c,
+	0x02, 0x07, 0x00, 0x00,
 }
@@ -55,6 +55,9 @@ const (
 	Message_CAFE_PUBLISH_PEER_ACK         Message_Type = 67
 	Message_CAFE_QUERY                    Message_Type = 70
 	Message_CAFE_QUERY_RES                Message_Type = 71
+	Message_CAFE_REPLICATE                Message_Type = 79
+	Message_CAFE_WEBHOOK                  Message_Type = 80
+	Message_CAFE_WEBHOOK_ACK              Message_Type = 81
 	Message_CAFE_PUBSUB_QUERY             Message_Type = 102
 	Message_CAFE_PUBSUB_QUERY_RES         Message_Type = 103
 	Message_ERROR                         Message_Type = 500
@@ -96,6 +99,9 @@ var Message_Type_name = map[int32]string{
 	67:  "CAFE_PUBLISH_PEER_ACK",
 	70:  "CAFE_QUERY",
 	71:  "CAFE_QUERY_RES",
+	79:  "CAFE_REPLICATE",
+	80:  "CAFE_WEBHOOK",
+	81:  "CAFE_WEBHOOK_ACK",
 	102: "CAFE_PUBSUB_QUERY",
 	103: "CAFE_PUBSUB_QUERY_RES",
 	500: "ERROR",
@@ -137,6 +143,9 @@ var Message_Type_value = map[string]int32{
 	"CAFE_PUBLISH_PEER_ACK":         67,
 	"CAFE_QUERY":                    70,
 	"CAFE_QUERY_RES":                71,
+	"CAFE_REPLICATE":                79,
+	"CAFE_WEBHOOK":                  80,
+	"CAFE_WEBHOOK_ACK":              81,
 	"CAFE_PUBSUB_QUERY":             102,
 	"CAFE_PUBSUB_QUERY_RES":         103,
 	"ERROR":                         500,
@@ -321,45 +330,47 @@ func init() {
 func init() { proto.RegisterFile("message.proto", fileDescriptor_33c57e4bae7b9afd) }
 
 var fileDescriptor_33c57e4bae7b9afd = []byte{
-	// 639 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x5b, 0x6f, 0xda, 0x4c,
-	0x10, 0x86, 0x3f, 0x12, 0x12, 0xf8, 0x86, 0x90, 0x6c, 0x26, 0x27, 0xc2, 0x77, 0x10, 0x41, 0xaa,
-	0xc4, 0x95, 0x23, 0x91, 0xa6, 0xe7, 0x43, 0x8c, 0x99, 0x80, 0x13, 0x63, 0xd3, 0xb5, 0x89, 0x94,
-	0xde, 0x58, 0xd0, 0x38, 0x28, 0x52, 0x8a, 0x29, 0x90, 0xaa, 0xfc, 0x80, 0xfe, 0xdf, 0x5e, 0xf4,
-	0x07, 0x54, 0x1e, 0xe3, 0x95, 0xd3, 0xa4, 0x77, 0x9e, 0xf7, 0x7d, 0xe7, 0x99, 0xdd, 0xb5, 0x34,
-	0x50, 0xfc, 0x1c, 0x4c, 0xa7, 0xfd, 0x61, 0xa0, 0x8d, 0x27, 0xe1, 0x2c, 0x2c, 0xef, 0x0f, 0xc3,
-	0x70, 0x78, 0x1b, 0x1c, 0x72, 0x35, 0xb8, 0xbb, 0x3e, 0xec, 0x8f, 0xe6, 0xb1, 0x55, 0xfd, 0x9e,
-	0x87, 0x5c, 0x27, 0x0e, 0xe3, 0x01, 0x64, 0x67, 0xf3, 0x71, 0x50, 0xca, 0x54, 0x32, 0xb5, 0xf5,
-	0x7a, 0x51, 0x5b, 0xe8, 0x9a, 0x37, 0x1f, 0x07, 0x92, 0x2d, 0xd4, 0x20, 0x37, 0xee, 0xcf, 0x6f,
-	0xc3, 0xfe, 0x55, 0x69, 0xa9, 0x92, 0xa9, 0x15, 0xea, 0xdb, 0x5a, 0xcc, 0xd6, 0x12, 0xb6, 0xa6,
-	0x8f, 0xe6, 0x32, 0x09, 0x61, 0x09, 0x72, 0x93, 0xe0, 0xcb, 0x5d, 0x30, 0x9d, 0x95, 0x96, 0x2b,
-	0x99, 0xda, 0x8a, 0x4c, 0x4a, 0x2c, 0x43, 0x7e, 0x12, 0x4c, 0xc7, 0xe1, 0x68, 0x1a, 0x94, 0xb2,
-	0x95, 0x4c, 0x2d, 0x2f, 0x55, 0x5d, 0xfd, 0xb1, 0x0a, 0xd9, 0x68, 0x28, 0xe6, 0x21, 0xdb, 0x35,
-	0xed, 0x96, 0xf8, 0x8b, 0xbf, 0x1c, 0xbb, 0x25, 0x32, 0xb8, 0x05, 0x1b, 0x5e, 0x5b, 0x92, 0xde,
-	0xf4, 0xc9, 0xbe, 0x20, 0xcb, 0xe9, 0x92, 0x00, 0xdc, 0x83, 0xad, 0xdf, 0x44, 0x5f, 0x37, 0xce,
-	0x45, 0x01, 0x11, 0xd6, 0x0d, 0xfd, 0x94, 0x7c, 0xa3, 0xad, 0x5b, 0x16, 0xd9, 0x2d, 0x12, 0x75,
-	0x5c, 0x07, 0x60, 0xcd, 0x76, 0x6c, 0x83, 0xc4, 0x11, 0xee, 0xc0, 0x26, 0xd7, 0x92, 0x5a, 0xa6,
-	0xeb, 0x49, 0xdd, 0x33, 0x1d, 0x5b, 0x3c, 0x8d, 0x98, 0x2c, 0x37, 0xe9, 0x9e, 0xd1, 0xc6, 0x7f,
-	0x60, 0xef, 0x11, 0x83, 0x07, 0x9a, 0x28, 0x60, 0x8d, 0x4d, 0x97, 0x5c, 0x37, 0x8a, 0x1f, 0x63,
-	0x09, 0xb6, 0x17, 0xf8, 0x53, 0x49, 0x6e, 0x5b, 0x39, 0xcf, 0xd4, 0x41, 0x5c, 0xcf, 0x91, 0x24,
-	0x9e, 0xab, 0xc3, 0x72, 0xcd, 0xbc, 0xd7, 0x8a, 0xd7, 0xb3, 0xe3, 0xd4, 0x19, 0x6e, 0x83, 0x48,
-	0x2b, 0x9c, 0x3b, 0xc7, 0x0d, 0x28, 0xb0, 0xea, 0x34, 0xce, 0xc8, 0xf0, 0xc4, 0x0b, 0x15, 0x8b,
-	0x05, 0xdf, 0x32, 0x5d, 0x4f, 0xbc, 0x54, 0x77, 0x8d, 0x5b, 0xe3, 0x37, 0x13, 0xaf, 0x70, 0x1f,
-	0x76, 0x1e, 0xc8, 0x0c, 0xb6, 0xd4, 0x33, 0x24, 0xe3, 0x16, 0x3d, 0x1d, 0xf5, 0x0c, 0xf7, 0x0d,
-	0xee, 0xb2, 0xd5, 0xa5, 0x9b, 0x64, 0x99, 0x17, 0x24, 0xfd, 0x0e, 0xb9, 0xae, 0xde, 0x22, 0xf1,
-	0x46, 0xf1, 0x8c, 0x36, 0x19, 0xe7, 0x89, 0xee, 0x8a, 0xb7, 0xb8, 0x09, 0x45, 0x36, 0x94, 0xf4,
-	0x2e, 0x4d, 0x21, 0x2f, 0xe5, 0xbc, 0xc7, 0x7f, 0xa1, 0xf4, 0x98, 0xc3, 0xd3, 0x4f, 0x70, 0x17,
-	0x90, 0xdd, 0x4b, 0xa7, 0xe7, 0xb7, 0xf5, 0x0b, 0xf2, 0x3b, 0xba, 0x69, 0x09, 0x5d, 0xdd, 0xbe,
-	0xdb, 0x6b, 0x58, 0xa6, 0xdb, 0xf6, 0xbb, 0x44, 0x52, 0x34, 0xd4, 0xed, 0xd3, 0x32, 0x93, 0x0c,
-	0xf5, 0x8b, 0x3e, 0xf4, 0x48, 0x5e, 0x8a, 0x53, 0xf5, 0x8b, 0xb8, 0xf6, 0x25, 0xb9, 0xa2, 0x95,
-	0xa6, 0xba, 0xbd, 0xc6, 0x22, 0x7a, 0x9d, 0xa6, 0x2a, 0x99, 0x3b, 0x86, 0x08, 0xb0, 0x42, 0x52,
-	0x3a, 0x52, 0xfc, 0x5c, 0xc6, 0xf2, 0xe2, 0xac, 0x86, 0x63, 0x7b, 0xba, 0xe1, 0x2d, 0xda, 0x9b,
-	0xe5, 0xa5, 0x7c, 0x06, 0xff, 0x87, 0xdd, 0x87, 0x1e, 0x33, 0x88, 0xfd, 0x03, 0xd8, 0x4f, 0x8f,
-	0xb8, 0x8f, 0xb8, 0xe2, 0xc8, 0x13, 0xf8, 0xef, 0x8f, 0x11, 0x26, 0x05, 0x51, 0xac, 0x7a, 0x02,
-	0x79, 0x1a, 0x7d, 0x0d, 0x6e, 0xc3, 0x71, 0x80, 0x55, 0xc8, 0x2d, 0xf6, 0x07, 0xaf, 0x82, 0x42,
-	0x3d, 0x9f, 0xac, 0x02, 0x99, 0x18, 0x28, 0x60, 0x79, 0x7a, 0x33, 0xe4, 0x25, 0xb0, 0x26, 0xa3,
-	0xcf, 0xea, 0x31, 0xac, 0xd0, 0x64, 0x12, 0x4e, 0x10, 0x21, 0xfb, 0x29, 0xbc, 0x8a, 0x7b, 0x8b,
-	0x92, 0xbf, 0xa3, 0x3d, 0x90, 0x20, 0xa3, 0x96, 0xbf, 0x15, 0xa8, 0xb1, 0x05, 0xc5, 0x9b, 0x50,
-	0x9b, 0x05, 0xdf, 0x66, 0x37, 0xd1, 0x16, 0x19, 0x7c, 0x5c, 0x1a, 0x0f, 0x06, 0xab, 0xbc, 0x4d,
-	0x8e, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x25, 0xb0, 0x61, 0x52, 0xc8, 0x04, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xed, 0x4e, 0xdb, 0x4a,
+	0x10, 0xbd, 0x81, 0x70, 0x93, 0x3b, 0x21, 0xb0, 0x2c, 0x5f, 0x21, 0xb7, 0xad, 0x42, 0xa4, 0x4a,
+	0xf9, 0x65, 0xa4, 0x50, 0xfa, 0xfd, 0x81, 0xe3, 0x0c, 0xb1, 0x89, 0x63, 0x87, 0xb5, 0x43, 0x45,
+	0xff, 0x58, 0x49, 0x31, 0x11, 0x12, 0x8d, 0xdd, 0x24, 0x54, 0xcd, 0x3b, 0xf5, 0x25, 0xfa, 0x4e,
+	0x7d, 0x80, 0xca, 0x63, 0x7b, 0x65, 0x0a, 0xfd, 0xb7, 0x73, 0xce, 0x99, 0x33, 0x33, 0x3b, 0xd2,
+	0x40, 0xf9, 0x8b, 0x3f, 0x9b, 0x0d, 0xc7, 0xbe, 0x12, 0x4e, 0x83, 0x79, 0x50, 0xdd, 0x1b, 0x07,
+	0xc1, 0xf8, 0xc6, 0x3f, 0xa0, 0x68, 0x74, 0x7b, 0x75, 0x30, 0x9c, 0x2c, 0x62, 0xaa, 0xfe, 0xb3,
+	0x08, 0x85, 0x5e, 0x2c, 0xe6, 0xfb, 0x90, 0x9f, 0x2f, 0x42, 0xbf, 0x92, 0xab, 0xe5, 0x1a, 0x6b,
+	0xcd, 0xb2, 0x92, 0xe0, 0x8a, 0xbb, 0x08, 0x7d, 0x41, 0x14, 0x57, 0xa0, 0x10, 0x0e, 0x17, 0x37,
+	0xc1, 0xf0, 0xb2, 0xb2, 0x54, 0xcb, 0x35, 0x4a, 0xcd, 0x2d, 0x25, 0xf6, 0x56, 0x52, 0x6f, 0x45,
+	0x9d, 0x2c, 0x44, 0x2a, 0xe2, 0x15, 0x28, 0x4c, 0xfd, 0xaf, 0xb7, 0xfe, 0x6c, 0x5e, 0x59, 0xae,
+	0xe5, 0x1a, 0x2b, 0x22, 0x0d, 0x79, 0x15, 0x8a, 0x53, 0x7f, 0x16, 0x06, 0x93, 0x99, 0x5f, 0xc9,
+	0xd7, 0x72, 0x8d, 0xa2, 0x90, 0x71, 0xfd, 0x47, 0x01, 0xf2, 0x51, 0x51, 0x5e, 0x84, 0x7c, 0xdf,
+	0xb0, 0x3a, 0xec, 0x1f, 0x7a, 0xd9, 0x56, 0x87, 0xe5, 0xf8, 0x26, 0xac, 0xbb, 0xba, 0x40, 0xb5,
+	0xed, 0xa1, 0x75, 0x8e, 0xa6, 0xdd, 0x47, 0x06, 0x7c, 0x17, 0x36, 0xff, 0x00, 0x3d, 0x55, 0xeb,
+	0xb2, 0x12, 0xe7, 0xb0, 0xa6, 0xa9, 0x27, 0xe8, 0x69, 0xba, 0x6a, 0x9a, 0x68, 0x75, 0x90, 0x35,
+	0xf9, 0x1a, 0x00, 0x61, 0x96, 0x6d, 0x69, 0xc8, 0x0e, 0xf9, 0x36, 0x6c, 0x50, 0x2c, 0xb0, 0x63,
+	0x38, 0xae, 0x50, 0x5d, 0xc3, 0xb6, 0xd8, 0xb3, 0xc8, 0x93, 0xe0, 0x36, 0xde, 0x21, 0x74, 0xfe,
+	0x3f, 0xec, 0x3e, 0x40, 0x50, 0x41, 0x83, 0x33, 0x58, 0x25, 0xd2, 0x41, 0xc7, 0x89, 0xe4, 0x47,
+	0xbc, 0x02, 0x5b, 0x89, 0xfd, 0x89, 0x40, 0x47, 0x97, 0xcc, 0x73, 0xd9, 0x88, 0xe3, 0xda, 0x02,
+	0xd9, 0x0b, 0xd9, 0x2c, 0xc5, 0xe4, 0xf7, 0x46, 0xfa, 0x0d, 0xac, 0x58, 0x75, 0xca, 0xb7, 0x80,
+	0x65, 0x11, 0xd2, 0x75, 0xf9, 0x3a, 0x94, 0x08, 0xb5, 0x5b, 0xa7, 0xa8, 0xb9, 0xec, 0xa5, 0x94,
+	0xc5, 0x80, 0x67, 0x1a, 0x8e, 0xcb, 0x5e, 0xc9, 0x59, 0xe3, 0xd4, 0xf8, 0xcf, 0xd8, 0x6b, 0xbe,
+	0x07, 0xdb, 0xf7, 0x60, 0x32, 0x36, 0xe5, 0x37, 0x0c, 0xac, 0x2c, 0xc9, 0x7a, 0xf2, 0x1b, 0x06,
+	0xd6, 0xbd, 0x2c, 0x4b, 0x0e, 0xdd, 0x46, 0xd3, 0x38, 0x47, 0xe1, 0xf5, 0xd0, 0x71, 0xd4, 0x0e,
+	0xb2, 0xb7, 0xd2, 0x4f, 0xd3, 0x51, 0xeb, 0xa6, 0xb8, 0xc3, 0xde, 0xf1, 0x0d, 0x28, 0x13, 0x21,
+	0xa1, 0xf7, 0x59, 0x17, 0x74, 0x33, 0xcc, 0x07, 0xfe, 0x08, 0x2a, 0x0f, 0x31, 0x54, 0xfd, 0x98,
+	0xef, 0x00, 0x27, 0xf6, 0xc2, 0x1e, 0x78, 0xba, 0x7a, 0x8e, 0x5e, 0x4f, 0x35, 0x4c, 0xa6, 0xca,
+	0xe9, 0xfb, 0x83, 0x96, 0x69, 0x38, 0xba, 0xd7, 0x47, 0x14, 0xac, 0x25, 0xa7, 0xcf, 0xc2, 0xe4,
+	0xa4, 0xc9, 0x15, 0x9d, 0x0d, 0x50, 0x5c, 0xb0, 0x13, 0xb9, 0x22, 0x8a, 0x3d, 0x81, 0x0e, 0xeb,
+	0x48, 0x4c, 0x60, 0xdf, 0x34, 0x34, 0xd5, 0x45, 0x66, 0xcb, 0xb5, 0x7d, 0xc4, 0x96, 0x6e, 0xdb,
+	0x5d, 0xd6, 0x97, 0xfb, 0x48, 0x10, 0xf2, 0x3f, 0xcb, 0x76, 0xe4, 0x0c, 0x5a, 0x49, 0x99, 0xab,
+	0x6c, 0x47, 0x12, 0xa6, 0x6a, 0x63, 0x0e, 0xb0, 0x82, 0x42, 0xd8, 0x82, 0xfd, 0x5a, 0xe6, 0xd5,
+	0x64, 0x4e, 0xcd, 0xb6, 0x5c, 0x55, 0x73, 0x93, 0xf4, 0x76, 0x75, 0xa9, 0x98, 0xe3, 0x4f, 0x60,
+	0xe7, 0x3e, 0x47, 0x1e, 0x48, 0xfc, 0x3e, 0xec, 0x65, 0x4b, 0xdc, 0xb5, 0xb8, 0x24, 0xc9, 0x53,
+	0x78, 0xfc, 0x57, 0x09, 0x39, 0xf9, 0x91, 0xac, 0x7e, 0x0c, 0x45, 0x9c, 0x7c, 0xf3, 0x6f, 0x82,
+	0xd0, 0xe7, 0x75, 0x28, 0x24, 0xb7, 0x87, 0xce, 0x48, 0xa9, 0x59, 0x4c, 0xcf, 0x88, 0x48, 0x09,
+	0xce, 0x60, 0x79, 0x76, 0x3d, 0xa6, 0x03, 0xb2, 0x2a, 0xa2, 0x67, 0xfd, 0x08, 0x56, 0x70, 0x3a,
+	0x0d, 0xa6, 0x9c, 0x43, 0xfe, 0x73, 0x70, 0x19, 0xe7, 0x96, 0x05, 0xbd, 0xa3, 0x1b, 0x92, 0x5a,
+	0x46, 0x29, 0xff, 0x49, 0xa3, 0xd6, 0x26, 0x94, 0xaf, 0x03, 0x65, 0xee, 0x7f, 0x9f, 0x5f, 0x47,
+	0x17, 0x68, 0xf4, 0x69, 0x29, 0x1c, 0x8d, 0xfe, 0xa5, 0x4b, 0x74, 0xf8, 0x7b, 0x00, 0xa1, 0x6f,
+	0x20, 0x2b, 0x04, 0x05, 0x00, 0x00,
 }
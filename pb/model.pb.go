@@ -6,6 +6,7 @@ package pb
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	any "github.com/golang/protobuf/ptypes/any"
 	_struct "github.com/golang/protobuf/ptypes/struct"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	math "math"
@@ -22,6 +23,37 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type PeerReachability_Path int32
+
+const (
+	PeerReachability_DIRECT PeerReachability_Path = 0
+	PeerReachability_RELAY  PeerReachability_Path = 1
+	PeerReachability_PUBSUB PeerReachability_Path = 2
+	PeerReachability_INBOX  PeerReachability_Path = 3
+)
+
+var PeerReachability_Path_name = map[int32]string{
+	0: "DIRECT",
+	1: "RELAY",
+	2: "PUBSUB",
+	3: "INBOX",
+}
+
+var PeerReachability_Path_value = map[string]int32{
+	"DIRECT": 0,
+	"RELAY":  1,
+	"PUBSUB": 2,
+	"INBOX":  3,
+}
+
+func (x PeerReachability_Path) String() string {
+	return proto.EnumName(PeerReachability_Path_name, int32(x))
+}
+
+func (PeerReachability_Path) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{2, 0}
+}
+
 // Type controls read (R), annotate (A), and write (W) access
 type Thread_Type int32
 
@@ -51,7 +83,7 @@ func (x Thread_Type) String() string {
 }
 
 func (Thread_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{6, 0}
 }
 
 // Sharing controls if (Y/N) a thread can be shared
@@ -80,7 +112,7 @@ func (x Thread_Sharing) String() string {
 }
 
 func (Thread_Sharing) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5, 1}
+	return fileDescriptor_4c16552f9fdb66d8, []int{6, 1}
 }
 
 // State indicates the loading state
@@ -109,7 +141,7 @@ func (x Thread_State) String() string {
 }
 
 func (Thread_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5, 2}
+	return fileDescriptor_4c16552f9fdb66d8, []int{6, 2}
 }
 
 type Block_BlockType int32
@@ -161,7 +193,7 @@ func (x Block_BlockType) String() string {
 }
 
 func (Block_BlockType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{8, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{9, 0}
 }
 
 type Block_BlockStatus int32
@@ -189,7 +221,33 @@ func (x Block_BlockStatus) String() string {
 }
 
 func (Block_BlockStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{8, 1}
+	return fileDescriptor_4c16552f9fdb66d8, []int{9, 1}
+}
+
+// BlockSort controls the ordering of block lists
+type Block_BlockSort int32
+
+const (
+	Block_DATE   Block_BlockSort = 0
+	Block_CAUSAL Block_BlockSort = 1
+)
+
+var Block_BlockSort_name = map[int32]string{
+	0: "DATE",
+	1: "CAUSAL",
+}
+
+var Block_BlockSort_value = map[string]int32{
+	"DATE":   0,
+	"CAUSAL": 1,
+}
+
+func (x Block_BlockSort) String() string {
+	return proto.EnumName(Block_BlockSort_name, int32(x))
+}
+
+func (Block_BlockSort) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{9, 2}
 }
 
 type Notification_Type int32
@@ -235,7 +293,7 @@ func (x Notification_Type) String() string {
 }
 
 func (Notification_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{16, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{17, 0}
 }
 
 type CafeRequest_Type int32
@@ -269,7 +327,7 @@ func (x CafeRequest_Type) String() string {
 }
 
 func (CafeRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{22, 0}
 }
 
 type CafeRequest_Status int32
@@ -297,7 +355,7 @@ func (x CafeRequest_Status) String() string {
 }
 
 func (CafeRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21, 1}
+	return fileDescriptor_4c16552f9fdb66d8, []int{22, 1}
 }
 
 type CafeHTTPRequest_Type int32
@@ -325,7 +383,69 @@ func (x CafeHTTPRequest_Type) String() string {
 }
 
 func (CafeHTTPRequest_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{24, 0}
+	return fileDescriptor_4c16552f9fdb66d8, []int{25, 0}
+}
+
+type ApiKey_Scope int32
+
+const (
+	ApiKey_READ         ApiKey_Scope = 0
+	ApiKey_THREAD_WRITE ApiKey_Scope = 1
+	ApiKey_ADMIN        ApiKey_Scope = 2
+	ApiKey_CAFE         ApiKey_Scope = 3
+)
+
+var ApiKey_Scope_name = map[int32]string{
+	0: "READ",
+	1: "THREAD_WRITE",
+	2: "ADMIN",
+	3: "CAFE",
+}
+
+var ApiKey_Scope_value = map[string]int32{
+	"READ":         0,
+	"THREAD_WRITE": 1,
+	"ADMIN":        2,
+	"CAFE":         3,
+}
+
+func (x ApiKey_Scope) String() string {
+	return proto.EnumName(ApiKey_Scope_name, int32(x))
+}
+
+func (ApiKey_Scope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{36, 0}
+}
+
+type DeadLetter_Queue int32
+
+const (
+	DeadLetter_BLOCK_DOWNLOADS DeadLetter_Queue = 0
+	DeadLetter_BLOCK_POSTS     DeadLetter_Queue = 1
+	DeadLetter_CAFE_INBOX      DeadLetter_Queue = 2
+	DeadLetter_CAFE_REQUESTS   DeadLetter_Queue = 3
+)
+
+var DeadLetter_Queue_name = map[int32]string{
+	0: "BLOCK_DOWNLOADS",
+	1: "BLOCK_POSTS",
+	2: "CAFE_INBOX",
+	3: "CAFE_REQUESTS",
+}
+
+var DeadLetter_Queue_value = map[string]int32{
+	"BLOCK_DOWNLOADS": 0,
+	"BLOCK_POSTS":     1,
+	"CAFE_INBOX":      2,
+	"CAFE_REQUESTS":   3,
+}
+
+func (x DeadLetter_Queue) String() string {
+	return proto.EnumName(DeadLetter_Queue_name, int32(x))
+}
+
+func (DeadLetter_Queue) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{38, 0}
 }
 
 type Peer struct {
@@ -454,6 +574,85 @@ func (m *PeerList) GetItems() []*Peer {
 	return nil
 }
 
+type PeerReachability struct {
+	Peer                 string                `protobuf:"bytes,1,opt,name=peer,proto3" json:"peer,omitempty"`
+	Path                 PeerReachability_Path `protobuf:"varint,2,opt,name=path,proto3,enum=PeerReachability_Path" json:"path,omitempty"`
+	Successes            int32                 `protobuf:"varint,3,opt,name=successes,proto3" json:"successes,omitempty"`
+	Failures             int32                 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastSuccess          *timestamp.Timestamp  `protobuf:"bytes,5,opt,name=last_success,json=lastSuccess,proto3" json:"last_success,omitempty"`
+	LastFailure          *timestamp.Timestamp  `protobuf:"bytes,6,opt,name=last_failure,json=lastFailure,proto3" json:"last_failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PeerReachability) Reset()         { *m = PeerReachability{} }
+func (m *PeerReachability) String() string { return proto.CompactTextString(m) }
+func (*PeerReachability) ProtoMessage()    {}
+func (*PeerReachability) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{2}
+}
+
+func (m *PeerReachability) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerReachability.Unmarshal(m, b)
+}
+func (m *PeerReachability) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerReachability.Marshal(b, m, deterministic)
+}
+func (m *PeerReachability) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerReachability.Merge(m, src)
+}
+func (m *PeerReachability) XXX_Size() int {
+	return xxx_messageInfo_PeerReachability.Size(m)
+}
+func (m *PeerReachability) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerReachability.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerReachability proto.InternalMessageInfo
+
+func (m *PeerReachability) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
+
+func (m *PeerReachability) GetPath() PeerReachability_Path {
+	if m != nil {
+		return m.Path
+	}
+	return PeerReachability_DIRECT
+}
+
+func (m *PeerReachability) GetSuccesses() int32 {
+	if m != nil {
+		return m.Successes
+	}
+	return 0
+}
+
+func (m *PeerReachability) GetFailures() int32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *PeerReachability) GetLastSuccess() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastSuccess
+	}
+	return nil
+}
+
+func (m *PeerReachability) GetLastFailure() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastFailure
+	}
+	return nil
+}
+
 type User struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -467,7 +666,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{2}
+	return fileDescriptor_4c16552f9fdb66d8, []int{3}
 }
 
 func (m *User) XXX_Unmarshal(b []byte) error {
@@ -524,7 +723,7 @@ func (m *Contact) Reset()         { *m = Contact{} }
 func (m *Contact) String() string { return proto.CompactTextString(m) }
 func (*Contact) ProtoMessage()    {}
 func (*Contact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{3}
+	return fileDescriptor_4c16552f9fdb66d8, []int{4}
 }
 
 func (m *Contact) XXX_Unmarshal(b []byte) error {
@@ -591,7 +790,7 @@ func (m *ContactList) Reset()         { *m = ContactList{} }
 func (m *ContactList) String() string { return proto.CompactTextString(m) }
 func (*ContactList) ProtoMessage()    {}
 func (*ContactList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{4}
+	return fileDescriptor_4c16552f9fdb66d8, []int{5}
 }
 
 func (m *ContactList) XXX_Unmarshal(b []byte) error {
@@ -620,17 +819,19 @@ func (m *ContactList) GetItems() []*Contact {
 }
 
 type Thread struct {
-	Id        string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Key       string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Sk        []byte         `protobuf:"bytes,3,opt,name=sk,proto3" json:"sk,omitempty"`
-	Name      string         `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Schema    string         `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
-	Initiator string         `protobuf:"bytes,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
-	Type      Thread_Type    `protobuf:"varint,7,opt,name=type,proto3,enum=Thread_Type" json:"type,omitempty"`
-	Sharing   Thread_Sharing `protobuf:"varint,8,opt,name=sharing,proto3,enum=Thread_Sharing" json:"sharing,omitempty"`
-	Whitelist []string       `protobuf:"bytes,9,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
-	State     Thread_State   `protobuf:"varint,10,opt,name=state,proto3,enum=Thread_State" json:"state,omitempty"` // Deprecated: Do not use.
-	Head      string         `protobuf:"bytes,11,opt,name=head,proto3" json:"head,omitempty"`
+	Id         string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Key        string         `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Sk         []byte         `protobuf:"bytes,3,opt,name=sk,proto3" json:"sk,omitempty"`
+	Name       string         `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Schema     string         `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	Initiator  string         `protobuf:"bytes,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Type       Thread_Type    `protobuf:"varint,7,opt,name=type,proto3,enum=Thread_Type" json:"type,omitempty"`
+	Sharing    Thread_Sharing `protobuf:"varint,8,opt,name=sharing,proto3,enum=Thread_Sharing" json:"sharing,omitempty"`
+	Whitelist  []string       `protobuf:"bytes,9,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	State      Thread_State   `protobuf:"varint,10,opt,name=state,proto3,enum=Thread_State" json:"state,omitempty"` // Deprecated: Do not use.
+	Head       string         `protobuf:"bytes,11,opt,name=head,proto3" json:"head,omitempty"`
+	ForkThread string         `protobuf:"bytes,12,opt,name=fork_thread,json=forkThread,proto3" json:"fork_thread,omitempty"`
+	ForkHead   string         `protobuf:"bytes,13,opt,name=fork_head,json=forkHead,proto3" json:"fork_head,omitempty"`
 	// view info
 	HeadBlocks           []*Block `protobuf:"bytes,101,rep,name=head_blocks,json=headBlocks,proto3" json:"head_blocks,omitempty"`
 	SchemaNode           *Node    `protobuf:"bytes,102,opt,name=schema_node,json=schemaNode,proto3" json:"schema_node,omitempty"`
@@ -645,7 +846,7 @@ func (m *Thread) Reset()         { *m = Thread{} }
 func (m *Thread) String() string { return proto.CompactTextString(m) }
 func (*Thread) ProtoMessage()    {}
 func (*Thread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{5}
+	return fileDescriptor_4c16552f9fdb66d8, []int{6}
 }
 
 func (m *Thread) XXX_Unmarshal(b []byte) error {
@@ -744,6 +945,20 @@ func (m *Thread) GetHead() string {
 	return ""
 }
 
+func (m *Thread) GetForkThread() string {
+	if m != nil {
+		return m.ForkThread
+	}
+	return ""
+}
+
+func (m *Thread) GetForkHead() string {
+	if m != nil {
+		return m.ForkHead
+	}
+	return ""
+}
+
 func (m *Thread) GetHeadBlocks() []*Block {
 	if m != nil {
 		return m.HeadBlocks
@@ -783,7 +998,7 @@ func (m *ThreadList) Reset()         { *m = ThreadList{} }
 func (m *ThreadList) String() string { return proto.CompactTextString(m) }
 func (*ThreadList) ProtoMessage()    {}
 func (*ThreadList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{6}
+	return fileDescriptor_4c16552f9fdb66d8, []int{7}
 }
 
 func (m *ThreadList) XXX_Unmarshal(b []byte) error {
@@ -824,7 +1039,7 @@ func (m *ThreadPeer) Reset()         { *m = ThreadPeer{} }
 func (m *ThreadPeer) String() string { return proto.CompactTextString(m) }
 func (*ThreadPeer) ProtoMessage()    {}
 func (*ThreadPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{7}
+	return fileDescriptor_4c16552f9fdb66d8, []int{8}
 }
 
 func (m *ThreadPeer) XXX_Unmarshal(b []byte) error {
@@ -878,6 +1093,8 @@ type Block struct {
 	Body     string               `protobuf:"bytes,8,opt,name=body,proto3" json:"body,omitempty"`
 	Status   Block_BlockStatus    `protobuf:"varint,10,opt,name=status,proto3,enum=Block_BlockStatus" json:"status,omitempty"`
 	Attempts int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Clock    int64                `protobuf:"varint,12,opt,name=clock,proto3" json:"clock,omitempty"`
+	Retry    *timestamp.Timestamp `protobuf:"bytes,13,opt,name=retry,proto3" json:"retry,omitempty"`
 	// view info
 	User                 *User    `protobuf:"bytes,101,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -889,7 +1106,7 @@ func (m *Block) Reset()         { *m = Block{} }
 func (m *Block) String() string { return proto.CompactTextString(m) }
 func (*Block) ProtoMessage()    {}
 func (*Block) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{8}
+	return fileDescriptor_4c16552f9fdb66d8, []int{9}
 }
 
 func (m *Block) XXX_Unmarshal(b []byte) error {
@@ -987,6 +1204,20 @@ func (m *Block) GetAttempts() int32 {
 	return 0
 }
 
+func (m *Block) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *Block) GetRetry() *timestamp.Timestamp {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 func (m *Block) GetUser() *User {
 	if m != nil {
 		return m.User
@@ -1005,7 +1236,7 @@ func (m *BlockList) Reset()         { *m = BlockList{} }
 func (m *BlockList) String() string { return proto.CompactTextString(m) }
 func (*BlockList) ProtoMessage()    {}
 func (*BlockList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{9}
+	return fileDescriptor_4c16552f9fdb66d8, []int{10}
 }
 
 func (m *BlockList) XXX_Unmarshal(b []byte) error {
@@ -1047,7 +1278,7 @@ func (m *BlockMessage) Reset()         { *m = BlockMessage{} }
 func (m *BlockMessage) String() string { return proto.CompactTextString(m) }
 func (*BlockMessage) ProtoMessage()    {}
 func (*BlockMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{10}
+	return fileDescriptor_4c16552f9fdb66d8, []int{11}
 }
 
 func (m *BlockMessage) XXX_Unmarshal(b []byte) error {
@@ -1112,7 +1343,7 @@ func (m *Invite) Reset()         { *m = Invite{} }
 func (m *Invite) String() string { return proto.CompactTextString(m) }
 func (*Invite) ProtoMessage()    {}
 func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{11}
+	return fileDescriptor_4c16552f9fdb66d8, []int{12}
 }
 
 func (m *Invite) XXX_Unmarshal(b []byte) error {
@@ -1186,7 +1417,7 @@ func (m *InviteList) Reset()         { *m = InviteList{} }
 func (m *InviteList) String() string { return proto.CompactTextString(m) }
 func (*InviteList) ProtoMessage()    {}
 func (*InviteList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{12}
+	return fileDescriptor_4c16552f9fdb66d8, []int{13}
 }
 
 func (m *InviteList) XXX_Unmarshal(b []byte) error {
@@ -1236,7 +1467,7 @@ func (m *FileIndex) Reset()         { *m = FileIndex{} }
 func (m *FileIndex) String() string { return proto.CompactTextString(m) }
 func (*FileIndex) ProtoMessage()    {}
 func (*FileIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{13}
+	return fileDescriptor_4c16552f9fdb66d8, []int{14}
 }
 
 func (m *FileIndex) XXX_Unmarshal(b []byte) error {
@@ -1358,7 +1589,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{14}
+	return fileDescriptor_4c16552f9fdb66d8, []int{15}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -1444,7 +1675,7 @@ func (m *Link) Reset()         { *m = Link{} }
 func (m *Link) String() string { return proto.CompactTextString(m) }
 func (*Link) ProtoMessage()    {}
 func (*Link) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{15}
+	return fileDescriptor_4c16552f9fdb66d8, []int{16}
 }
 
 func (m *Link) XXX_Unmarshal(b []byte) error {
@@ -1529,7 +1760,7 @@ func (m *Notification) Reset()         { *m = Notification{} }
 func (m *Notification) String() string { return proto.CompactTextString(m) }
 func (*Notification) ProtoMessage()    {}
 func (*Notification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{16}
+	return fileDescriptor_4c16552f9fdb66d8, []int{17}
 }
 
 func (m *Notification) XXX_Unmarshal(b []byte) error {
@@ -1638,7 +1869,7 @@ func (m *NotificationList) Reset()         { *m = NotificationList{} }
 func (m *NotificationList) String() string { return proto.CompactTextString(m) }
 func (*NotificationList) ProtoMessage()    {}
 func (*NotificationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{17}
+	return fileDescriptor_4c16552f9fdb66d8, []int{18}
 }
 
 func (m *NotificationList) XXX_Unmarshal(b []byte) error {
@@ -1673,6 +1904,7 @@ type Cafe struct {
 	Protocol             string   `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
 	Node                 string   `protobuf:"bytes,5,opt,name=node,proto3" json:"node,omitempty"`
 	Url                  string   `protobuf:"bytes,6,opt,name=url,proto3" json:"url,omitempty"`
+	Neighbors            []string `protobuf:"bytes,7,rep,name=neighbors,proto3" json:"neighbors,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1682,7 +1914,7 @@ func (m *Cafe) Reset()         { *m = Cafe{} }
 func (m *Cafe) String() string { return proto.CompactTextString(m) }
 func (*Cafe) ProtoMessage()    {}
 func (*Cafe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{18}
+	return fileDescriptor_4c16552f9fdb66d8, []int{19}
 }
 
 func (m *Cafe) XXX_Unmarshal(b []byte) error {
@@ -1745,6 +1977,13 @@ func (m *Cafe) GetUrl() string {
 	return ""
 }
 
+func (m *Cafe) GetNeighbors() []string {
+	if m != nil {
+		return m.Neighbors
+	}
+	return nil
+}
+
 type CafeSession struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Access               string               `protobuf:"bytes,2,opt,name=access,proto3" json:"access,omitempty"`
@@ -1763,7 +2002,7 @@ func (m *CafeSession) Reset()         { *m = CafeSession{} }
 func (m *CafeSession) String() string { return proto.CompactTextString(m) }
 func (*CafeSession) ProtoMessage()    {}
 func (*CafeSession) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{19}
+	return fileDescriptor_4c16552f9fdb66d8, []int{20}
 }
 
 func (m *CafeSession) XXX_Unmarshal(b []byte) error {
@@ -1851,7 +2090,7 @@ func (m *CafeSessionList) Reset()         { *m = CafeSessionList{} }
 func (m *CafeSessionList) String() string { return proto.CompactTextString(m) }
 func (*CafeSessionList) ProtoMessage()    {}
 func (*CafeSessionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{20}
+	return fileDescriptor_4c16552f9fdb66d8, []int{21}
 }
 
 func (m *CafeSessionList) XXX_Unmarshal(b []byte) error {
@@ -1893,6 +2132,7 @@ type CafeRequest struct {
 	Attempts             int32                `protobuf:"varint,11,opt,name=attempts,proto3" json:"attempts,omitempty"`
 	GroupSize            int64                `protobuf:"varint,12,opt,name=group_size,json=groupSize,proto3" json:"group_size,omitempty"`
 	GroupTransferred     int64                `protobuf:"varint,13,opt,name=group_transferred,json=groupTransferred,proto3" json:"group_transferred,omitempty"`
+	Retry                *timestamp.Timestamp `protobuf:"bytes,14,opt,name=retry,proto3" json:"retry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -1902,7 +2142,7 @@ func (m *CafeRequest) Reset()         { *m = CafeRequest{} }
 func (m *CafeRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeRequest) ProtoMessage()    {}
 func (*CafeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{21}
+	return fileDescriptor_4c16552f9fdb66d8, []int{22}
 }
 
 func (m *CafeRequest) XXX_Unmarshal(b []byte) error {
@@ -2014,6 +2254,13 @@ func (m *CafeRequest) GetGroupTransferred() int64 {
 	return 0
 }
 
+func (m *CafeRequest) GetRetry() *timestamp.Timestamp {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 type CafeRequestList struct {
 	Items                []*CafeRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
@@ -2025,7 +2272,7 @@ func (m *CafeRequestList) Reset()         { *m = CafeRequestList{} }
 func (m *CafeRequestList) String() string { return proto.CompactTextString(m) }
 func (*CafeRequestList) ProtoMessage()    {}
 func (*CafeRequestList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{22}
+	return fileDescriptor_4c16552f9fdb66d8, []int{23}
 }
 
 func (m *CafeRequestList) XXX_Unmarshal(b []byte) error {
@@ -2074,7 +2321,7 @@ func (m *CafeSyncGroupStatus) Reset()         { *m = CafeSyncGroupStatus{} }
 func (m *CafeSyncGroupStatus) String() string { return proto.CompactTextString(m) }
 func (*CafeSyncGroupStatus) ProtoMessage()    {}
 func (*CafeSyncGroupStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{23}
+	return fileDescriptor_4c16552f9fdb66d8, []int{24}
 }
 
 func (m *CafeSyncGroupStatus) XXX_Unmarshal(b []byte) error {
@@ -2186,7 +2433,7 @@ func (m *CafeHTTPRequest) Reset()         { *m = CafeHTTPRequest{} }
 func (m *CafeHTTPRequest) String() string { return proto.CompactTextString(m) }
 func (*CafeHTTPRequest) ProtoMessage()    {}
 func (*CafeHTTPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{24}
+	return fileDescriptor_4c16552f9fdb66d8, []int{25}
 }
 
 func (m *CafeHTTPRequest) XXX_Unmarshal(b []byte) error {
@@ -2240,6 +2487,7 @@ type CafeMessage struct {
 	Peer                 string               `protobuf:"bytes,2,opt,name=peer,proto3" json:"peer,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
 	Attempts             int32                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Retry                *timestamp.Timestamp `protobuf:"bytes,5,opt,name=retry,proto3" json:"retry,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2249,7 +2497,7 @@ func (m *CafeMessage) Reset()         { *m = CafeMessage{} }
 func (m *CafeMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeMessage) ProtoMessage()    {}
 func (*CafeMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{25}
+	return fileDescriptor_4c16552f9fdb66d8, []int{26}
 }
 
 func (m *CafeMessage) XXX_Unmarshal(b []byte) error {
@@ -2298,6 +2546,13 @@ func (m *CafeMessage) GetAttempts() int32 {
 	return 0
 }
 
+func (m *CafeMessage) GetRetry() *timestamp.Timestamp {
+	if m != nil {
+		return m.Retry
+	}
+	return nil
+}
+
 type CafeClientNonce struct {
 	Value                string               `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
@@ -2311,7 +2566,7 @@ func (m *CafeClientNonce) Reset()         { *m = CafeClientNonce{} }
 func (m *CafeClientNonce) String() string { return proto.CompactTextString(m) }
 func (*CafeClientNonce) ProtoMessage()    {}
 func (*CafeClientNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{26}
+	return fileDescriptor_4c16552f9fdb66d8, []int{27}
 }
 
 func (m *CafeClientNonce) XXX_Unmarshal(b []byte) error {
@@ -2354,21 +2609,26 @@ func (m *CafeClientNonce) GetDate() *timestamp.Timestamp {
 }
 
 type CafeClient struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Address              string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	Created              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
-	Seen                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
-	Token                string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Id            string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       string               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Created       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created,proto3" json:"created,omitempty"`
+	Seen          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=seen,proto3" json:"seen,omitempty"`
+	Token         string               `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Revoked       *timestamp.Timestamp `protobuf:"bytes,6,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	WebhookUrl    string               `protobuf:"bytes,7,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	WebhookSecret string               `protobuf:"bytes,8,opt,name=webhook_secret,json=webhookSecret,proto3" json:"webhook_secret,omitempty"`
+	// view info
+	Usage                *CafeClientUsage `protobuf:"bytes,101,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
 func (m *CafeClient) Reset()         { *m = CafeClient{} }
 func (m *CafeClient) String() string { return proto.CompactTextString(m) }
 func (*CafeClient) ProtoMessage()    {}
 func (*CafeClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{27}
+	return fileDescriptor_4c16552f9fdb66d8, []int{28}
 }
 
 func (m *CafeClient) XXX_Unmarshal(b []byte) error {
@@ -2424,6 +2684,34 @@ func (m *CafeClient) GetToken() string {
 	return ""
 }
 
+func (m *CafeClient) GetRevoked() *timestamp.Timestamp {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *CafeClient) GetWebhookUrl() string {
+	if m != nil {
+		return m.WebhookUrl
+	}
+	return ""
+}
+
+func (m *CafeClient) GetWebhookSecret() string {
+	if m != nil {
+		return m.WebhookSecret
+	}
+	return ""
+}
+
+func (m *CafeClient) GetUsage() *CafeClientUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
 type CafeClientList struct {
 	Items                []*CafeClient `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
@@ -2435,7 +2723,7 @@ func (m *CafeClientList) Reset()         { *m = CafeClientList{} }
 func (m *CafeClientList) String() string { return proto.CompactTextString(m) }
 func (*CafeClientList) ProtoMessage()    {}
 func (*CafeClientList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{28}
+	return fileDescriptor_4c16552f9fdb66d8, []int{29}
 }
 
 func (m *CafeClientList) XXX_Unmarshal(b []byte) error {
@@ -2467,6 +2755,7 @@ type CafeToken struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Value                []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	Date                 *timestamp.Timestamp `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	Disabled             bool                 `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -2476,7 +2765,7 @@ func (m *CafeToken) Reset()         { *m = CafeToken{} }
 func (m *CafeToken) String() string { return proto.CompactTextString(m) }
 func (*CafeToken) ProtoMessage()    {}
 func (*CafeToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{29}
+	return fileDescriptor_4c16552f9fdb66d8, []int{30}
 }
 
 func (m *CafeToken) XXX_Unmarshal(b []byte) error {
@@ -2518,6 +2807,13 @@ func (m *CafeToken) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+func (m *CafeToken) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 type CafeClientThread struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Client               string   `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
@@ -2531,7 +2827,7 @@ func (m *CafeClientThread) Reset()         { *m = CafeClientThread{} }
 func (m *CafeClientThread) String() string { return proto.CompactTextString(m) }
 func (*CafeClientThread) ProtoMessage()    {}
 func (*CafeClientThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{30}
+	return fileDescriptor_4c16552f9fdb66d8, []int{31}
 }
 
 func (m *CafeClientThread) XXX_Unmarshal(b []byte) error {
@@ -2587,7 +2883,7 @@ func (m *CafeClientMessage) Reset()         { *m = CafeClientMessage{} }
 func (m *CafeClientMessage) String() string { return proto.CompactTextString(m) }
 func (*CafeClientMessage) ProtoMessage()    {}
 func (*CafeClientMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{31}
+	return fileDescriptor_4c16552f9fdb66d8, []int{32}
 }
 
 func (m *CafeClientMessage) XXX_Unmarshal(b []byte) error {
@@ -2636,6 +2932,449 @@ func (m *CafeClientMessage) GetDate() *timestamp.Timestamp {
 	return nil
 }
 
+type CafeClientObject struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Client               string               `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Size                 int64                `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeClientObject) Reset()         { *m = CafeClientObject{} }
+func (m *CafeClientObject) String() string { return proto.CompactTextString(m) }
+func (*CafeClientObject) ProtoMessage()    {}
+func (*CafeClientObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{33}
+}
+
+func (m *CafeClientObject) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientObject.Unmarshal(m, b)
+}
+func (m *CafeClientObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientObject.Marshal(b, m, deterministic)
+}
+func (m *CafeClientObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientObject.Merge(m, src)
+}
+func (m *CafeClientObject) XXX_Size() int {
+	return xxx_messageInfo_CafeClientObject.Size(m)
+}
+func (m *CafeClientObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientObject proto.InternalMessageInfo
+
+func (m *CafeClientObject) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClientObject) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *CafeClientObject) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *CafeClientObject) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type CafeClientUsage struct {
+	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bytes   int64                `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Objects int64                `protobuf:"varint,3,opt,name=objects,proto3" json:"objects,omitempty"`
+	Updated *timestamp.Timestamp `protobuf:"bytes,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	// view info
+	ByteQuota            int64    `protobuf:"varint,101,opt,name=byte_quota,json=byteQuota,proto3" json:"byte_quota,omitempty"`
+	ObjectQuota          int64    `protobuf:"varint,102,opt,name=object_quota,json=objectQuota,proto3" json:"object_quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CafeClientUsage) Reset()         { *m = CafeClientUsage{} }
+func (m *CafeClientUsage) String() string { return proto.CompactTextString(m) }
+func (*CafeClientUsage) ProtoMessage()    {}
+func (*CafeClientUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{34}
+}
+
+func (m *CafeClientUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientUsage.Unmarshal(m, b)
+}
+func (m *CafeClientUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientUsage.Marshal(b, m, deterministic)
+}
+func (m *CafeClientUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientUsage.Merge(m, src)
+}
+func (m *CafeClientUsage) XXX_Size() int {
+	return xxx_messageInfo_CafeClientUsage.Size(m)
+}
+func (m *CafeClientUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientUsage proto.InternalMessageInfo
+
+func (m *CafeClientUsage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CafeClientUsage) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *CafeClientUsage) GetObjects() int64 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *CafeClientUsage) GetUpdated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *CafeClientUsage) GetByteQuota() int64 {
+	if m != nil {
+		return m.ByteQuota
+	}
+	return 0
+}
+
+func (m *CafeClientUsage) GetObjectQuota() int64 {
+	if m != nil {
+		return m.ObjectQuota
+	}
+	return 0
+}
+
+type CafeClientUsageList struct {
+	Items                []*CafeClientUsage `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *CafeClientUsageList) Reset()         { *m = CafeClientUsageList{} }
+func (m *CafeClientUsageList) String() string { return proto.CompactTextString(m) }
+func (*CafeClientUsageList) ProtoMessage()    {}
+func (*CafeClientUsageList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{35}
+}
+
+func (m *CafeClientUsageList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeClientUsageList.Unmarshal(m, b)
+}
+func (m *CafeClientUsageList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeClientUsageList.Marshal(b, m, deterministic)
+}
+func (m *CafeClientUsageList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeClientUsageList.Merge(m, src)
+}
+func (m *CafeClientUsageList) XXX_Size() int {
+	return xxx_messageInfo_CafeClientUsageList.Size(m)
+}
+func (m *CafeClientUsageList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeClientUsageList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeClientUsageList proto.InternalMessageInfo
+
+func (m *CafeClientUsageList) GetItems() []*CafeClientUsage {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ApiKey struct {
+	Id      string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value   []byte               `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Name    string               `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes  []ApiKey_Scope       `protobuf:"varint,4,rep,packed,name=scopes,proto3,enum=ApiKey_Scope" json:"scopes,omitempty"`
+	Threads []string             `protobuf:"bytes,5,rep,name=threads,proto3" json:"threads,omitempty"`
+	Created *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created,proto3" json:"created,omitempty"`
+	Expires *timestamp.Timestamp `protobuf:"bytes,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	Revoked *timestamp.Timestamp `protobuf:"bytes,8,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// view info
+	Key                  string   `protobuf:"bytes,101,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{36}
+}
+
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (m *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(m, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiKey) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *ApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKey) GetScopes() []ApiKey_Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ApiKey) GetThreads() []string {
+	if m != nil {
+		return m.Threads
+	}
+	return nil
+}
+
+func (m *ApiKey) GetCreated() *timestamp.Timestamp {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *ApiKey) GetExpires() *timestamp.Timestamp {
+	if m != nil {
+		return m.Expires
+	}
+	return nil
+}
+
+func (m *ApiKey) GetRevoked() *timestamp.Timestamp {
+	if m != nil {
+		return m.Revoked
+	}
+	return nil
+}
+
+func (m *ApiKey) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ApiKeyList struct {
+	Items                []*ApiKey `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ApiKeyList) Reset()         { *m = ApiKeyList{} }
+func (m *ApiKeyList) String() string { return proto.CompactTextString(m) }
+func (*ApiKeyList) ProtoMessage()    {}
+func (*ApiKeyList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{37}
+}
+
+func (m *ApiKeyList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKeyList.Unmarshal(m, b)
+}
+func (m *ApiKeyList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKeyList.Marshal(b, m, deterministic)
+}
+func (m *ApiKeyList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKeyList.Merge(m, src)
+}
+func (m *ApiKeyList) XXX_Size() int {
+	return xxx_messageInfo_ApiKeyList.Size(m)
+}
+func (m *ApiKeyList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKeyList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKeyList proto.InternalMessageInfo
+
+func (m *ApiKeyList) GetItems() []*ApiKey {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type DeadLetter struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Queue                DeadLetter_Queue     `protobuf:"varint,2,opt,name=queue,proto3,enum=DeadLetter_Queue" json:"queue,omitempty"`
+	Item                 *any.Any             `protobuf:"bytes,3,opt,name=item,proto3" json:"item,omitempty"`
+	Attempts             int32                `protobuf:"varint,4,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	Error                string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DeadLetter) Reset()         { *m = DeadLetter{} }
+func (m *DeadLetter) String() string { return proto.CompactTextString(m) }
+func (*DeadLetter) ProtoMessage()    {}
+func (*DeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{38}
+}
+
+func (m *DeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetter.Unmarshal(m, b)
+}
+func (m *DeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetter.Marshal(b, m, deterministic)
+}
+func (m *DeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetter.Merge(m, src)
+}
+func (m *DeadLetter) XXX_Size() int {
+	return xxx_messageInfo_DeadLetter.Size(m)
+}
+func (m *DeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetter proto.InternalMessageInfo
+
+func (m *DeadLetter) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetQueue() DeadLetter_Queue {
+	if m != nil {
+		return m.Queue
+	}
+	return DeadLetter_BLOCK_DOWNLOADS
+}
+
+func (m *DeadLetter) GetItem() *any.Any {
+	if m != nil {
+		return m.Item
+	}
+	return nil
+}
+
+func (m *DeadLetter) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *DeadLetter) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeadLetter) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+type DeadLetterList struct {
+	Items                []*DeadLetter `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DeadLetterList) Reset()         { *m = DeadLetterList{} }
+func (m *DeadLetterList) String() string { return proto.CompactTextString(m) }
+func (*DeadLetterList) ProtoMessage()    {}
+func (*DeadLetterList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c16552f9fdb66d8, []int{39}
+}
+
+func (m *DeadLetterList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeadLetterList.Unmarshal(m, b)
+}
+func (m *DeadLetterList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeadLetterList.Marshal(b, m, deterministic)
+}
+func (m *DeadLetterList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeadLetterList.Merge(m, src)
+}
+func (m *DeadLetterList) XXX_Size() int {
+	return xxx_messageInfo_DeadLetterList.Size(m)
+}
+func (m *DeadLetterList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeadLetterList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeadLetterList proto.InternalMessageInfo
+
+func (m *DeadLetterList) GetItems() []*DeadLetter {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
 // Bots KV Store //
 type BotKV struct {
 	Key                  string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
@@ -2651,7 +3390,7 @@ func (m *BotKV) Reset()         { *m = BotKV{} }
 func (m *BotKV) String() string { return proto.CompactTextString(m) }
 func (*BotKV) ProtoMessage()    {}
 func (*BotKV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4c16552f9fdb66d8, []int{32}
+	return fileDescriptor_4c16552f9fdb66d8, []int{40}
 }
 
 func (m *BotKV) XXX_Unmarshal(b []byte) error {
@@ -2701,17 +3440,22 @@ func (m *BotKV) GetUpdated() *timestamp.Timestamp {
 }
 
 func init() {
+	proto.RegisterEnum("PeerReachability_Path", PeerReachability_Path_name, PeerReachability_Path_value)
 	proto.RegisterEnum("Thread_Type", Thread_Type_name, Thread_Type_value)
 	proto.RegisterEnum("Thread_Sharing", Thread_Sharing_name, Thread_Sharing_value)
 	proto.RegisterEnum("Thread_State", Thread_State_name, Thread_State_value)
 	proto.RegisterEnum("Block_BlockType", Block_BlockType_name, Block_BlockType_value)
 	proto.RegisterEnum("Block_BlockStatus", Block_BlockStatus_name, Block_BlockStatus_value)
+	proto.RegisterEnum("Block_BlockSort", Block_BlockSort_name, Block_BlockSort_value)
 	proto.RegisterEnum("Notification_Type", Notification_Type_name, Notification_Type_value)
 	proto.RegisterEnum("CafeRequest_Type", CafeRequest_Type_name, CafeRequest_Type_value)
 	proto.RegisterEnum("CafeRequest_Status", CafeRequest_Status_name, CafeRequest_Status_value)
 	proto.RegisterEnum("CafeHTTPRequest_Type", CafeHTTPRequest_Type_name, CafeHTTPRequest_Type_value)
+	proto.RegisterEnum("ApiKey_Scope", ApiKey_Scope_name, ApiKey_Scope_value)
+	proto.RegisterEnum("DeadLetter_Queue", DeadLetter_Queue_name, DeadLetter_Queue_value)
 	proto.RegisterType((*Peer)(nil), "Peer")
 	proto.RegisterType((*PeerList)(nil), "PeerList")
+	proto.RegisterType((*PeerReachability)(nil), "PeerReachability")
 	proto.RegisterType((*User)(nil), "User")
 	proto.RegisterType((*Contact)(nil), "Contact")
 	proto.RegisterType((*ContactList)(nil), "ContactList")
@@ -2746,160 +3490,206 @@ func init() {
 	proto.RegisterType((*CafeToken)(nil), "CafeToken")
 	proto.RegisterType((*CafeClientThread)(nil), "CafeClientThread")
 	proto.RegisterType((*CafeClientMessage)(nil), "CafeClientMessage")
+	proto.RegisterType((*CafeClientObject)(nil), "CafeClientObject")
+	proto.RegisterType((*CafeClientUsage)(nil), "CafeClientUsage")
+	proto.RegisterType((*CafeClientUsageList)(nil), "CafeClientUsageList")
+	proto.RegisterType((*ApiKey)(nil), "ApiKey")
+	proto.RegisterType((*ApiKeyList)(nil), "ApiKeyList")
+	proto.RegisterType((*DeadLetter)(nil), "DeadLetter")
+	proto.RegisterType((*DeadLetterList)(nil), "DeadLetterList")
 	proto.RegisterType((*BotKV)(nil), "BotKV")
 }
 
 func init() { proto.RegisterFile("model.proto", fileDescriptor_4c16552f9fdb66d8) }
 
 var fileDescriptor_4c16552f9fdb66d8 = []byte{
-	// 2375 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4f, 0x73, 0xdb, 0xc6,
-	0x15, 0x17, 0x48, 0x80, 0x7f, 0x1e, 0x29, 0x0b, 0x5e, 0x3b, 0x0e, 0x2c, 0xdb, 0xb1, 0x03, 0x37,
-	0xfe, 0x13, 0xa7, 0x4c, 0x2a, 0xb7, 0xb5, 0x27, 0x97, 0x0e, 0x45, 0xc1, 0x32, 0x1b, 0x8a, 0x54,
-	0x41, 0xca, 0x4d, 0x72, 0xe1, 0x40, 0xc0, 0x4a, 0x44, 0x44, 0x02, 0x0c, 0x00, 0x3a, 0x56, 0x66,
-	0x3a, 0xb9, 0x75, 0x7a, 0xe8, 0x07, 0xe8, 0x4c, 0xfb, 0x15, 0x7a, 0xe9, 0x67, 0xe8, 0xf7, 0xe8,
-	0xb5, 0x3d, 0xf5, 0xd2, 0xe9, 0xa9, 0xd3, 0xe9, 0xbc, 0xb7, 0xbb, 0x24, 0x68, 0xc9, 0x8a, 0x94,
-	0x71, 0x2f, 0xd2, 0xbe, 0x3f, 0xbb, 0xef, 0xed, 0xdb, 0xdf, 0xfb, 0x03, 0x42, 0x6d, 0x12, 0x07,
-	0x7c, 0xdc, 0x98, 0x26, 0x71, 0x16, 0xaf, 0xdf, 0x3e, 0x8c, 0xe3, 0xc3, 0x31, 0xff, 0x98, 0xa8,
-	0xfd, 0xd9, 0xc1, 0xc7, 0x59, 0x38, 0xe1, 0x69, 0xe6, 0x4d, 0xa6, 0x52, 0xe1, 0xe6, 0xeb, 0x0a,
-	0x69, 0x96, 0xcc, 0xfc, 0x4c, 0x4a, 0x57, 0x27, 0x3c, 0x4d, 0xbd, 0x43, 0x2e, 0x48, 0xfb, 0x1f,
-	0x1a, 0xe8, 0xbb, 0x9c, 0x27, 0xec, 0x12, 0x14, 0xc2, 0xc0, 0xd2, 0xee, 0x68, 0x0f, 0xaa, 0x6e,
-	0x21, 0x0c, 0x98, 0x05, 0x65, 0x2f, 0x08, 0x12, 0x9e, 0xa6, 0x56, 0x81, 0x98, 0x8a, 0x64, 0x0c,
-	0xf4, 0xc8, 0x9b, 0x70, 0xab, 0x48, 0x6c, 0x5a, 0xb3, 0x6b, 0x50, 0xf2, 0x5e, 0x7a, 0x99, 0x97,
-	0x58, 0x3a, 0x71, 0x25, 0xc5, 0x6e, 0x43, 0x39, 0x8c, 0xf6, 0xe3, 0x57, 0x3c, 0xb5, 0x8c, 0x3b,
-	0xc5, 0x07, 0xb5, 0x0d, 0xa3, 0xd1, 0xf2, 0x0e, 0xb8, 0xab, 0xb8, 0xec, 0xa7, 0x50, 0xf6, 0x13,
-	0xee, 0x65, 0x3c, 0xb0, 0x4a, 0x77, 0xb4, 0x07, 0xb5, 0x8d, 0xf5, 0x86, 0x70, 0xbf, 0xa1, 0xdc,
-	0x6f, 0x0c, 0xd4, 0xfd, 0x5c, 0xa5, 0x8a, 0xbb, 0x66, 0xd3, 0x80, 0x76, 0x95, 0xbf, 0x7f, 0x97,
-	0x54, 0xb5, 0xef, 0x43, 0x05, 0xaf, 0xda, 0x09, 0xd3, 0x8c, 0xdd, 0x00, 0x23, 0xcc, 0xf8, 0x24,
-	0xb5, 0x34, 0xe9, 0x16, 0x4a, 0x5c, 0xc1, 0xb3, 0x3b, 0xa0, 0xef, 0xa5, 0x3c, 0xc9, 0xc7, 0x40,
-	0x3b, 0x3d, 0x06, 0x85, 0x53, 0x63, 0x50, 0xcc, 0xc7, 0xc0, 0xfe, 0xad, 0x06, 0xe5, 0x56, 0x1c,
-	0x65, 0x9e, 0x9f, 0xbd, 0x9d, 0x13, 0xd1, 0xf9, 0x29, 0xe7, 0x49, 0x6a, 0xe9, 0x4b, 0xce, 0x13,
-	0x0f, 0x4d, 0x64, 0xa3, 0x84, 0x7b, 0x81, 0x08, 0x79, 0xd5, 0x55, 0xa4, 0xfd, 0x63, 0xa8, 0x49,
-	0x3f, 0x28, 0x04, 0xef, 0x2d, 0x87, 0xa0, 0xd2, 0x90, 0x42, 0x15, 0x85, 0xbf, 0xeb, 0x50, 0x1a,
-	0xd0, 0xd6, 0x13, 0xe0, 0x30, 0xa1, 0x78, 0xc4, 0x8f, 0xa5, 0xaf, 0xb8, 0x44, 0x8d, 0xf4, 0x88,
-	0xdc, 0xac, 0xbb, 0x85, 0xf4, 0x68, 0x7e, 0x1d, 0x7d, 0xf9, 0x3a, 0xa9, 0x3f, 0xe2, 0x13, 0xcf,
-	0x32, 0xc4, 0x75, 0x04, 0xc5, 0x6e, 0x42, 0x35, 0x8c, 0xc2, 0x2c, 0xf4, 0xb2, 0x38, 0x21, 0x14,
-	0x54, 0xdd, 0x05, 0x83, 0xdd, 0x01, 0x3d, 0x3b, 0x9e, 0x72, 0x7a, 0xe8, 0x4b, 0x1b, 0xf5, 0x86,
-	0x70, 0xa9, 0x31, 0x38, 0x9e, 0x72, 0x97, 0x24, 0xec, 0x21, 0x94, 0xd3, 0x91, 0x97, 0x84, 0xd1,
-	0xa1, 0x55, 0x21, 0xa5, 0x35, 0xa5, 0xd4, 0x17, 0x6c, 0x57, 0xc9, 0xd1, 0xd4, 0x37, 0xa3, 0x30,
-	0xe3, 0xe3, 0x30, 0xcd, 0xac, 0x2a, 0x85, 0x67, 0xc1, 0x60, 0xf7, 0xc1, 0x48, 0x33, 0x2f, 0xe3,
-	0x16, 0xd0, 0x31, 0xab, 0xf3, 0x63, 0x90, 0xb9, 0x59, 0xb0, 0x34, 0x57, 0xc8, 0xf1, 0x76, 0x23,
-	0xee, 0x05, 0x56, 0x4d, 0xdc, 0x0e, 0xd7, 0xec, 0x3e, 0xd4, 0xf0, 0xff, 0x70, 0x7f, 0x1c, 0xfb,
-	0x47, 0xa9, 0xc5, 0x29, 0xa8, 0xa5, 0xc6, 0x26, 0x92, 0x2e, 0xa0, 0x88, 0x96, 0x29, 0xbb, 0x07,
-	0x35, 0x71, 0xf1, 0x61, 0x14, 0x07, 0xdc, 0x3a, 0x20, 0x00, 0x1b, 0x8d, 0x6e, 0x1c, 0x70, 0x17,
-	0x84, 0x04, 0xd7, 0xec, 0x36, 0xd4, 0xe8, 0xac, 0xa1, 0x1f, 0xcf, 0xa2, 0xcc, 0x3a, 0xbc, 0xa3,
-	0x3d, 0x30, 0x5c, 0x20, 0x56, 0x0b, 0x39, 0xec, 0x16, 0x00, 0x3e, 0xb9, 0x94, 0x8f, 0x48, 0x5e,
-	0x45, 0x0e, 0x89, 0xed, 0xa7, 0xa0, 0x63, 0x90, 0x58, 0x0d, 0xca, 0xbb, 0x6e, 0xfb, 0x45, 0x73,
-	0xe0, 0x98, 0x2b, 0x6c, 0x15, 0xaa, 0xae, 0xd3, 0xdc, 0x1a, 0xf6, 0xba, 0x9d, 0x2f, 0x4c, 0x8d,
-	0x01, 0x94, 0x76, 0xf7, 0x36, 0x3b, 0xed, 0x96, 0x59, 0x60, 0x15, 0xd0, 0x7b, 0xbb, 0x4e, 0xd7,
-	0x2c, 0xda, 0x3f, 0x87, 0xb2, 0x8c, 0x1c, 0xbb, 0x04, 0xd0, 0xed, 0x0d, 0x86, 0xfd, 0xe7, 0x4d,
-	0xd7, 0xd9, 0x32, 0x57, 0xd8, 0x1a, 0xd4, 0xda, 0xdd, 0x17, 0xed, 0x81, 0x93, 0x3b, 0x41, 0x0a,
-	0x0b, 0xf6, 0x13, 0x30, 0x28, 0x54, 0xcc, 0x84, 0x7a, 0xa7, 0xd7, 0xdc, 0x6a, 0x77, 0xb7, 0x87,
-	0x83, 0x66, 0xbb, 0x63, 0xae, 0xa0, 0x1a, 0x72, 0x9c, 0x2d, 0x53, 0xcb, 0x4b, 0x9f, 0x3b, 0x4d,
-	0xdc, 0xf8, 0x08, 0x40, 0x84, 0x9a, 0x80, 0x79, 0x6b, 0x19, 0x98, 0x65, 0xf9, 0x0c, 0x0a, 0x97,
-	0xbb, 0x4a, 0xf9, 0xd4, 0xba, 0x75, 0x0d, 0x4a, 0x02, 0xef, 0x12, 0x9d, 0x92, 0x62, 0xeb, 0x50,
-	0xf9, 0x86, 0x8f, 0xfd, 0x78, 0xc2, 0x03, 0x82, 0x69, 0xc5, 0x9d, 0xd3, 0xf6, 0x9f, 0x74, 0x30,
-	0xe8, 0x71, 0xce, 0x7d, 0x1a, 0x66, 0xe6, 0x2c, 0x1b, 0xc5, 0x8b, 0xcc, 0x24, 0x8a, 0xfd, 0x48,
-	0x82, 0x55, 0x27, 0x00, 0x99, 0xe2, 0xf5, 0xc5, 0xdf, 0x1c, 0x60, 0x1b, 0xa0, 0x63, 0x45, 0xa2,
-	0x34, 0x38, 0xbb, 0x76, 0x91, 0x1e, 0xa6, 0xf4, 0xd4, 0x4b, 0x78, 0x94, 0xa5, 0x56, 0x49, 0xa4,
-	0xb4, 0x24, 0xc9, 0x3f, 0x2f, 0x39, 0xe4, 0x19, 0xa5, 0x07, 0xfa, 0x47, 0x14, 0x02, 0x34, 0xf0,
-	0x32, 0xcf, 0xaa, 0x0a, 0x80, 0xe2, 0x1a, 0x79, 0xfb, 0x71, 0x70, 0x4c, 0x39, 0x52, 0x75, 0x69,
-	0xcd, 0x3e, 0x84, 0x12, 0x22, 0x7a, 0x96, 0x4a, 0xc8, 0xb3, 0xbc, 0xc7, 0x7d, 0x92, 0xb8, 0x52,
-	0x03, 0x23, 0xe8, 0x65, 0x19, 0x9f, 0x4c, 0xb3, 0x94, 0x80, 0x6f, 0xb8, 0x73, 0x9a, 0x5d, 0x07,
-	0x7d, 0x96, 0xf2, 0xc4, 0xe2, 0x12, 0xcc, 0x58, 0x3e, 0x5d, 0x62, 0xd9, 0xbf, 0xd7, 0xa0, 0x3a,
-	0x0f, 0x00, 0x5b, 0x05, 0x63, 0xc7, 0x71, 0xb7, 0x1d, 0x73, 0x65, 0xbd, 0x50, 0x21, 0xf4, 0xb4,
-	0xb7, 0xbb, 0x3d, 0xd7, 0x31, 0x35, 0xc4, 0xdf, 0xb3, 0x4e, 0x73, 0x5b, 0x20, 0xf1, 0x97, 0xbd,
-	0x76, 0xd7, 0x2c, 0xb2, 0x3a, 0x54, 0x9a, 0xdd, 0x6e, 0x6f, 0xaf, 0xdb, 0x72, 0x4c, 0x9d, 0x55,
-	0xc1, 0xe8, 0x38, 0xcd, 0x17, 0x8e, 0x69, 0xa0, 0xca, 0xc0, 0xf9, 0x7c, 0x60, 0x96, 0x90, 0xf9,
-	0xac, 0xdd, 0x71, 0xfa, 0x66, 0x99, 0xad, 0x41, 0xb9, 0xd5, 0xdb, 0xd9, 0x71, 0xba, 0x03, 0xb3,
-	0x42, 0xc7, 0x57, 0x40, 0xef, 0xb4, 0x3f, 0x73, 0xcc, 0x2a, 0x2b, 0x43, 0xb1, 0xb9, 0xb5, 0x65,
-	0x6e, 0xd8, 0x3f, 0x81, 0x5a, 0xee, 0x72, 0xb8, 0x1b, 0xf3, 0xe1, 0x0b, 0x01, 0xd1, 0x5f, 0xed,
-	0x39, 0x7b, 0x04, 0x51, 0xcc, 0x19, 0xa7, 0x8b, 0x10, 0x35, 0x0b, 0xf6, 0x43, 0x79, 0x01, 0x02,
-	0xe7, 0xcd, 0x65, 0x70, 0xaa, 0x04, 0x97, 0xd8, 0xfc, 0x0e, 0xea, 0x44, 0xef, 0x88, 0x26, 0x7b,
-	0x02, 0x4f, 0x0c, 0x74, 0x4c, 0x50, 0x55, 0xe5, 0x71, 0xcd, 0x6e, 0x40, 0x91, 0x47, 0x2f, 0x09,
-	0x48, 0xb5, 0x8d, 0x6a, 0xc3, 0x89, 0x5e, 0xf2, 0x71, 0x3c, 0xe5, 0x2e, 0x72, 0xe7, 0x50, 0xd1,
-	0xcf, 0x07, 0x15, 0xfb, 0xcf, 0x1a, 0x94, 0xda, 0xd1, 0xcb, 0x30, 0x3b, 0x69, 0xfb, 0x2a, 0x18,
-	0x54, 0x3c, 0xc8, 0x78, 0xdd, 0x15, 0xc4, 0xa9, 0xdd, 0x9c, 0xba, 0x36, 0x9e, 0x91, 0x48, 0xbb,
-	0xb2, 0xc3, 0x28, 0xee, 0xdb, 0x03, 0x30, 0x66, 0xbe, 0x70, 0xf7, 0xf4, 0xcc, 0x17, 0x32, 0x15,
-	0xdd, 0xbf, 0x16, 0xa0, 0xfa, 0x2c, 0x1c, 0xf3, 0x76, 0x14, 0xf0, 0x57, 0xe8, 0xf9, 0x24, 0x1c,
-	0x8f, 0xe5, 0x0d, 0x69, 0x8d, 0x18, 0xf5, 0x47, 0xdc, 0x3f, 0x4a, 0x67, 0x13, 0x19, 0xe3, 0x39,
-	0x4d, 0xed, 0x27, 0x9e, 0x25, 0xbe, 0xba, 0xab, 0xa4, 0xf0, 0x9c, 0x18, 0x31, 0x2d, 0x5b, 0x15,
-	0xae, 0xa9, 0xc0, 0x7b, 0xe9, 0x48, 0x36, 0x2a, 0x5a, 0xab, 0xa6, 0x57, 0x5a, 0x34, 0xbd, 0xab,
-	0x60, 0x4c, 0x78, 0x10, 0x7a, 0x32, 0xf9, 0x04, 0x31, 0x8f, 0x68, 0x25, 0x17, 0x51, 0x06, 0x7a,
-	0x1a, 0x7e, 0xcb, 0x29, 0x1f, 0x8b, 0x2e, 0xad, 0xd9, 0x27, 0x60, 0x78, 0x41, 0xc0, 0x03, 0x4a,
-	0xbd, 0xb3, 0xa3, 0x28, 0x14, 0xd9, 0x23, 0xd0, 0x27, 0x3c, 0xf3, 0x28, 0xfb, 0x6a, 0x1b, 0xef,
-	0x9e, 0xd8, 0xd0, 0xa7, 0x41, 0xcf, 0x25, 0x25, 0x9a, 0x03, 0xa8, 0x18, 0xa4, 0x56, 0x5d, 0xce,
-	0x01, 0x82, 0xb4, 0xff, 0x56, 0x00, 0x9d, 0x3a, 0x8c, 0xf2, 0x54, 0xcb, 0x79, 0x6a, 0x42, 0x71,
-	0x1a, 0x46, 0x14, 0xbc, 0x8a, 0x8b, 0x4b, 0xec, 0x99, 0xd3, 0xb1, 0x17, 0x46, 0x19, 0x7f, 0x95,
-	0xc9, 0xd2, 0xb9, 0x60, 0xcc, 0x5f, 0x41, 0xcf, 0xbd, 0xc2, 0x5d, 0x19, 0x51, 0x31, 0xf2, 0xad,
-	0x51, 0x6b, 0x6b, 0xf4, 0xa6, 0x59, 0xea, 0x44, 0x59, 0x72, 0x2c, 0x43, 0xfc, 0x14, 0x6a, 0x5f,
-	0xa5, 0x71, 0x34, 0x94, 0x23, 0x41, 0xe9, 0xec, 0x3b, 0x01, 0xea, 0xf6, 0xc5, 0xbc, 0x70, 0x0f,
-	0x8c, 0x71, 0x18, 0x1d, 0xa5, 0x56, 0x85, 0xce, 0x37, 0xc5, 0xf9, 0x1d, 0x64, 0x09, 0x03, 0x42,
-	0xbc, 0xfe, 0x04, 0xaa, 0x73, 0xa3, 0xea, 0xf5, 0xb4, 0xa5, 0xd7, 0x7b, 0xe9, 0x8d, 0x67, 0x6a,
-	0xe4, 0x12, 0xc4, 0xa7, 0x85, 0xa7, 0xda, 0xfa, 0x2f, 0x00, 0x16, 0xa7, 0x9d, 0xb2, 0xf3, 0x46,
-	0x7e, 0x27, 0x66, 0x07, 0x6a, 0xe7, 0x0e, 0xb0, 0xff, 0xa5, 0x81, 0x8e, 0x3c, 0xdc, 0x3b, 0x4b,
-	0x55, 0x80, 0x71, 0xf9, 0x7f, 0x89, 0x2f, 0x9a, 0x7a, 0x7b, 0xf1, 0xfd, 0xc1, 0x71, 0xb3, 0xff,
-	0x59, 0x84, 0x7a, 0x37, 0xce, 0xc2, 0x83, 0xd0, 0xf7, 0xb2, 0x30, 0x8e, 0x4e, 0x94, 0x20, 0x55,
-	0x37, 0x0a, 0xe7, 0xac, 0x1b, 0x57, 0xc1, 0xf0, 0xfc, 0x6c, 0xde, 0x65, 0x05, 0x81, 0xc8, 0x4e,
-	0x67, 0xfb, 0x5f, 0x71, 0x3f, 0x93, 0x51, 0x51, 0x24, 0x7b, 0x1f, 0xea, 0x72, 0x39, 0x0c, 0x78,
-	0xea, 0xcb, 0xf4, 0xad, 0x49, 0xde, 0x16, 0x4f, 0xfd, 0x45, 0x15, 0x14, 0x79, 0x2c, 0xab, 0xe0,
-	0x9b, 0xfa, 0xe8, 0x3d, 0xd9, 0xcf, 0x2b, 0xb2, 0x3b, 0xe6, 0x6f, 0x97, 0x1f, 0x41, 0x55, 0x6f,
-	0xad, 0xe6, 0x7a, 0x2b, 0x03, 0x9d, 0x26, 0x07, 0xa0, 0x27, 0xa5, 0xf5, 0x59, 0x7d, 0xf2, 0x2f,
-	0x9a, 0x9c, 0xd7, 0xae, 0xc0, 0x9a, 0x1c, 0xb1, 0x5c, 0xa7, 0xe5, 0xb4, 0x5f, 0xd0, 0xdc, 0xf5,
-	0x2e, 0x5c, 0x69, 0xb6, 0x5a, 0xbd, 0xbd, 0xee, 0x60, 0xb8, 0xeb, 0x38, 0xee, 0x10, 0xfb, 0x23,
-	0x75, 0xaa, 0x77, 0xe0, 0xf2, 0x92, 0xa0, 0xe3, 0x3c, 0x1b, 0x98, 0x15, 0x9c, 0xd3, 0xf2, 0x7a,
-	0x05, 0x1c, 0xfc, 0x16, 0xf2, 0x22, 0xbb, 0x0c, 0xab, 0x3b, 0x4e, 0xbf, 0xdf, 0xdc, 0x76, 0x86,
-	0xcd, 0x2d, 0x1c, 0xcb, 0x74, 0xdc, 0x42, 0x8d, 0x54, 0x32, 0x0c, 0xd4, 0x91, 0xed, 0x54, 0xb2,
-	0x4a, 0x38, 0x0e, 0x62, 0x43, 0x95, 0x74, 0xd9, 0x7e, 0x02, 0x66, 0x3e, 0x24, 0x54, 0xc4, 0xef,
-	0x2e, 0x17, 0xf1, 0xd5, 0xa5, 0xa0, 0xa9, 0x52, 0xfe, 0x3b, 0x0d, 0x74, 0xfc, 0x12, 0x9c, 0x77,
-	0x44, 0x2d, 0xd7, 0x11, 0xdf, 0xfc, 0xed, 0x69, 0x42, 0xd1, 0x9b, 0x86, 0x12, 0x0e, 0xb8, 0xc4,
-	0x8a, 0x4f, 0xf0, 0xf1, 0x63, 0x95, 0x23, 0x73, 0x9a, 0xea, 0x1b, 0x8e, 0xd8, 0xb2, 0x8a, 0xe3,
-	0x9a, 0x32, 0x32, 0x19, 0xab, 0x2a, 0x3e, 0x4b, 0xc6, 0xf6, 0xbf, 0x35, 0xa8, 0xa1, 0x2b, 0x7d,
-	0x9e, 0xa6, 0xa7, 0x81, 0x16, 0x67, 0x3d, 0xdf, 0x5f, 0x38, 0x23, 0x29, 0xf6, 0x11, 0x14, 0xf9,
-	0xab, 0xa9, 0xec, 0xdb, 0x67, 0x61, 0x19, 0xd5, 0xf0, 0x4e, 0x09, 0x3f, 0x48, 0x78, 0x3a, 0x52,
-	0xa0, 0x95, 0x24, 0x26, 0x45, 0x82, 0x07, 0x9d, 0xa3, 0x99, 0x26, 0xf2, 0x24, 0x05, 0xff, 0xd2,
-	0x32, 0xfc, 0x59, 0xee, 0x53, 0xa9, 0x2a, 0x91, 0x79, 0x1d, 0x74, 0xdf, 0x3b, 0x10, 0x08, 0x9e,
-	0x7f, 0x7e, 0x13, 0xcb, 0xfe, 0x19, 0xac, 0xe5, 0xee, 0x4d, 0x6f, 0x67, 0x2f, 0xbf, 0x5d, 0xbd,
-	0x91, 0x53, 0x50, 0x4f, 0xf7, 0x07, 0x5d, 0xc4, 0xcb, 0xe5, 0x5f, 0xcf, 0x78, 0x9a, 0x9d, 0x6b,
-	0xc6, 0x59, 0xe4, 0x57, 0x71, 0x29, 0xbf, 0x94, 0x77, 0xfa, 0x09, 0xef, 0x30, 0x51, 0x0f, 0x93,
-	0x78, 0x36, 0x95, 0x7d, 0x54, 0x10, 0xf8, 0xcd, 0x93, 0x1e, 0x47, 0xfe, 0x50, 0x88, 0x40, 0x7c,
-	0x2c, 0x22, 0x67, 0x9b, 0xc4, 0x1f, 0xc8, 0x08, 0x18, 0x94, 0xaf, 0x97, 0x1b, 0x39, 0x3f, 0x1b,
-	0xa7, 0x0c, 0xe0, 0xa5, 0x73, 0xd6, 0x21, 0xd5, 0xbe, 0xcb, 0xb9, 0xf6, 0xfd, 0x68, 0x3e, 0x3a,
-	0x57, 0xc9, 0xd8, 0x95, 0x25, 0x63, 0x17, 0x98, 0x9d, 0x6f, 0x01, 0xd0, 0x6d, 0x86, 0x64, 0xa2,
-	0x4e, 0x26, 0xaa, 0xc4, 0xe9, 0x0b, 0x3b, 0x97, 0x85, 0x38, 0x4b, 0xbc, 0x28, 0x3d, 0xe0, 0x49,
-	0xc2, 0x03, 0x6b, 0x95, 0xb4, 0x4c, 0x12, 0x0c, 0x16, 0x7c, 0xbb, 0x27, 0x6b, 0x48, 0x15, 0x8c,
-	0xfe, 0x00, 0xc7, 0xea, 0x15, 0x1c, 0x65, 0xf7, 0xba, 0x82, 0x28, 0xe2, 0xa7, 0x17, 0x2d, 0x87,
-	0x83, 0xe7, 0x38, 0xf6, 0x9a, 0x1a, 0x63, 0x70, 0x49, 0x8a, 0x15, 0x8f, 0xe6, 0xec, 0x76, 0x77,
-	0xb3, 0xf7, 0xb9, 0x59, 0xb0, 0x3f, 0x82, 0x92, 0x9c, 0x94, 0xcb, 0x50, 0xec, 0x3a, 0xbf, 0x16,
-	0x07, 0xaa, 0xd9, 0x58, 0xc3, 0x01, 0xbd, 0xd5, 0xdb, 0xd9, 0xed, 0x38, 0x03, 0xc7, 0x2c, 0x28,
-	0x44, 0xc9, 0x20, 0xbc, 0x19, 0x51, 0x52, 0x41, 0x21, 0xea, 0x3f, 0x05, 0xb8, 0x42, 0x40, 0x53,
-	0xef, 0x28, 0x4d, 0xbe, 0x8e, 0xac, 0x1b, 0x50, 0x8d, 0x66, 0x93, 0x61, 0x16, 0x67, 0xde, 0x98,
-	0xe0, 0x65, 0xb8, 0x95, 0x68, 0x36, 0x19, 0x20, 0x8d, 0x9f, 0xcb, 0x28, 0x9c, 0xf2, 0x28, 0x08,
-	0xa3, 0x43, 0xc2, 0x99, 0xe1, 0x42, 0x34, 0x9b, 0xec, 0x0a, 0x0e, 0x36, 0x07, 0x54, 0xf0, 0xe3,
-	0xc9, 0x74, 0xcc, 0xe5, 0x48, 0x6d, 0xb8, 0xb8, 0xa9, 0x25, 0x59, 0x84, 0xae, 0xf0, 0x5b, 0x2e,
-	0x2d, 0x18, 0xe2, 0x29, 0x90, 0x23, 0x4c, 0x60, 0x7b, 0x41, 0xb1, 0xb2, 0x51, 0x22, 0x85, 0x1a,
-	0xf2, 0x94, 0x91, 0xbb, 0xb0, 0x4a, 0x2a, 0x73, 0x2b, 0x02, 0x32, 0xb4, 0x6f, 0x6e, 0xe6, 0x43,
-	0xf9, 0xa4, 0xe9, 0x30, 0x67, 0xad, 0x42, 0x8a, 0x6b, 0x42, 0xd0, 0x9f, 0xdb, 0xfc, 0x04, 0xae,
-	0xe6, 0x75, 0xe7, 0xe7, 0x8a, 0x49, 0x92, 0x2d, 0xd4, 0xe7, 0xa7, 0x5f, 0x05, 0x83, 0x27, 0x49,
-	0x9c, 0x58, 0x1b, 0x22, 0x71, 0x88, 0x60, 0xd7, 0xa1, 0x42, 0x8b, 0x61, 0x18, 0x58, 0x8f, 0x45,
-	0xd9, 0x20, 0xba, 0x1d, 0xd8, 0xff, 0xd5, 0xc4, 0xb3, 0x3d, 0x1f, 0x0c, 0x76, 0x55, 0x52, 0x3f,
-	0x94, 0x89, 0xa4, 0x11, 0xb6, 0xdf, 0x69, 0xbc, 0x26, 0xcf, 0x27, 0x93, 0xac, 0xa8, 0x85, 0x79,
-	0x45, 0x65, 0x4f, 0xa0, 0x3c, 0xe2, 0x5e, 0xc0, 0x93, 0xd4, 0x2a, 0xd2, 0xab, 0xdf, 0x3a, 0xb1,
-	0xff, 0xb9, 0x90, 0x8b, 0x81, 0x45, 0x69, 0x53, 0xe9, 0xf0, 0x32, 0x55, 0x21, 0x69, 0xbd, 0xfe,
-	0x29, 0xd4, 0xf3, 0xca, 0x17, 0x1a, 0x48, 0x3e, 0x90, 0xe9, 0x50, 0x86, 0xe2, 0xee, 0xde, 0xc0,
-	0x5c, 0xc1, 0x0f, 0xc2, 0xdd, 0x5e, 0x7f, 0x20, 0x7e, 0xb7, 0xd8, 0x72, 0x24, 0x6c, 0x7f, 0x23,
-	0x0a, 0xda, 0x45, 0x3e, 0xda, 0x54, 0x05, 0x29, 0x9e, 0xb3, 0x82, 0xe4, 0x0b, 0x80, 0xbe, 0x5c,
-	0x00, 0xec, 0xaf, 0x45, 0xf8, 0x5b, 0xe3, 0x90, 0x47, 0x59, 0x37, 0x8e, 0x7c, 0xbe, 0xb8, 0x92,
-	0x96, 0xbb, 0xd2, 0x19, 0x7d, 0xf1, 0x82, 0xee, 0xe0, 0xb0, 0x01, 0x0b, 0x9b, 0x17, 0xf8, 0xf1,
-	0x37, 0xf7, 0x7b, 0x6d, 0xf1, 0xfc, 0xbf, 0xd7, 0x36, 0x40, 0x4f, 0x39, 0x8f, 0xce, 0xf3, 0x15,
-	0x8b, 0x7a, 0x78, 0xfd, 0x2c, 0x3e, 0xe2, 0x91, 0xec, 0xdc, 0x82, 0xb0, 0x1f, 0xc3, 0xa5, 0x85,
-	0xcf, 0x54, 0x5c, 0xde, 0x5f, 0x2e, 0x2e, 0xb5, 0xc6, 0x42, 0xae, 0x6a, 0x8b, 0x07, 0x55, 0x64,
-	0x0e, 0xf0, 0x84, 0xd3, 0x3e, 0x89, 0x17, 0xc8, 0xa9, 0xab, 0x30, 0x5f, 0x34, 0x98, 0x5f, 0x82,
-	0xb9, 0xb0, 0xfb, 0x86, 0x5f, 0x4c, 0xaf, 0x41, 0xc9, 0x27, 0xb9, 0x1a, 0x22, 0x04, 0xc5, 0xde,
-	0x03, 0xf0, 0xc3, 0xe9, 0x88, 0x27, 0xf3, 0xe9, 0xbf, 0xee, 0xe6, 0x38, 0xf6, 0x77, 0x70, 0x79,
-	0x71, 0xf6, 0x45, 0x00, 0xba, 0x30, 0x58, 0x5c, 0x32, 0x78, 0xd1, 0x1f, 0x14, 0xfe, 0xa8, 0x81,
-	0xb1, 0x19, 0x67, 0x9f, 0xbd, 0xf8, 0xbe, 0xc4, 0x9b, 0x87, 0xef, 0x87, 0x41, 0x24, 0xf7, 0x93,
-	0xbe, 0x7e, 0xee, 0x9f, 0xf4, 0x37, 0xaf, 0xc0, 0x6a, 0x18, 0x37, 0x30, 0x52, 0x21, 0x6a, 0xee,
-	0x7f, 0x59, 0x98, 0xee, 0xef, 0x97, 0x68, 0xc7, 0xe3, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xd6,
-	0x54, 0xdf, 0xcf, 0x37, 0x19, 0x00, 0x00,
+	// 2999 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4b, 0x8f, 0xdb, 0xd6,
+	0xf5, 0x1f, 0x8a, 0xa4, 0x1e, 0x47, 0x9a, 0x19, 0x9a, 0x76, 0x1c, 0xda, 0x8e, 0x13, 0x87, 0xf9,
+	0xfb, 0x91, 0xc7, 0x5f, 0x49, 0x27, 0x69, 0x6d, 0x04, 0x08, 0x0a, 0x8d, 0x44, 0xdb, 0xaa, 0x65,
+	0x69, 0x4c, 0x49, 0xce, 0x63, 0x23, 0x70, 0xa8, 0x3b, 0x23, 0x66, 0x24, 0x52, 0x26, 0x29, 0xc7,
+	0x13, 0xa0, 0xcd, 0xae, 0xdd, 0xf4, 0x1b, 0x74, 0x59, 0xa0, 0xbb, 0xa2, 0x40, 0x3f, 0x41, 0x17,
+	0xdd, 0xb4, 0x9f, 0xa0, 0xbb, 0xae, 0xbb, 0x2a, 0x0a, 0x14, 0x5d, 0x15, 0x45, 0x71, 0xce, 0xbd,
+	0x7c, 0x68, 0x46, 0xb6, 0x35, 0x46, 0xba, 0x21, 0xee, 0x79, 0xdc, 0xd7, 0xb9, 0xbf, 0xf3, 0xb8,
+	0x97, 0x50, 0x9d, 0x05, 0x63, 0x36, 0xad, 0xcf, 0xc3, 0x20, 0x0e, 0x2e, 0x5f, 0x3a, 0x0c, 0x82,
+	0xc3, 0x29, 0xfb, 0x90, 0xa8, 0xfd, 0xc5, 0xc1, 0x87, 0x8e, 0x7f, 0x2c, 0x44, 0x6f, 0x9d, 0x14,
+	0xc5, 0xde, 0x8c, 0x45, 0xb1, 0x33, 0x9b, 0x0b, 0x85, 0x37, 0x4e, 0x2a, 0x44, 0x71, 0xb8, 0x70,
+	0x63, 0x21, 0xdd, 0x9c, 0xb1, 0x28, 0x72, 0x0e, 0x19, 0x27, 0xcd, 0xbf, 0x49, 0xa0, 0xec, 0x31,
+	0x16, 0xea, 0x5b, 0x50, 0xf0, 0xc6, 0x86, 0x74, 0x4d, 0xba, 0x55, 0xb1, 0x0b, 0xde, 0x58, 0x37,
+	0xa0, 0xe4, 0x8c, 0xc7, 0x21, 0x8b, 0x22, 0xa3, 0x40, 0xcc, 0x84, 0xd4, 0x75, 0x50, 0x7c, 0x67,
+	0xc6, 0x0c, 0x99, 0xd8, 0xd4, 0xd6, 0x2f, 0x42, 0xd1, 0x79, 0xea, 0xc4, 0x4e, 0x68, 0x28, 0xc4,
+	0x15, 0x94, 0xfe, 0x16, 0x94, 0x3c, 0x7f, 0x3f, 0x78, 0xc6, 0x22, 0x43, 0xbd, 0x26, 0xdf, 0xaa,
+	0xee, 0xa8, 0xf5, 0xa6, 0x73, 0xc0, 0xec, 0x84, 0xab, 0x7f, 0x02, 0x25, 0x37, 0x64, 0x4e, 0xcc,
+	0xc6, 0x46, 0xf1, 0x9a, 0x74, 0xab, 0xba, 0x73, 0xb9, 0xce, 0x97, 0x5f, 0x4f, 0x96, 0x5f, 0x1f,
+	0x24, 0xfb, 0xb3, 0x13, 0x55, 0xec, 0xb5, 0x98, 0x8f, 0xa9, 0x57, 0xe9, 0xe5, 0xbd, 0x84, 0xaa,
+	0x79, 0x13, 0xca, 0xb8, 0xd5, 0x8e, 0x17, 0xc5, 0xfa, 0x15, 0x50, 0xbd, 0x98, 0xcd, 0x22, 0x43,
+	0x12, 0xcb, 0x42, 0x89, 0xcd, 0x79, 0xe6, 0x1f, 0x0a, 0xa0, 0x11, 0xcd, 0x1c, 0x77, 0xe2, 0xec,
+	0x7b, 0x53, 0x2f, 0x3e, 0xc6, 0x6d, 0xcf, 0x19, 0x0b, 0x85, 0x89, 0xa8, 0xad, 0xbf, 0x07, 0xca,
+	0xdc, 0x89, 0x27, 0x64, 0xa1, 0xad, 0x9d, 0x8b, 0xf5, 0x93, 0x9d, 0xea, 0x7b, 0x4e, 0x3c, 0xb1,
+	0x49, 0x47, 0x7f, 0x03, 0x2a, 0xd1, 0xc2, 0x75, 0x59, 0x14, 0xb1, 0x88, 0x6c, 0xa7, 0xda, 0x19,
+	0x43, 0xbf, 0x0c, 0xe5, 0x03, 0xc7, 0x9b, 0x2e, 0x42, 0x16, 0x91, 0x09, 0x55, 0x3b, 0xa5, 0xf5,
+	0xcf, 0xa0, 0x36, 0x75, 0xa2, 0x78, 0x24, 0xb4, 0x0d, 0xf5, 0xa5, 0x5b, 0xae, 0xa2, 0x7e, 0x9f,
+	0xab, 0xa7, 0xdd, 0xc5, 0x78, 0x46, 0x71, 0xbd, 0xee, 0x77, 0xb9, 0xba, 0xf9, 0x09, 0x28, 0xb8,
+	0x0b, 0x1d, 0xa0, 0xd8, 0x6a, 0xdb, 0x56, 0x73, 0xa0, 0x6d, 0xe8, 0x15, 0x50, 0x6d, 0xab, 0xd3,
+	0xf8, 0x52, 0x93, 0x90, 0xbd, 0x37, 0xdc, 0xed, 0x0f, 0x77, 0xb5, 0x02, 0xb2, 0xdb, 0xdd, 0xdd,
+	0xde, 0x17, 0x9a, 0x6c, 0x76, 0x40, 0x19, 0x46, 0x2c, 0xcc, 0xc3, 0x48, 0x5a, 0x0d, 0xa3, 0xc2,
+	0x4a, 0x18, 0xc9, 0x79, 0x18, 0x99, 0x3f, 0x97, 0xa0, 0xd4, 0x0c, 0xfc, 0xd8, 0x71, 0xe3, 0xef,
+	0x67, 0x44, 0x3c, 0x7f, 0x3c, 0x41, 0x34, 0x76, 0xfe, 0xfc, 0x89, 0x87, 0x53, 0xc4, 0x93, 0x90,
+	0x39, 0x63, 0x8e, 0xda, 0x8a, 0x9d, 0x90, 0xe6, 0xff, 0x43, 0x55, 0xac, 0x83, 0x50, 0xf4, 0xe6,
+	0x32, 0x8a, 0xca, 0x75, 0x21, 0x4c, 0x80, 0xf4, 0x1b, 0x15, 0x8a, 0x03, 0xea, 0x7a, 0xca, 0xbf,
+	0x34, 0x90, 0x8f, 0xd8, 0xb1, 0x58, 0x2b, 0x36, 0x51, 0x23, 0x3a, 0xa2, 0x65, 0xd6, 0xec, 0x42,
+	0x74, 0x94, 0x6e, 0x47, 0x59, 0xde, 0x4e, 0xe4, 0x4e, 0xd8, 0xcc, 0x21, 0x10, 0x54, 0x6c, 0x41,
+	0x21, 0xb8, 0x3c, 0xdf, 0x8b, 0x3d, 0x27, 0x0e, 0x42, 0x3a, 0xe0, 0x8a, 0x9d, 0x31, 0xf4, 0x6b,
+	0xa0, 0xc4, 0xc7, 0x73, 0x46, 0xbe, 0xb2, 0xb5, 0x53, 0xab, 0xf3, 0x25, 0xd5, 0x07, 0xc7, 0x73,
+	0x66, 0x93, 0x44, 0x7f, 0x17, 0x4a, 0xd1, 0xc4, 0x09, 0x3d, 0xff, 0xd0, 0x28, 0x93, 0xd2, 0x76,
+	0xa2, 0xd4, 0xe7, 0x6c, 0x3b, 0x91, 0xe3, 0x54, 0xdf, 0x4c, 0xbc, 0x98, 0x4d, 0xbd, 0x28, 0x36,
+	0x2a, 0x64, 0x9e, 0x8c, 0xa1, 0xdf, 0x04, 0x35, 0x8a, 0x9d, 0x98, 0x19, 0x40, 0xc3, 0x6c, 0xa6,
+	0xc3, 0x20, 0x73, 0xb7, 0x60, 0x48, 0x36, 0x97, 0xe3, 0xee, 0x26, 0xcc, 0x19, 0x1b, 0x55, 0xbe,
+	0x3b, 0x6c, 0xeb, 0x6f, 0x41, 0xf5, 0x20, 0x08, 0x8f, 0x46, 0xdc, 0xda, 0x46, 0x8d, 0x44, 0x80,
+	0x2c, 0x61, 0xc4, 0x2b, 0x50, 0x21, 0x05, 0xea, 0xb9, 0x49, 0xe2, 0x32, 0x32, 0xee, 0xa3, 0xf0,
+	0x26, 0x54, 0x91, 0x3f, 0xda, 0x9f, 0x06, 0xee, 0x51, 0x64, 0x30, 0x3a, 0x92, 0x62, 0x7d, 0x17,
+	0x49, 0x1b, 0x50, 0x44, 0xcd, 0x48, 0xbf, 0x01, 0x55, 0x6e, 0xb6, 0x91, 0x1f, 0x8c, 0x99, 0x71,
+	0x40, 0xfe, 0xa0, 0xd6, 0xbb, 0xc1, 0x98, 0xd9, 0xc0, 0x25, 0xd8, 0xc6, 0xe5, 0xd0, 0x58, 0x23,
+	0x37, 0x58, 0xf8, 0xb1, 0x71, 0x48, 0x6e, 0x09, 0xc4, 0x6a, 0x22, 0x47, 0xbf, 0x0a, 0x80, 0x80,
+	0x11, 0xf2, 0x09, 0xf7, 0x69, 0xe4, 0x90, 0xd8, 0xbc, 0x03, 0x0a, 0x9a, 0x58, 0xaf, 0x42, 0x69,
+	0xcf, 0x6e, 0x3f, 0x6e, 0x0c, 0x2c, 0x6d, 0x43, 0xdf, 0x84, 0x8a, 0x6d, 0x35, 0x5a, 0xa3, 0x5e,
+	0xb7, 0x93, 0xb9, 0x4f, 0xa7, 0xdd, 0xd4, 0x0a, 0x7a, 0x19, 0x94, 0xde, 0x9e, 0xd5, 0xd5, 0x64,
+	0xf3, 0x47, 0x50, 0x12, 0x76, 0xd7, 0xb7, 0x00, 0xba, 0xbd, 0xc1, 0xa8, 0x7f, 0xbf, 0x61, 0x5b,
+	0x2d, 0x6d, 0x43, 0xdf, 0x86, 0x6a, 0xbb, 0xfb, 0xb8, 0x3d, 0xb0, 0x72, 0x23, 0x08, 0x61, 0xc1,
+	0xbc, 0x0d, 0x2a, 0x19, 0x5a, 0xd7, 0xa0, 0xd6, 0xe9, 0x35, 0x5a, 0xed, 0xee, 0xbd, 0xd1, 0xa0,
+	0xd1, 0xee, 0x68, 0x1b, 0xa8, 0x86, 0x1c, 0xab, 0xa5, 0x49, 0x79, 0xe9, 0x7d, 0xab, 0x81, 0x1d,
+	0xdf, 0x07, 0xe0, 0x26, 0x26, 0x58, 0x5f, 0x5d, 0x86, 0x75, 0x49, 0x1c, 0x62, 0x82, 0xea, 0xbd,
+	0x44, 0x79, 0x65, 0xe2, 0xb8, 0x08, 0x45, 0x71, 0x7e, 0x1c, 0xdb, 0x82, 0xc2, 0x08, 0xf7, 0x0d,
+	0x9b, 0xba, 0xc1, 0x8c, 0x8d, 0x09, 0xe4, 0x65, 0x3b, 0xa5, 0xcd, 0x5f, 0xa8, 0xa0, 0xd2, 0xe1,
+	0xac, 0x3d, 0x1a, 0xfa, 0xf5, 0x22, 0x9e, 0x04, 0x99, 0x5f, 0x13, 0xa5, 0xff, 0x9f, 0x80, 0xba,
+	0x42, 0xf0, 0xd3, 0xf8, 0xe9, 0xf3, 0x6f, 0x0e, 0xee, 0x75, 0x50, 0x30, 0x25, 0xac, 0x11, 0x49,
+	0x49, 0x0f, 0x03, 0xc2, 0xdc, 0x09, 0x99, 0x1f, 0x47, 0x46, 0x91, 0x07, 0x04, 0x41, 0xd2, 0xfa,
+	0x9c, 0xf0, 0x90, 0xc5, 0x46, 0x49, 0xac, 0x8f, 0x28, 0x84, 0xf7, 0xd8, 0x89, 0x1d, 0xa3, 0xc2,
+	0xe1, 0x8d, 0x6d, 0xe4, 0xed, 0x07, 0xe3, 0x63, 0xf2, 0xb0, 0x8a, 0x4d, 0x6d, 0xfd, 0x3d, 0x28,
+	0xa2, 0x3f, 0x2c, 0x22, 0xe1, 0x30, 0x7a, 0x7e, 0xc5, 0x7d, 0x92, 0xd8, 0x42, 0x03, 0x2d, 0xe8,
+	0xc4, 0x31, 0x9b, 0xcd, 0xe3, 0x88, 0xdc, 0x46, 0xb5, 0x53, 0x5a, 0xbf, 0x00, 0xaa, 0x8b, 0x5d,
+	0xc8, 0x69, 0x64, 0x9b, 0x13, 0xfa, 0x47, 0xa0, 0x86, 0x2c, 0x0e, 0x8f, 0x8d, 0xcd, 0x97, 0x6e,
+	0x94, 0x2b, 0xea, 0x97, 0x40, 0x59, 0x44, 0x2c, 0x34, 0x98, 0x70, 0x0a, 0x0c, 0xe2, 0x36, 0xb1,
+	0xcc, 0x5f, 0x4a, 0x50, 0x49, 0x0d, 0xa9, 0x6f, 0x82, 0xfa, 0xd0, 0xb2, 0xef, 0x59, 0xda, 0xc6,
+	0xe5, 0x42, 0x99, 0x50, 0xd8, 0xbe, 0xd7, 0xed, 0xd9, 0x96, 0x26, 0x21, 0x8e, 0xef, 0x76, 0x1a,
+	0xf7, 0x38, 0xa2, 0x7f, 0xd2, 0x6b, 0x77, 0x35, 0x59, 0xaf, 0x41, 0xb9, 0xd1, 0xed, 0xf6, 0x86,
+	0xdd, 0xa6, 0xa5, 0x29, 0x98, 0x28, 0x3a, 0x56, 0xe3, 0xb1, 0xa5, 0xa9, 0xa8, 0x32, 0xb0, 0xbe,
+	0x18, 0x68, 0x45, 0x64, 0xde, 0x6d, 0x77, 0xac, 0xbe, 0x56, 0xd2, 0xb7, 0xa1, 0xd4, 0xec, 0x3d,
+	0x7c, 0x68, 0x75, 0x07, 0x5a, 0x99, 0x86, 0x2f, 0x83, 0xd2, 0x69, 0x3f, 0xb0, 0xb4, 0x8a, 0x5e,
+	0x02, 0xb9, 0xd1, 0x6a, 0x69, 0x3b, 0xe6, 0x0f, 0xa0, 0x9a, 0x33, 0x12, 0x4f, 0x49, 0x8d, 0xd6,
+	0x97, 0x1c, 0xea, 0x8f, 0x86, 0xd6, 0x90, 0xa0, 0x8e, 0xbe, 0x67, 0x75, 0x11, 0xea, 0x5a, 0xc1,
+	0x7c, 0x5b, 0x6c, 0xa0, 0x1f, 0x84, 0x31, 0x0e, 0xd9, 0xe2, 0x2e, 0x09, 0x50, 0x6c, 0x36, 0x86,
+	0xfd, 0x46, 0x47, 0x93, 0xcc, 0x77, 0x85, 0x0a, 0xf9, 0xc1, 0x1b, 0xcb, 0x7e, 0x90, 0xc4, 0x12,
+	0xe1, 0x06, 0xdf, 0x41, 0x8d, 0xe8, 0x87, 0xbc, 0xa0, 0x3a, 0x05, 0xdd, 0xa4, 0x60, 0x28, 0xe4,
+	0x0a, 0x86, 0x2b, 0x20, 0x33, 0xff, 0x29, 0x61, 0xb6, 0xba, 0x53, 0xa9, 0x5b, 0xfe, 0x53, 0x36,
+	0x0d, 0xe6, 0xcc, 0x46, 0x6e, 0x8a, 0x4a, 0x65, 0x3d, 0x54, 0x9a, 0xbf, 0x95, 0xa0, 0xd8, 0xf6,
+	0x9f, 0x7a, 0xf1, 0xe9, 0xb9, 0x2f, 0x80, 0x4a, 0x71, 0x8a, 0x26, 0xaf, 0xd9, 0x9c, 0x58, 0x59,
+	0xb9, 0x51, 0x85, 0x86, 0x63, 0x84, 0x62, 0x5e, 0x91, 0x0a, 0x13, 0xee, 0xf7, 0xe7, 0x2b, 0x18,
+	0x64, 0xf8, 0x72, 0x57, 0x07, 0x19, 0x2e, 0x4b, 0xac, 0xfb, 0xc7, 0x02, 0x54, 0xee, 0x7a, 0x53,
+	0xd6, 0xf6, 0xc7, 0xec, 0x19, 0xae, 0x7c, 0xe6, 0x4d, 0xa7, 0x49, 0xf1, 0x85, 0x6d, 0x74, 0x07,
+	0x77, 0xc2, 0xdc, 0xa3, 0x68, 0x31, 0x13, 0x36, 0x4e, 0x69, 0xca, 0x93, 0xc1, 0x22, 0x74, 0x93,
+	0xbd, 0x0a, 0x0a, 0xc7, 0x09, 0xd0, 0x7d, 0x44, 0x4e, 0xc5, 0x36, 0x65, 0x22, 0x27, 0x9a, 0x88,
+	0x8c, 0x4a, 0xed, 0x24, 0x3b, 0x17, 0xb3, 0xec, 0x7c, 0x01, 0xd4, 0x19, 0x1b, 0x7b, 0x8e, 0xf0,
+	0x73, 0x4e, 0xa4, 0x16, 0x2d, 0xe7, 0x2c, 0xaa, 0x83, 0x12, 0x79, 0xdf, 0x32, 0x72, 0x7d, 0xd9,
+	0xa6, 0x36, 0x3a, 0xa2, 0x33, 0x1e, 0xb3, 0xb1, 0x01, 0x2f, 0xb5, 0x22, 0x57, 0xd4, 0xdf, 0x07,
+	0x65, 0xc6, 0x62, 0x87, 0x1c, 0xbd, 0xba, 0xf3, 0xfa, 0xa9, 0x0e, 0x7d, 0x2a, 0xea, 0x6d, 0x52,
+	0xa2, 0x82, 0x85, 0xe2, 0x4e, 0x64, 0xd4, 0x44, 0xc1, 0xc2, 0x49, 0xf3, 0xaf, 0x05, 0x50, 0x28,
+	0x99, 0x25, 0x2b, 0x95, 0x72, 0x2b, 0xd5, 0x40, 0x9e, 0x7b, 0x3e, 0x19, 0xaf, 0x6c, 0x63, 0x13,
+	0x93, 0xfb, 0x7c, 0xea, 0x78, 0x7e, 0xcc, 0x9e, 0xc5, 0x22, 0x4a, 0x67, 0x8c, 0xf4, 0x14, 0x94,
+	0xdc, 0x29, 0xbc, 0x23, 0x2c, 0xca, 0xcb, 0xfb, 0x6d, 0xca, 0xa2, 0xf5, 0xde, 0x3c, 0x8e, 0x2c,
+	0x3f, 0x0e, 0x8f, 0x85, 0x89, 0xef, 0x40, 0xf5, 0xeb, 0x28, 0xf0, 0x47, 0xa2, 0x76, 0x29, 0xbe,
+	0x78, 0x4f, 0x80, 0xba, 0x7d, 0x52, 0xd5, 0x6f, 0x80, 0x3a, 0xf5, 0xfc, 0xa3, 0xc8, 0x28, 0xd3,
+	0xf8, 0x1a, 0x1f, 0xbf, 0x83, 0x2c, 0x3e, 0x01, 0x17, 0x5f, 0xbe, 0x0d, 0x95, 0x74, 0xd2, 0xe4,
+	0xf4, 0xa4, 0xa5, 0xd3, 0x7b, 0xea, 0x4c, 0x17, 0x49, 0x6d, 0xc8, 0x89, 0x4f, 0x0b, 0x77, 0xa4,
+	0xcb, 0x3f, 0x06, 0xc8, 0x46, 0x5b, 0xd1, 0xf3, 0x4a, 0xbe, 0x27, 0x7a, 0x07, 0x6a, 0xe7, 0x06,
+	0x30, 0xff, 0x29, 0x81, 0x82, 0x3c, 0xec, 0xbb, 0x88, 0x12, 0x03, 0x63, 0xf3, 0x7f, 0x62, 0x5f,
+	0x9c, 0xea, 0xfb, 0xb3, 0xef, 0x2b, 0xdb, 0xcd, 0xfc, 0xbb, 0x0c, 0xb5, 0x6e, 0x10, 0x7b, 0x07,
+	0x9e, 0xeb, 0xc4, 0x5e, 0xe0, 0x9f, 0x0a, 0x41, 0x49, 0xdc, 0x28, 0xac, 0x19, 0x37, 0x2e, 0x80,
+	0xea, 0xb8, 0x71, 0x9a, 0xd0, 0x39, 0x81, 0xc8, 0x8e, 0x16, 0xfb, 0x5f, 0x33, 0x37, 0x16, 0x56,
+	0x49, 0x48, 0xfd, 0x6d, 0xa8, 0x89, 0xe6, 0x68, 0xcc, 0x22, 0x57, 0xb8, 0x6f, 0x55, 0xf0, 0x5a,
+	0x2c, 0x72, 0xb3, 0x28, 0xc8, 0xfd, 0x98, 0x13, 0xcf, 0x4d, 0xd9, 0x37, 0x44, 0xe9, 0x50, 0x16,
+	0x89, 0x38, 0xbf, 0xbb, 0x7c, 0xad, 0x9c, 0xa4, 0xf1, 0x4a, 0x2e, 0x8d, 0xeb, 0xa0, 0x50, 0x91,
+	0x02, 0x74, 0xa4, 0xd4, 0x7e, 0x51, 0x2a, 0xfd, 0xbd, 0x24, 0x4a, 0xc3, 0xf3, 0xb0, 0x2d, 0xaa,
+	0x39, 0xdb, 0x6a, 0x5a, 0xed, 0xc7, 0x54, 0xe2, 0xbd, 0x0e, 0xe7, 0x1b, 0xcd, 0x66, 0x6f, 0xd8,
+	0x1d, 0x8c, 0xf6, 0x2c, 0xcb, 0x1e, 0x61, 0x0a, 0xa5, 0x64, 0xf6, 0x1a, 0x9c, 0x5b, 0x12, 0x74,
+	0xac, 0xbb, 0x03, 0xad, 0x8c, 0x25, 0x61, 0x5e, 0xaf, 0x80, 0x35, 0x66, 0x26, 0x97, 0xf5, 0x73,
+	0xb0, 0xf9, 0xd0, 0xea, 0xf7, 0x1b, 0xf7, 0xac, 0x51, 0xa3, 0x85, 0x15, 0xa0, 0x82, 0x5d, 0x28,
+	0xd7, 0x0a, 0x86, 0x8a, 0x3a, 0x22, 0xe3, 0x0a, 0x56, 0x11, 0x2b, 0x4f, 0xcc, 0xb9, 0x82, 0x2e,
+	0x99, 0xb7, 0x41, 0xcb, 0x9b, 0x84, 0x82, 0xf8, 0x3b, 0xcb, 0x41, 0x7c, 0x73, 0xc9, 0x68, 0xe9,
+	0x2d, 0x48, 0x02, 0x05, 0x6f, 0xfd, 0x2b, 0xaf, 0xd0, 0xcf, 0x7f, 0x67, 0xd0, 0x40, 0x76, 0xe6,
+	0x9e, 0x80, 0x03, 0x36, 0x31, 0xe2, 0x13, 0x7c, 0xdc, 0x20, 0xf1, 0x91, 0x94, 0xa6, 0xf8, 0x86,
+	0xd5, 0xbc, 0x88, 0xe2, 0xd8, 0x26, 0x8f, 0x0c, 0xa7, 0x49, 0x14, 0x5f, 0x84, 0x53, 0xf4, 0x3f,
+	0x9f, 0x79, 0x87, 0x93, 0xfd, 0x20, 0x8c, 0x8c, 0x12, 0xbf, 0xbc, 0xa4, 0x0c, 0xf3, 0x5f, 0x12,
+	0x54, 0x71, 0xa1, 0x7d, 0x16, 0x45, 0xab, 0x20, 0x8d, 0x45, 0xa7, 0xeb, 0x66, 0x4b, 0x15, 0x94,
+	0xfe, 0x01, 0xc8, 0xec, 0xd9, 0xdc, 0x90, 0x5f, 0x8a, 0x74, 0x54, 0xc3, 0x1d, 0x87, 0xec, 0x20,
+	0x64, 0xd1, 0x24, 0x81, 0xb4, 0x20, 0xd1, 0x65, 0x42, 0x1c, 0x68, 0x8d, 0x54, 0x1b, 0x8a, 0x91,
+	0x12, 0xe7, 0x28, 0x2e, 0x3b, 0x87, 0x9e, 0xbb, 0xf1, 0x55, 0x04, 0x6e, 0x2f, 0x81, 0xe2, 0x3a,
+	0x07, 0x1c, 0xdf, 0xe9, 0x43, 0x0c, 0xb1, 0xcc, 0x1f, 0xc2, 0x76, 0x6e, 0xdf, 0x74, 0xb2, 0xe6,
+	0xf2, 0xc9, 0xd6, 0xea, 0x39, 0x85, 0xe4, 0x60, 0xff, 0xac, 0x70, 0x7b, 0xd9, 0xec, 0xc9, 0x82,
+	0x45, 0xf1, 0x5a, 0x15, 0x50, 0xe6, 0x7d, 0xf2, 0x92, 0xf7, 0x25, 0xab, 0x53, 0x4e, 0xad, 0x0e,
+	0xdd, 0xf8, 0x30, 0x0c, 0x16, 0x73, 0x91, 0x65, 0x39, 0x81, 0x97, 0xaf, 0xe8, 0xd8, 0x77, 0x47,
+	0x5c, 0x04, 0x24, 0xaa, 0x20, 0xe7, 0x1e, 0x89, 0xaf, 0x0b, 0x0b, 0xa8, 0xe4, 0xcd, 0xe7, 0xea,
+	0xb9, 0x75, 0xd6, 0x57, 0xdc, 0x04, 0x8a, 0x6b, 0x46, 0xa9, 0x24, 0xb9, 0x97, 0x72, 0xc9, 0xfd,
+	0xfd, 0xb4, 0x86, 0xaf, 0xd0, 0x64, 0xe7, 0x97, 0x26, 0x3b, 0x43, 0x11, 0x7f, 0x15, 0x80, 0x76,
+	0x33, 0xa2, 0x29, 0x78, 0x25, 0x5f, 0x21, 0x4e, 0x9f, 0xcf, 0x73, 0x8e, 0x8b, 0xe3, 0xd0, 0xf1,
+	0xa3, 0x03, 0x16, 0x86, 0x8c, 0xdf, 0x82, 0x65, 0x5b, 0x23, 0xc1, 0x20, 0xe3, 0x67, 0xa5, 0xff,
+	0xd6, 0x9a, 0xa5, 0xbf, 0xd9, 0x13, 0x31, 0xa9, 0x02, 0x6a, 0x7f, 0x80, 0x95, 0xfc, 0x06, 0x56,
+	0xcf, 0xc3, 0x2e, 0x27, 0x64, 0xbc, 0x35, 0x52, 0x73, 0x34, 0xb8, 0x8f, 0x95, 0xb6, 0x26, 0xe9,
+	0x3a, 0x6c, 0x0d, 0xbb, 0x4b, 0x3c, 0x25, 0x7b, 0x03, 0x2a, 0x98, 0x1f, 0x40, 0x51, 0x14, 0xe7,
+	0x25, 0x90, 0xbb, 0xd6, 0xe7, 0xda, 0x46, 0xbe, 0x1c, 0x97, 0xf0, 0x4e, 0xd0, 0xec, 0x3d, 0xdc,
+	0xeb, 0x58, 0x03, 0x4b, 0x2b, 0x24, 0x18, 0x14, 0x66, 0x7b, 0x3e, 0x06, 0x85, 0x42, 0x82, 0xc1,
+	0x7f, 0x17, 0xe0, 0x3c, 0x41, 0x33, 0x39, 0x79, 0x31, 0xe5, 0x49, 0x2c, 0x5e, 0x81, 0x8a, 0xbf,
+	0x98, 0x8d, 0xe2, 0x20, 0x76, 0xa6, 0x04, 0x48, 0xd5, 0x2e, 0xfb, 0x8b, 0xd9, 0x00, 0x69, 0xbc,
+	0xe9, 0xa3, 0x70, 0xce, 0xfc, 0x31, 0x3e, 0x81, 0xf0, 0xd7, 0x39, 0xf0, 0x17, 0xb3, 0x3d, 0xce,
+	0xc1, 0x64, 0x83, 0x0a, 0x6e, 0x30, 0x9b, 0x4f, 0x99, 0x28, 0xd1, 0x55, 0x1b, 0x3b, 0x35, 0x05,
+	0x8b, 0xf0, 0xe8, 0x7d, 0xcb, 0xc4, 0x0c, 0x2a, 0x3f, 0x3c, 0xe4, 0xf0, 0x29, 0x30, 0x5d, 0xa1,
+	0x38, 0x99, 0xa3, 0x48, 0x0a, 0x55, 0xe4, 0x25, 0x93, 0xbc, 0x03, 0x9b, 0xa4, 0x92, 0xce, 0xc2,
+	0x41, 0x46, 0xfd, 0xd2, 0x69, 0xde, 0x13, 0x20, 0x88, 0x46, 0xb9, 0xd9, 0xca, 0xa4, 0xb8, 0xcd,
+	0x05, 0xfd, 0x74, 0xce, 0x8f, 0xe0, 0x42, 0x5e, 0x37, 0x1d, 0x97, 0x57, 0xa6, 0x7a, 0xa6, 0x9e,
+	0x8e, 0x7e, 0x01, 0x54, 0x16, 0x86, 0x41, 0x68, 0xec, 0x70, 0x57, 0x23, 0x42, 0xbf, 0x04, 0x65,
+	0x6a, 0x8c, 0xbc, 0xb1, 0xf1, 0x31, 0x0f, 0x34, 0x44, 0xb7, 0xc7, 0xe6, 0x7f, 0x24, 0x7e, 0x6c,
+	0xf7, 0x07, 0x83, 0xbd, 0x24, 0x0c, 0xbc, 0x2b, 0x5c, 0x4f, 0x22, 0x6f, 0x78, 0xad, 0x7e, 0x42,
+	0x9e, 0x77, 0x3f, 0x11, 0xa1, 0x0b, 0x59, 0x84, 0xbe, 0x0d, 0x25, 0x7c, 0xaa, 0xc1, 0xa7, 0x39,
+	0x99, 0x4e, 0xfd, 0xea, 0xa9, 0xfe, 0xf7, 0xb9, 0x9c, 0x17, 0x40, 0x89, 0x36, 0x05, 0x1b, 0x7c,
+	0x8b, 0x15, 0xc5, 0x13, 0xb6, 0x2f, 0x7f, 0x0a, 0xb5, 0xbc, 0xf2, 0x99, 0x0a, 0x9c, 0xeb, 0xc2,
+	0x1d, 0x4a, 0x20, 0xef, 0x0d, 0xf1, 0xd1, 0xb3, 0x0c, 0xca, 0x5e, 0xaf, 0x3f, 0xe0, 0x4f, 0x2e,
+	0x2d, 0x4b, 0xc0, 0xf6, 0x77, 0x22, 0x67, 0x9c, 0xe5, 0x16, 0x98, 0x04, 0x1d, 0x79, 0xcd, 0xa0,
+	0x93, 0x8f, 0x19, 0xca, 0x89, 0x98, 0x91, 0xfa, 0xb9, 0xba, 0xae, 0x9f, 0x3f, 0xe1, 0x27, 0xd6,
+	0x9c, 0x7a, 0xcc, 0x8f, 0xbb, 0x81, 0xef, 0xb2, 0xcc, 0x0a, 0x52, 0xce, 0x0a, 0x2f, 0x48, 0xcd,
+	0x67, 0xdc, 0x80, 0xf9, 0x97, 0x02, 0x40, 0x36, 0xe7, 0x19, 0xfe, 0x35, 0xe4, 0x7e, 0x0f, 0xc8,
+	0xeb, 0xff, 0x1e, 0xa8, 0x83, 0x12, 0x31, 0xe6, 0xaf, 0x73, 0x91, 0x46, 0x3d, 0xdc, 0x7e, 0x1c,
+	0x1c, 0x31, 0x5f, 0x14, 0x0f, 0x9c, 0xc0, 0xb9, 0x43, 0xf6, 0x34, 0x38, 0x5a, 0xef, 0xd7, 0x84,
+	0x50, 0xc5, 0x50, 0xf2, 0x0d, 0xdb, 0x9f, 0x04, 0xc1, 0xd1, 0x08, 0x91, 0xcd, 0x13, 0x30, 0x08,
+	0xd6, 0x30, 0x9c, 0xea, 0xd7, 0x61, 0x2b, 0x51, 0x88, 0x98, 0x1b, 0xb2, 0x58, 0xa4, 0xb5, 0x4d,
+	0xc1, 0xed, 0x13, 0x13, 0x2f, 0x3e, 0x0b, 0x04, 0x94, 0x28, 0x1f, 0xb5, 0x7a, 0x66, 0xbf, 0x21,
+	0xf2, 0x6d, 0x2e, 0x36, 0x3f, 0x86, 0xad, 0x4c, 0x42, 0x51, 0xf3, 0xed, 0xe5, 0xa8, 0x59, 0xcd,
+	0xf5, 0x4c, 0x82, 0xe6, 0x4f, 0xa1, 0x82, 0xcc, 0x01, 0xed, 0x73, 0xc5, 0xdb, 0x41, 0xe6, 0x12,
+	0xb5, 0x04, 0x0c, 0xaf, 0x80, 0xd9, 0xb1, 0x17, 0x39, 0xfb, 0x53, 0x36, 0xa6, 0x73, 0x28, 0xdb,
+	0x29, 0x6d, 0x7e, 0x05, 0x5a, 0xb6, 0xa6, 0xe7, 0xbc, 0x8f, 0x5f, 0x84, 0xa2, 0x4b, 0xf2, 0xa4,
+	0xd6, 0xe2, 0x94, 0xfe, 0x26, 0x80, 0xeb, 0xcd, 0x27, 0x2c, 0x4c, 0xaf, 0x50, 0x35, 0x3b, 0xc7,
+	0x31, 0xbf, 0x83, 0x73, 0xd9, 0xd8, 0x67, 0x71, 0xca, 0x6c, 0x42, 0x79, 0x69, 0xc2, 0xb3, 0xbe,
+	0xca, 0xfc, 0x2c, 0xbf, 0xb9, 0x1e, 0x2f, 0xc7, 0xd6, 0xdd, 0x5c, 0x52, 0x5d, 0xc8, 0xb9, 0xea,
+	0xe2, 0xac, 0xf3, 0xff, 0x49, 0x82, 0xed, 0x13, 0x58, 0x59, 0xf9, 0x3c, 0x74, 0x1c, 0x33, 0xee,
+	0x6e, 0xb2, 0xcd, 0x09, 0x74, 0xc3, 0x80, 0xd6, 0x1b, 0x89, 0x05, 0x24, 0x64, 0xfe, 0x7f, 0x9b,
+	0xb2, 0xf6, 0xff, 0x36, 0xcc, 0x88, 0x38, 0xf0, 0xe8, 0xc9, 0x22, 0x88, 0x1d, 0xc2, 0xb1, 0x6c,
+	0x57, 0x90, 0xf3, 0x08, 0x19, 0x98, 0x11, 0xf9, 0xf8, 0x42, 0xe1, 0x80, 0x67, 0x44, 0xce, 0x23,
+	0x15, 0xf3, 0x33, 0x38, 0x7f, 0x62, 0x2b, 0x84, 0xf0, 0x1b, 0xcb, 0x08, 0x5f, 0xe1, 0x1b, 0x1c,
+	0xe6, 0xff, 0x28, 0x40, 0xb1, 0x31, 0xf7, 0x1e, 0xb0, 0xe3, 0x55, 0x16, 0x58, 0x01, 0xf2, 0x55,
+	0x0f, 0x64, 0xd7, 0xf1, 0x97, 0x4b, 0x30, 0x67, 0xfc, 0x57, 0x11, 0xfe, 0xd2, 0xe0, 0x43, 0xd6,
+	0xfb, 0xc8, 0xb5, 0x85, 0xf0, 0xf9, 0xff, 0x8c, 0x5e, 0xfd, 0x17, 0x27, 0x7b, 0x36, 0xf7, 0xf0,
+	0x7f, 0xe0, 0x1a, 0xbf, 0x38, 0x85, 0x6a, 0x3e, 0x66, 0x95, 0xd7, 0x8f, 0x59, 0x22, 0x2d, 0xb2,
+	0x34, 0x2d, 0x9a, 0x77, 0x40, 0xa5, 0xed, 0x61, 0xd2, 0xa3, 0xc2, 0x6e, 0x03, 0xcb, 0x3f, 0x5e,
+	0xe4, 0x8d, 0x3e, 0xb7, 0xdb, 0x03, 0x7c, 0xe7, 0xad, 0x80, 0xda, 0x68, 0x3d, 0x6c, 0x77, 0xf9,
+	0x43, 0x6f, 0xb3, 0x71, 0xd7, 0xd2, 0x64, 0x7c, 0xe4, 0xe3, 0xf6, 0x59, 0xfd, 0xc8, 0xc7, 0x65,
+	0xc9, 0x01, 0xfd, 0xba, 0x00, 0xd0, 0xc2, 0xbf, 0x0e, 0x2c, 0x8e, 0x57, 0xfc, 0x4a, 0xb8, 0x09,
+	0xea, 0x93, 0x05, 0x13, 0x87, 0x84, 0x45, 0x7c, 0xa6, 0x5b, 0x7f, 0x84, 0x02, 0x9b, 0xcb, 0xf5,
+	0x5b, 0xa0, 0xe0, 0x80, 0x22, 0x38, 0x5d, 0x38, 0xb5, 0xe7, 0x86, 0x7f, 0x6c, 0x93, 0xc6, 0x0b,
+	0x53, 0x69, 0x5a, 0xfc, 0xa8, 0xf9, 0xe2, 0xe7, 0x8c, 0x37, 0x04, 0x73, 0x0f, 0x54, 0x5a, 0x1b,
+	0xde, 0xed, 0x77, 0x3b, 0xbd, 0xe6, 0x83, 0x51, 0xab, 0xf7, 0x79, 0x17, 0xff, 0xb7, 0xf4, 0xf9,
+	0xef, 0x1b, 0xce, 0xc4, 0x52, 0xa2, 0xaf, 0x49, 0x78, 0xcb, 0x46, 0xcb, 0x8d, 0x44, 0xd1, 0x4c,
+	0x17, 0x71, 0xa4, 0x6d, 0xeb, 0xd1, 0xd0, 0x42, 0x15, 0x19, 0x43, 0x7c, 0xb6, 0xf1, 0xd5, 0x21,
+	0x3e, 0x93, 0x27, 0xa6, 0xfd, 0x95, 0x04, 0xea, 0x6e, 0x10, 0x3f, 0x78, 0xfc, 0xb2, 0xa2, 0x27,
+	0x05, 0xff, 0xab, 0xe5, 0xda, 0x57, 0x0a, 0x0d, 0xbb, 0xe7, 0x61, 0xd3, 0x0b, 0xea, 0x18, 0xb0,
+	0x3d, 0xd4, 0xdc, 0xff, 0xaa, 0x30, 0xdf, 0xdf, 0x2f, 0x52, 0x8f, 0x8f, 0xff, 0x3b, 0x00, 0x85,
+	0x63, 0x1f, 0x0e, 0x0a, 0x21, 0x00, 0x00,
 }
@@ -53,6 +53,86 @@ func (AddThreadConfig_Schema_Preset) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_10c1b2aca93c333f, []int{0, 0, 0}
 }
 
+type ThreadVerification_Issue_Type int32
+
+const (
+	ThreadVerification_Issue_MISSING_NODE   ThreadVerification_Issue_Type = 0
+	ThreadVerification_Issue_INVALID_NODE   ThreadVerification_Issue_Type = 1
+	ThreadVerification_Issue_INVALID_BLOCK  ThreadVerification_Issue_Type = 2
+	ThreadVerification_Issue_MISSING_BLOCK  ThreadVerification_Issue_Type = 3
+	ThreadVerification_Issue_PENDING_BLOCK  ThreadVerification_Issue_Type = 4
+	ThreadVerification_Issue_INDEX_MISMATCH ThreadVerification_Issue_Type = 5
+	ThreadVerification_Issue_MISSING_FILE   ThreadVerification_Issue_Type = 6
+	ThreadVerification_Issue_UNPINNED       ThreadVerification_Issue_Type = 7
+)
+
+var ThreadVerification_Issue_Type_name = map[int32]string{
+	0: "MISSING_NODE",
+	1: "INVALID_NODE",
+	2: "INVALID_BLOCK",
+	3: "MISSING_BLOCK",
+	4: "PENDING_BLOCK",
+	5: "INDEX_MISMATCH",
+	6: "MISSING_FILE",
+	7: "UNPINNED",
+}
+
+var ThreadVerification_Issue_Type_value = map[string]int32{
+	"MISSING_NODE":   0,
+	"INVALID_NODE":   1,
+	"INVALID_BLOCK":  2,
+	"MISSING_BLOCK":  3,
+	"PENDING_BLOCK":  4,
+	"INDEX_MISMATCH": 5,
+	"MISSING_FILE":   6,
+	"UNPINNED":       7,
+}
+
+func (x ThreadVerification_Issue_Type) String() string {
+	return proto.EnumName(ThreadVerification_Issue_Type_name, int32(x))
+}
+
+func (ThreadVerification_Issue_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{3, 0, 0}
+}
+
+type FileBatchUpdate_Status int32
+
+const (
+	FileBatchUpdate_QUEUED  FileBatchUpdate_Status = 0
+	FileBatchUpdate_MILLED  FileBatchUpdate_Status = 1
+	FileBatchUpdate_PINNED  FileBatchUpdate_Status = 2
+	FileBatchUpdate_SKIPPED FileBatchUpdate_Status = 3
+	FileBatchUpdate_ERROR   FileBatchUpdate_Status = 4
+	FileBatchUpdate_ADDED   FileBatchUpdate_Status = 5
+)
+
+var FileBatchUpdate_Status_name = map[int32]string{
+	0: "QUEUED",
+	1: "MILLED",
+	2: "PINNED",
+	3: "SKIPPED",
+	4: "ERROR",
+	5: "ADDED",
+}
+
+var FileBatchUpdate_Status_value = map[string]int32{
+	"QUEUED":  0,
+	"MILLED":  1,
+	"PINNED":  2,
+	"SKIPPED": 3,
+	"ERROR":   4,
+	"ADDED":   5,
+}
+
+func (x FileBatchUpdate_Status) String() string {
+	return proto.EnumName(FileBatchUpdate_Status_name, int32(x))
+}
+
+func (FileBatchUpdate_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{8, 0}
+}
+
 type FeedRequest_Mode int32
 
 const (
@@ -78,7 +158,7 @@ func (x FeedRequest_Mode) String() string {
 }
 
 func (FeedRequest_Mode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{9, 0}
+	return fileDescriptor_10c1b2aca93c333f, []int{12, 0}
 }
 
 type AccountUpdate_Type int32
@@ -109,7 +189,41 @@ func (x AccountUpdate_Type) String() string {
 }
 
 func (AccountUpdate_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{27, 0}
+	return fileDescriptor_10c1b2aca93c333f, []int{30, 0}
+}
+
+type QueueStats_Queue int32
+
+const (
+	QueueStats_BLOCK_MESSAGES  QueueStats_Queue = 0
+	QueueStats_BLOCK_DOWNLOADS QueueStats_Queue = 1
+	QueueStats_BLOCK_POSTS     QueueStats_Queue = 2
+	QueueStats_CAFE_REQUESTS   QueueStats_Queue = 3
+	QueueStats_CAFE_INBOX      QueueStats_Queue = 4
+)
+
+var QueueStats_Queue_name = map[int32]string{
+	0: "BLOCK_MESSAGES",
+	1: "BLOCK_DOWNLOADS",
+	2: "BLOCK_POSTS",
+	3: "CAFE_REQUESTS",
+	4: "CAFE_INBOX",
+}
+
+var QueueStats_Queue_value = map[string]int32{
+	"BLOCK_MESSAGES":  0,
+	"BLOCK_DOWNLOADS": 1,
+	"BLOCK_POSTS":     2,
+	"CAFE_REQUESTS":   3,
+	"CAFE_INBOX":      4,
+}
+
+func (x QueueStats_Queue) String() string {
+	return proto.EnumName(QueueStats_Queue_name, int32(x))
+}
+
+func (QueueStats_Queue) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{32, 0}
 }
 
 type LogLevel_Level int32
@@ -146,7 +260,7 @@ func (x LogLevel_Level) String() string {
 }
 
 func (LogLevel_Level) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{29, 0}
+	return fileDescriptor_10c1b2aca93c333f, []int{35, 0}
 }
 
 type AddThreadConfig struct {
@@ -346,6 +460,401 @@ func (m *BlockViz) GetNext() string {
 	return ""
 }
 
+type ThreadGraph struct {
+	Thread               string              `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Nodes                []*ThreadGraph_Node `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Edges                []*ThreadGraph_Edge `protobuf:"bytes,3,rep,name=edges,proto3" json:"edges,omitempty"`
+	Authors              []string            `protobuf:"bytes,4,rep,name=authors,proto3" json:"authors,omitempty"`
+	Lanes                int32               `protobuf:"varint,5,opt,name=lanes,proto3" json:"lanes,omitempty"`
+	Next                 string              `protobuf:"bytes,6,opt,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ThreadGraph) Reset()         { *m = ThreadGraph{} }
+func (m *ThreadGraph) String() string { return proto.CompactTextString(m) }
+func (*ThreadGraph) ProtoMessage()    {}
+func (*ThreadGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{2}
+}
+
+func (m *ThreadGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadGraph.Unmarshal(m, b)
+}
+func (m *ThreadGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadGraph.Marshal(b, m, deterministic)
+}
+func (m *ThreadGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadGraph.Merge(m, src)
+}
+func (m *ThreadGraph) XXX_Size() int {
+	return xxx_messageInfo_ThreadGraph.Size(m)
+}
+func (m *ThreadGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadGraph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadGraph proto.InternalMessageInfo
+
+func (m *ThreadGraph) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadGraph) GetNodes() []*ThreadGraph_Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ThreadGraph) GetEdges() []*ThreadGraph_Edge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+func (m *ThreadGraph) GetAuthors() []string {
+	if m != nil {
+		return m.Authors
+	}
+	return nil
+}
+
+func (m *ThreadGraph) GetLanes() int32 {
+	if m != nil {
+		return m.Lanes
+	}
+	return 0
+}
+
+func (m *ThreadGraph) GetNext() string {
+	if m != nil {
+		return m.Next
+	}
+	return ""
+}
+
+type ThreadGraph_Node struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type                 Block_BlockType      `protobuf:"varint,2,opt,name=type,proto3,enum=Block_BlockType" json:"type,omitempty"`
+	Author               string               `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Date                 *timestamp.Timestamp `protobuf:"bytes,4,opt,name=date,proto3" json:"date,omitempty"`
+	Clock                int64                `protobuf:"varint,5,opt,name=clock,proto3" json:"clock,omitempty"`
+	Status               Block_BlockStatus    `protobuf:"varint,6,opt,name=status,proto3,enum=Block_BlockStatus" json:"status,omitempty"`
+	Lane                 int32                `protobuf:"varint,7,opt,name=lane,proto3" json:"lane,omitempty"`
+	Row                  int32                `protobuf:"varint,8,opt,name=row,proto3" json:"row,omitempty"`
+	Merge                bool                 `protobuf:"varint,9,opt,name=merge,proto3" json:"merge,omitempty"`
+	Fork                 bool                 `protobuf:"varint,10,opt,name=fork,proto3" json:"fork,omitempty"`
+	Head                 bool                 `protobuf:"varint,11,opt,name=head,proto3" json:"head,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ThreadGraph_Node) Reset()         { *m = ThreadGraph_Node{} }
+func (m *ThreadGraph_Node) String() string { return proto.CompactTextString(m) }
+func (*ThreadGraph_Node) ProtoMessage()    {}
+func (*ThreadGraph_Node) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{2, 0}
+}
+
+func (m *ThreadGraph_Node) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadGraph_Node.Unmarshal(m, b)
+}
+func (m *ThreadGraph_Node) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadGraph_Node.Marshal(b, m, deterministic)
+}
+func (m *ThreadGraph_Node) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadGraph_Node.Merge(m, src)
+}
+func (m *ThreadGraph_Node) XXX_Size() int {
+	return xxx_messageInfo_ThreadGraph_Node.Size(m)
+}
+func (m *ThreadGraph_Node) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadGraph_Node.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadGraph_Node proto.InternalMessageInfo
+
+func (m *ThreadGraph_Node) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ThreadGraph_Node) GetType() Block_BlockType {
+	if m != nil {
+		return m.Type
+	}
+	return Block_MERGE
+}
+
+func (m *ThreadGraph_Node) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *ThreadGraph_Node) GetDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *ThreadGraph_Node) GetClock() int64 {
+	if m != nil {
+		return m.Clock
+	}
+	return 0
+}
+
+func (m *ThreadGraph_Node) GetStatus() Block_BlockStatus {
+	if m != nil {
+		return m.Status
+	}
+	return Block_READY
+}
+
+func (m *ThreadGraph_Node) GetLane() int32 {
+	if m != nil {
+		return m.Lane
+	}
+	return 0
+}
+
+func (m *ThreadGraph_Node) GetRow() int32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *ThreadGraph_Node) GetMerge() bool {
+	if m != nil {
+		return m.Merge
+	}
+	return false
+}
+
+func (m *ThreadGraph_Node) GetFork() bool {
+	if m != nil {
+		return m.Fork
+	}
+	return false
+}
+
+func (m *ThreadGraph_Node) GetHead() bool {
+	if m != nil {
+		return m.Head
+	}
+	return false
+}
+
+type ThreadGraph_Edge struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Node                 string   `protobuf:"bytes,3,opt,name=node,proto3" json:"node,omitempty"`
+	Outside              bool     `protobuf:"varint,4,opt,name=outside,proto3" json:"outside,omitempty"`
+	Missing              bool     `protobuf:"varint,5,opt,name=missing,proto3" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThreadGraph_Edge) Reset()         { *m = ThreadGraph_Edge{} }
+func (m *ThreadGraph_Edge) String() string { return proto.CompactTextString(m) }
+func (*ThreadGraph_Edge) ProtoMessage()    {}
+func (*ThreadGraph_Edge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{2, 1}
+}
+
+func (m *ThreadGraph_Edge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadGraph_Edge.Unmarshal(m, b)
+}
+func (m *ThreadGraph_Edge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadGraph_Edge.Marshal(b, m, deterministic)
+}
+func (m *ThreadGraph_Edge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadGraph_Edge.Merge(m, src)
+}
+func (m *ThreadGraph_Edge) XXX_Size() int {
+	return xxx_messageInfo_ThreadGraph_Edge.Size(m)
+}
+func (m *ThreadGraph_Edge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadGraph_Edge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadGraph_Edge proto.InternalMessageInfo
+
+func (m *ThreadGraph_Edge) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ThreadGraph_Edge) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *ThreadGraph_Edge) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *ThreadGraph_Edge) GetOutside() bool {
+	if m != nil {
+		return m.Outside
+	}
+	return false
+}
+
+func (m *ThreadGraph_Edge) GetMissing() bool {
+	if m != nil {
+		return m.Missing
+	}
+	return false
+}
+
+type ThreadVerification struct {
+	Thread               string                      `protobuf:"bytes,1,opt,name=thread,proto3" json:"thread,omitempty"`
+	Count                int32                       `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Issues               []*ThreadVerification_Issue `protobuf:"bytes,3,rep,name=issues,proto3" json:"issues,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ThreadVerification) Reset()         { *m = ThreadVerification{} }
+func (m *ThreadVerification) String() string { return proto.CompactTextString(m) }
+func (*ThreadVerification) ProtoMessage()    {}
+func (*ThreadVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{3}
+}
+
+func (m *ThreadVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadVerification.Unmarshal(m, b)
+}
+func (m *ThreadVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadVerification.Marshal(b, m, deterministic)
+}
+func (m *ThreadVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadVerification.Merge(m, src)
+}
+func (m *ThreadVerification) XXX_Size() int {
+	return xxx_messageInfo_ThreadVerification.Size(m)
+}
+func (m *ThreadVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadVerification proto.InternalMessageInfo
+
+func (m *ThreadVerification) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ThreadVerification) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ThreadVerification) GetIssues() []*ThreadVerification_Issue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
+type ThreadVerification_Issue struct {
+	Type                 ThreadVerification_Issue_Type `protobuf:"varint,1,opt,name=type,proto3,enum=ThreadVerification_Issue_Type" json:"type,omitempty"`
+	Node                 string                        `protobuf:"bytes,2,opt,name=node,proto3" json:"node,omitempty"`
+	Block                string                        `protobuf:"bytes,3,opt,name=block,proto3" json:"block,omitempty"`
+	Msg                  string                        `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	Repaired             bool                          `protobuf:"varint,5,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ThreadVerification_Issue) Reset()         { *m = ThreadVerification_Issue{} }
+func (m *ThreadVerification_Issue) String() string { return proto.CompactTextString(m) }
+func (*ThreadVerification_Issue) ProtoMessage()    {}
+func (*ThreadVerification_Issue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{3, 0}
+}
+
+func (m *ThreadVerification_Issue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThreadVerification_Issue.Unmarshal(m, b)
+}
+func (m *ThreadVerification_Issue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThreadVerification_Issue.Marshal(b, m, deterministic)
+}
+func (m *ThreadVerification_Issue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThreadVerification_Issue.Merge(m, src)
+}
+func (m *ThreadVerification_Issue) XXX_Size() int {
+	return xxx_messageInfo_ThreadVerification_Issue.Size(m)
+}
+func (m *ThreadVerification_Issue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThreadVerification_Issue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThreadVerification_Issue proto.InternalMessageInfo
+
+func (m *ThreadVerification_Issue) GetType() ThreadVerification_Issue_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ThreadVerification_Issue_MISSING_NODE
+}
+
+func (m *ThreadVerification_Issue) GetNode() string {
+	if m != nil {
+		return m.Node
+	}
+	return ""
+}
+
+func (m *ThreadVerification_Issue) GetBlock() string {
+	if m != nil {
+		return m.Block
+	}
+	return ""
+}
+
+func (m *ThreadVerification_Issue) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *ThreadVerification_Issue) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
 type Step struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Link                 *Link    `protobuf:"bytes,2,opt,name=link,proto3" json:"link,omitempty"`
@@ -358,7 +867,7 @@ func (m *Step) Reset()         { *m = Step{} }
 func (m *Step) String() string { return proto.CompactTextString(m) }
 func (*Step) ProtoMessage()    {}
 func (*Step) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{2}
+	return fileDescriptor_10c1b2aca93c333f, []int{4}
 }
 
 func (m *Step) XXX_Unmarshal(b []byte) error {
@@ -404,7 +913,7 @@ func (m *Directory) Reset()         { *m = Directory{} }
 func (m *Directory) String() string { return proto.CompactTextString(m) }
 func (*Directory) ProtoMessage()    {}
 func (*Directory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{3}
+	return fileDescriptor_10c1b2aca93c333f, []int{5}
 }
 
 func (m *Directory) XXX_Unmarshal(b []byte) error {
@@ -443,7 +952,7 @@ func (m *DirectoryList) Reset()         { *m = DirectoryList{} }
 func (m *DirectoryList) String() string { return proto.CompactTextString(m) }
 func (*DirectoryList) ProtoMessage()    {}
 func (*DirectoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{4}
+	return fileDescriptor_10c1b2aca93c333f, []int{6}
 }
 
 func (m *DirectoryList) XXX_Unmarshal(b []byte) error {
@@ -482,7 +991,7 @@ func (m *Keys) Reset()         { *m = Keys{} }
 func (m *Keys) String() string { return proto.CompactTextString(m) }
 func (*Keys) ProtoMessage()    {}
 func (*Keys) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{5}
+	return fileDescriptor_10c1b2aca93c333f, []int{7}
 }
 
 func (m *Keys) XXX_Unmarshal(b []byte) error {
@@ -510,6 +1019,86 @@ func (m *Keys) GetFiles() map[string]string {
 	return nil
 }
 
+// FileBatchUpdate reports the progress of a batch file add
+type FileBatchUpdate struct {
+	Group                string                 `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Name                 string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Status               FileBatchUpdate_Status `protobuf:"varint,3,opt,name=status,proto3,enum=FileBatchUpdate_Status" json:"status,omitempty"`
+	Dir                  *Directory             `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	Files                *Files                 `protobuf:"bytes,5,opt,name=files,proto3" json:"files,omitempty"`
+	Error                string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *FileBatchUpdate) Reset()         { *m = FileBatchUpdate{} }
+func (m *FileBatchUpdate) String() string { return proto.CompactTextString(m) }
+func (*FileBatchUpdate) ProtoMessage()    {}
+func (*FileBatchUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{8}
+}
+
+func (m *FileBatchUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FileBatchUpdate.Unmarshal(m, b)
+}
+func (m *FileBatchUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FileBatchUpdate.Marshal(b, m, deterministic)
+}
+func (m *FileBatchUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FileBatchUpdate.Merge(m, src)
+}
+func (m *FileBatchUpdate) XXX_Size() int {
+	return xxx_messageInfo_FileBatchUpdate.Size(m)
+}
+func (m *FileBatchUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FileBatchUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FileBatchUpdate proto.InternalMessageInfo
+
+func (m *FileBatchUpdate) GetGroup() string {
+	if m != nil {
+		return m.Group
+	}
+	return ""
+}
+
+func (m *FileBatchUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *FileBatchUpdate) GetStatus() FileBatchUpdate_Status {
+	if m != nil {
+		return m.Status
+	}
+	return FileBatchUpdate_QUEUED
+}
+
+func (m *FileBatchUpdate) GetDir() *Directory {
+	if m != nil {
+		return m.Dir
+	}
+	return nil
+}
+
+func (m *FileBatchUpdate) GetFiles() *Files {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *FileBatchUpdate) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type InviteView struct {
 	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -524,7 +1113,7 @@ func (m *InviteView) Reset()         { *m = InviteView{} }
 func (m *InviteView) String() string { return proto.CompactTextString(m) }
 func (*InviteView) ProtoMessage()    {}
 func (*InviteView) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{6}
+	return fileDescriptor_10c1b2aca93c333f, []int{9}
 }
 
 func (m *InviteView) XXX_Unmarshal(b []byte) error {
@@ -584,7 +1173,7 @@ func (m *InviteViewList) Reset()         { *m = InviteViewList{} }
 func (m *InviteViewList) String() string { return proto.CompactTextString(m) }
 func (*InviteViewList) ProtoMessage()    {}
 func (*InviteViewList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{7}
+	return fileDescriptor_10c1b2aca93c333f, []int{10}
 }
 
 func (m *InviteViewList) XXX_Unmarshal(b []byte) error {
@@ -625,7 +1214,7 @@ func (m *ExternalInvite) Reset()         { *m = ExternalInvite{} }
 func (m *ExternalInvite) String() string { return proto.CompactTextString(m) }
 func (*ExternalInvite) ProtoMessage()    {}
 func (*ExternalInvite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{8}
+	return fileDescriptor_10c1b2aca93c333f, []int{11}
 }
 
 func (m *ExternalInvite) XXX_Unmarshal(b []byte) error {
@@ -672,6 +1261,7 @@ type FeedRequest struct {
 	Offset               string           `protobuf:"bytes,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	Limit                int32            `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	Mode                 FeedRequest_Mode `protobuf:"varint,4,opt,name=mode,proto3,enum=FeedRequest_Mode" json:"mode,omitempty"`
+	Sort                 Block_BlockSort  `protobuf:"varint,5,opt,name=sort,proto3,enum=Block_BlockSort" json:"sort,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
 	XXX_unrecognized     []byte           `json:"-"`
 	XXX_sizecache        int32            `json:"-"`
@@ -681,7 +1271,7 @@ func (m *FeedRequest) Reset()         { *m = FeedRequest{} }
 func (m *FeedRequest) String() string { return proto.CompactTextString(m) }
 func (*FeedRequest) ProtoMessage()    {}
 func (*FeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{9}
+	return fileDescriptor_10c1b2aca93c333f, []int{12}
 }
 
 func (m *FeedRequest) XXX_Unmarshal(b []byte) error {
@@ -730,6 +1320,13 @@ func (m *FeedRequest) GetMode() FeedRequest_Mode {
 	return FeedRequest_CHRONO
 }
 
+func (m *FeedRequest) GetSort() Block_BlockSort {
+	if m != nil {
+		return m.Sort
+	}
+	return Block_DATE
+}
+
 type FeedItem struct {
 	Block                string   `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
 	Thread               string   `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
@@ -743,7 +1340,7 @@ func (m *FeedItem) Reset()         { *m = FeedItem{} }
 func (m *FeedItem) String() string { return proto.CompactTextString(m) }
 func (*FeedItem) ProtoMessage()    {}
 func (*FeedItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{10}
+	return fileDescriptor_10c1b2aca93c333f, []int{13}
 }
 
 func (m *FeedItem) XXX_Unmarshal(b []byte) error {
@@ -798,7 +1395,7 @@ func (m *FeedItemList) Reset()         { *m = FeedItemList{} }
 func (m *FeedItemList) String() string { return proto.CompactTextString(m) }
 func (*FeedItemList) ProtoMessage()    {}
 func (*FeedItemList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{11}
+	return fileDescriptor_10c1b2aca93c333f, []int{14}
 }
 
 func (m *FeedItemList) XXX_Unmarshal(b []byte) error {
@@ -855,7 +1452,7 @@ func (m *Merge) Reset()         { *m = Merge{} }
 func (m *Merge) String() string { return proto.CompactTextString(m) }
 func (*Merge) ProtoMessage()    {}
 func (*Merge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{12}
+	return fileDescriptor_10c1b2aca93c333f, []int{15}
 }
 
 func (m *Merge) XXX_Unmarshal(b []byte) error {
@@ -918,7 +1515,7 @@ func (m *Ignore) Reset()         { *m = Ignore{} }
 func (m *Ignore) String() string { return proto.CompactTextString(m) }
 func (*Ignore) ProtoMessage()    {}
 func (*Ignore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{13}
+	return fileDescriptor_10c1b2aca93c333f, []int{16}
 }
 
 func (m *Ignore) XXX_Unmarshal(b []byte) error {
@@ -981,7 +1578,7 @@ func (m *Flag) Reset()         { *m = Flag{} }
 func (m *Flag) String() string { return proto.CompactTextString(m) }
 func (*Flag) ProtoMessage()    {}
 func (*Flag) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{14}
+	return fileDescriptor_10c1b2aca93c333f, []int{17}
 }
 
 func (m *Flag) XXX_Unmarshal(b []byte) error {
@@ -1044,7 +1641,7 @@ func (m *Join) Reset()         { *m = Join{} }
 func (m *Join) String() string { return proto.CompactTextString(m) }
 func (*Join) ProtoMessage()    {}
 func (*Join) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{15}
+	return fileDescriptor_10c1b2aca93c333f, []int{18}
 }
 
 func (m *Join) XXX_Unmarshal(b []byte) error {
@@ -1106,7 +1703,7 @@ func (m *Announce) Reset()         { *m = Announce{} }
 func (m *Announce) String() string { return proto.CompactTextString(m) }
 func (*Announce) ProtoMessage()    {}
 func (*Announce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{16}
+	return fileDescriptor_10c1b2aca93c333f, []int{19}
 }
 
 func (m *Announce) XXX_Unmarshal(b []byte) error {
@@ -1162,7 +1759,7 @@ func (m *Leave) Reset()         { *m = Leave{} }
 func (m *Leave) String() string { return proto.CompactTextString(m) }
 func (*Leave) ProtoMessage()    {}
 func (*Leave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{17}
+	return fileDescriptor_10c1b2aca93c333f, []int{20}
 }
 
 func (m *Leave) XXX_Unmarshal(b []byte) error {
@@ -1227,7 +1824,7 @@ func (m *Text) Reset()         { *m = Text{} }
 func (m *Text) String() string { return proto.CompactTextString(m) }
 func (*Text) ProtoMessage()    {}
 func (*Text) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{18}
+	return fileDescriptor_10c1b2aca93c333f, []int{21}
 }
 
 func (m *Text) XXX_Unmarshal(b []byte) error {
@@ -1301,7 +1898,7 @@ func (m *TextList) Reset()         { *m = TextList{} }
 func (m *TextList) String() string { return proto.CompactTextString(m) }
 func (*TextList) ProtoMessage()    {}
 func (*TextList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{19}
+	return fileDescriptor_10c1b2aca93c333f, []int{22}
 }
 
 func (m *TextList) XXX_Unmarshal(b []byte) error {
@@ -1342,7 +1939,7 @@ func (m *File) Reset()         { *m = File{} }
 func (m *File) String() string { return proto.CompactTextString(m) }
 func (*File) ProtoMessage()    {}
 func (*File) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{20}
+	return fileDescriptor_10c1b2aca93c333f, []int{23}
 }
 
 func (m *File) XXX_Unmarshal(b []byte) error {
@@ -1404,7 +2001,7 @@ func (m *Files) Reset()         { *m = Files{} }
 func (m *Files) String() string { return proto.CompactTextString(m) }
 func (*Files) ProtoMessage()    {}
 func (*Files) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{21}
+	return fileDescriptor_10c1b2aca93c333f, []int{24}
 }
 
 func (m *Files) XXX_Unmarshal(b []byte) error {
@@ -1506,7 +2103,7 @@ func (m *FilesList) Reset()         { *m = FilesList{} }
 func (m *FilesList) String() string { return proto.CompactTextString(m) }
 func (*FilesList) ProtoMessage()    {}
 func (*FilesList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{22}
+	return fileDescriptor_10c1b2aca93c333f, []int{25}
 }
 
 func (m *FilesList) XXX_Unmarshal(b []byte) error {
@@ -1550,7 +2147,7 @@ func (m *Comment) Reset()         { *m = Comment{} }
 func (m *Comment) String() string { return proto.CompactTextString(m) }
 func (*Comment) ProtoMessage()    {}
 func (*Comment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{23}
+	return fileDescriptor_10c1b2aca93c333f, []int{26}
 }
 
 func (m *Comment) XXX_Unmarshal(b []byte) error {
@@ -1618,7 +2215,7 @@ func (m *CommentList) Reset()         { *m = CommentList{} }
 func (m *CommentList) String() string { return proto.CompactTextString(m) }
 func (*CommentList) ProtoMessage()    {}
 func (*CommentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{24}
+	return fileDescriptor_10c1b2aca93c333f, []int{27}
 }
 
 func (m *CommentList) XXX_Unmarshal(b []byte) error {
@@ -1660,7 +2257,7 @@ func (m *Like) Reset()         { *m = Like{} }
 func (m *Like) String() string { return proto.CompactTextString(m) }
 func (*Like) ProtoMessage()    {}
 func (*Like) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{25}
+	return fileDescriptor_10c1b2aca93c333f, []int{28}
 }
 
 func (m *Like) XXX_Unmarshal(b []byte) error {
@@ -1720,7 +2317,7 @@ func (m *LikeList) Reset()         { *m = LikeList{} }
 func (m *LikeList) String() string { return proto.CompactTextString(m) }
 func (*LikeList) ProtoMessage()    {}
 func (*LikeList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{26}
+	return fileDescriptor_10c1b2aca93c333f, []int{29}
 }
 
 func (m *LikeList) XXX_Unmarshal(b []byte) error {
@@ -1761,7 +2358,7 @@ func (m *AccountUpdate) Reset()         { *m = AccountUpdate{} }
 func (m *AccountUpdate) String() string { return proto.CompactTextString(m) }
 func (*AccountUpdate) ProtoMessage()    {}
 func (*AccountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{27}
+	return fileDescriptor_10c1b2aca93c333f, []int{30}
 }
 
 func (m *AccountUpdate) XXX_Unmarshal(b []byte) error {
@@ -1820,7 +2417,7 @@ func (m *Summary) Reset()         { *m = Summary{} }
 func (m *Summary) String() string { return proto.CompactTextString(m) }
 func (*Summary) ProtoMessage()    {}
 func (*Summary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{28}
+	return fileDescriptor_10c1b2aca93c333f, []int{31}
 }
 
 func (m *Summary) XXX_Unmarshal(b []byte) error {
@@ -1883,6 +2480,227 @@ func (m *Summary) GetContactCount() int32 {
 	return 0
 }
 
+type QueueStats struct {
+	Queue                QueueStats_Queue     `protobuf:"varint,1,opt,name=queue,proto3,enum=QueueStats_Queue" json:"queue,omitempty"`
+	Count                int32                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Statuses             map[string]int32     `protobuf:"bytes,3,rep,name=statuses,proto3" json:"statuses,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Peers                map[string]int32     `protobuf:"bytes,4,rep,name=peers,proto3" json:"peers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Backoff              int32                `protobuf:"varint,5,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	Oldest               *timestamp.Timestamp `protobuf:"bytes,6,opt,name=oldest,proto3" json:"oldest,omitempty"`
+	OldestAge            int64                `protobuf:"varint,7,opt,name=oldest_age,json=oldestAge,proto3" json:"oldest_age,omitempty"`
+	Dead                 int32                `protobuf:"varint,8,opt,name=dead,proto3" json:"dead,omitempty"`
+	LastError            string               `protobuf:"bytes,9,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorDate        *timestamp.Timestamp `protobuf:"bytes,10,opt,name=last_error_date,json=lastErrorDate,proto3" json:"last_error_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *QueueStats) Reset()         { *m = QueueStats{} }
+func (m *QueueStats) String() string { return proto.CompactTextString(m) }
+func (*QueueStats) ProtoMessage()    {}
+func (*QueueStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{32}
+}
+
+func (m *QueueStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueStats.Unmarshal(m, b)
+}
+func (m *QueueStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueStats.Marshal(b, m, deterministic)
+}
+func (m *QueueStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueStats.Merge(m, src)
+}
+func (m *QueueStats) XXX_Size() int {
+	return xxx_messageInfo_QueueStats.Size(m)
+}
+func (m *QueueStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueStats proto.InternalMessageInfo
+
+func (m *QueueStats) GetQueue() QueueStats_Queue {
+	if m != nil {
+		return m.Queue
+	}
+	return QueueStats_BLOCK_MESSAGES
+}
+
+func (m *QueueStats) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueueStats) GetStatuses() map[string]int32 {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *QueueStats) GetPeers() map[string]int32 {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *QueueStats) GetBackoff() int32 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *QueueStats) GetOldest() *timestamp.Timestamp {
+	if m != nil {
+		return m.Oldest
+	}
+	return nil
+}
+
+func (m *QueueStats) GetOldestAge() int64 {
+	if m != nil {
+		return m.OldestAge
+	}
+	return 0
+}
+
+func (m *QueueStats) GetDead() int32 {
+	if m != nil {
+		return m.Dead
+	}
+	return 0
+}
+
+func (m *QueueStats) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *QueueStats) GetLastErrorDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastErrorDate
+	}
+	return nil
+}
+
+type QueueStatsList struct {
+	Items                []*QueueStats `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QueueStatsList) Reset()         { *m = QueueStatsList{} }
+func (m *QueueStatsList) String() string { return proto.CompactTextString(m) }
+func (*QueueStatsList) ProtoMessage()    {}
+func (*QueueStatsList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{33}
+}
+
+func (m *QueueStatsList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueueStatsList.Unmarshal(m, b)
+}
+func (m *QueueStatsList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueueStatsList.Marshal(b, m, deterministic)
+}
+func (m *QueueStatsList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueueStatsList.Merge(m, src)
+}
+func (m *QueueStatsList) XXX_Size() int {
+	return xxx_messageInfo_QueueStatsList.Size(m)
+}
+func (m *QueueStatsList) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueueStatsList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueueStatsList proto.InternalMessageInfo
+
+func (m *QueueStatsList) GetItems() []*QueueStats {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type CafeGCReport struct {
+	Started              *timestamp.Timestamp `protobuf:"bytes,1,opt,name=started,proto3" json:"started,omitempty"`
+	Finished             *timestamp.Timestamp `protobuf:"bytes,2,opt,name=finished,proto3" json:"finished,omitempty"`
+	Removed              int32                `protobuf:"varint,3,opt,name=removed,proto3" json:"removed,omitempty"`
+	Freed                int64                `protobuf:"varint,4,opt,name=freed,proto3" json:"freed,omitempty"`
+	Error                string               `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *CafeGCReport) Reset()         { *m = CafeGCReport{} }
+func (m *CafeGCReport) String() string { return proto.CompactTextString(m) }
+func (*CafeGCReport) ProtoMessage()    {}
+func (*CafeGCReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_10c1b2aca93c333f, []int{34}
+}
+
+func (m *CafeGCReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CafeGCReport.Unmarshal(m, b)
+}
+func (m *CafeGCReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CafeGCReport.Marshal(b, m, deterministic)
+}
+func (m *CafeGCReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CafeGCReport.Merge(m, src)
+}
+func (m *CafeGCReport) XXX_Size() int {
+	return xxx_messageInfo_CafeGCReport.Size(m)
+}
+func (m *CafeGCReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_CafeGCReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CafeGCReport proto.InternalMessageInfo
+
+func (m *CafeGCReport) GetStarted() *timestamp.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *CafeGCReport) GetFinished() *timestamp.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *CafeGCReport) GetRemoved() int32 {
+	if m != nil {
+		return m.Removed
+	}
+	return 0
+}
+
+func (m *CafeGCReport) GetFreed() int64 {
+	if m != nil {
+		return m.Freed
+	}
+	return 0
+}
+
+func (m *CafeGCReport) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type LogLevel struct {
 	Systems              map[string]LogLevel_Level `protobuf:"bytes,1,rep,name=systems,proto3" json:"systems,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3,enum=LogLevel_Level"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
@@ -1894,7 +2712,7 @@ func (m *LogLevel) Reset()         { *m = LogLevel{} }
 func (m *LogLevel) String() string { return proto.CompactTextString(m) }
 func (*LogLevel) ProtoMessage()    {}
 func (*LogLevel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{29}
+	return fileDescriptor_10c1b2aca93c333f, []int{35}
 }
 
 func (m *LogLevel) XXX_Unmarshal(b []byte) error {
@@ -1933,7 +2751,7 @@ func (m *Strings) Reset()         { *m = Strings{} }
 func (m *Strings) String() string { return proto.CompactTextString(m) }
 func (*Strings) ProtoMessage()    {}
 func (*Strings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_10c1b2aca93c333f, []int{30}
+	return fileDescriptor_10c1b2aca93c333f, []int{36}
 }
 
 func (m *Strings) XXX_Unmarshal(b []byte) error {
@@ -1963,18 +2781,27 @@ func (m *Strings) GetValues() []string {
 
 func init() {
 	proto.RegisterEnum("AddThreadConfig_Schema_Preset", AddThreadConfig_Schema_Preset_name, AddThreadConfig_Schema_Preset_value)
+	proto.RegisterEnum("ThreadVerification_Issue_Type", ThreadVerification_Issue_Type_name, ThreadVerification_Issue_Type_value)
+	proto.RegisterEnum("FileBatchUpdate_Status", FileBatchUpdate_Status_name, FileBatchUpdate_Status_value)
 	proto.RegisterEnum("FeedRequest_Mode", FeedRequest_Mode_name, FeedRequest_Mode_value)
 	proto.RegisterEnum("AccountUpdate_Type", AccountUpdate_Type_name, AccountUpdate_Type_value)
+	proto.RegisterEnum("QueueStats_Queue", QueueStats_Queue_name, QueueStats_Queue_value)
 	proto.RegisterEnum("LogLevel_Level", LogLevel_Level_name, LogLevel_Level_value)
 	proto.RegisterType((*AddThreadConfig)(nil), "AddThreadConfig")
 	proto.RegisterType((*AddThreadConfig_Schema)(nil), "AddThreadConfig.Schema")
 	proto.RegisterType((*BlockViz)(nil), "BlockViz")
+	proto.RegisterType((*ThreadGraph)(nil), "ThreadGraph")
+	proto.RegisterType((*ThreadGraph_Node)(nil), "ThreadGraph.Node")
+	proto.RegisterType((*ThreadGraph_Edge)(nil), "ThreadGraph.Edge")
+	proto.RegisterType((*ThreadVerification)(nil), "ThreadVerification")
+	proto.RegisterType((*ThreadVerification_Issue)(nil), "ThreadVerification.Issue")
 	proto.RegisterType((*Step)(nil), "Step")
 	proto.RegisterType((*Directory)(nil), "Directory")
 	proto.RegisterMapType((map[string]*FileIndex)(nil), "Directory.FilesEntry")
 	proto.RegisterType((*DirectoryList)(nil), "DirectoryList")
 	proto.RegisterType((*Keys)(nil), "Keys")
 	proto.RegisterMapType((map[string]string)(nil), "Keys.FilesEntry")
+	proto.RegisterType((*FileBatchUpdate)(nil), "FileBatchUpdate")
 	proto.RegisterType((*InviteView)(nil), "InviteView")
 	proto.RegisterType((*InviteViewList)(nil), "InviteViewList")
 	proto.RegisterType((*ExternalInvite)(nil), "ExternalInvite")
@@ -1999,6 +2826,11 @@ func init() {
 	proto.RegisterType((*LikeList)(nil), "LikeList")
 	proto.RegisterType((*AccountUpdate)(nil), "AccountUpdate")
 	proto.RegisterType((*Summary)(nil), "Summary")
+	proto.RegisterType((*QueueStats)(nil), "QueueStats")
+	proto.RegisterMapType((map[string]int32)(nil), "QueueStats.PeersEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "QueueStats.StatusesEntry")
+	proto.RegisterType((*QueueStatsList)(nil), "QueueStatsList")
+	proto.RegisterType((*CafeGCReport)(nil), "CafeGCReport")
 	proto.RegisterType((*LogLevel)(nil), "LogLevel")
 	proto.RegisterMapType((map[string]LogLevel_Level)(nil), "LogLevel.SystemsEntry")
 	proto.RegisterType((*Strings)(nil), "Strings")
@@ -2007,99 +2839,152 @@ func init() {
 func init() { proto.RegisterFile("view.proto", fileDescriptor_10c1b2aca93c333f) }
 
 var fileDescriptor_10c1b2aca93c333f = []byte{
-	// 1496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xdd, 0x72, 0xdb, 0x44,
-	0x1b, 0x8e, 0x64, 0xc9, 0xb6, 0x5e, 0x27, 0xa9, 0xbe, 0x6d, 0xbe, 0x7e, 0x6a, 0xda, 0x69, 0x1c,
-	0xf5, 0x2b, 0x4d, 0x07, 0x50, 0x69, 0x3a, 0x30, 0x9d, 0x9e, 0x29, 0xb6, 0xd2, 0x9a, 0x3a, 0x76,
-	0x67, 0xed, 0x94, 0x81, 0x03, 0x32, 0x8a, 0xb5, 0x71, 0x44, 0x6c, 0xc9, 0x48, 0xeb, 0x34, 0xe6,
-	0x80, 0x19, 0x66, 0xe0, 0xa4, 0xc3, 0x09, 0x37, 0x00, 0xa7, 0xc0, 0x45, 0xf4, 0x02, 0xb8, 0x03,
-	0xee, 0x86, 0xd9, 0x1f, 0xf9, 0x27, 0x76, 0x69, 0xcb, 0x4c, 0x80, 0x13, 0x8f, 0xde, 0x1f, 0xef,
-	0x3e, 0xef, 0xff, 0xbb, 0x00, 0xa7, 0x21, 0x79, 0xee, 0x0c, 0x92, 0x98, 0xc6, 0xeb, 0x57, 0xbb,
-	0x71, 0xdc, 0xed, 0x91, 0xbb, 0x9c, 0x3a, 0x1c, 0x1e, 0xdd, 0xf5, 0xa3, 0x91, 0x14, 0x6d, 0x9c,
-	0x17, 0xd1, 0xb0, 0x4f, 0x52, 0xea, 0xf7, 0x07, 0x52, 0xa1, 0xd4, 0x8f, 0x03, 0xd2, 0x13, 0x84,
-	0xfd, 0x22, 0x07, 0x97, 0xdc, 0x20, 0x68, 0x1f, 0x27, 0xc4, 0x0f, 0x2a, 0x71, 0x74, 0x14, 0x76,
-	0x91, 0x09, 0xb9, 0x13, 0x32, 0xb2, 0x94, 0xb2, 0xb2, 0x65, 0x60, 0xf6, 0x89, 0x10, 0x68, 0x91,
-	0xdf, 0x27, 0x96, 0xca, 0x59, 0xfc, 0x1b, 0xdd, 0x85, 0x7c, 0xda, 0x39, 0x26, 0x7d, 0xdf, 0xca,
-	0x95, 0x95, 0xad, 0xd2, 0xf6, 0xff, 0x9c, 0x73, 0xe7, 0x38, 0x2d, 0x2e, 0xc6, 0x52, 0x0d, 0x95,
-	0x41, 0xa3, 0xa3, 0x01, 0xb1, 0xb4, 0xb2, 0xb2, 0xb5, 0xba, 0xbd, 0xec, 0x08, 0x5d, 0xa7, 0x3d,
-	0x1a, 0x10, 0xcc, 0x25, 0xe8, 0x0e, 0x14, 0xd2, 0x63, 0x3f, 0x09, 0xa3, 0xae, 0xa5, 0x73, 0xa5,
-	0x4b, 0x99, 0x52, 0x4b, 0xb0, 0x71, 0x26, 0x47, 0xd7, 0xc1, 0x78, 0x7e, 0x1c, 0x52, 0xd2, 0x0b,
-	0x53, 0x6a, 0xe5, 0xcb, 0xb9, 0x2d, 0x03, 0x4f, 0x18, 0x68, 0x0d, 0xf4, 0xa3, 0x38, 0xe9, 0x10,
-	0xab, 0x50, 0x56, 0xb6, 0x8a, 0x58, 0x10, 0xeb, 0x3f, 0x2a, 0x90, 0x17, 0x98, 0xd0, 0x2a, 0xa8,
-	0x61, 0x20, 0x2d, 0x54, 0xc3, 0x80, 0x19, 0xf8, 0x45, 0x1a, 0x47, 0x99, 0x81, 0xec, 0x1b, 0x7d,
-	0x04, 0xf9, 0x41, 0x42, 0x52, 0x42, 0xb9, 0x81, 0xab, 0xdb, 0x37, 0x5e, 0x61, 0xa0, 0xf3, 0x94,
-	0x6b, 0x61, 0xa9, 0x6d, 0x3f, 0x80, 0xbc, 0xe0, 0xa0, 0x22, 0x68, 0x8d, 0x66, 0xc3, 0x33, 0x97,
-	0xd8, 0xd7, 0x4e, 0xbd, 0xb9, 0x63, 0x2a, 0xe8, 0x12, 0x94, 0x2a, 0xee, 0x9e, 0x87, 0xdd, 0x03,
-	0xdc, 0xac, 0xd7, 0x4d, 0x15, 0x19, 0xa0, 0xef, 0x79, 0xd5, 0x9a, 0x6b, 0xe6, 0xec, 0xc7, 0x50,
-	0xdc, 0xe9, 0xc5, 0x9d, 0x93, 0x67, 0xe1, 0x57, 0x0c, 0x51, 0x10, 0xd3, 0x54, 0x62, 0xe4, 0xdf,
-	0xcc, 0xac, 0x4e, 0x3c, 0x8c, 0x28, 0x87, 0xa9, 0x63, 0x41, 0xf0, 0xe0, 0x90, 0x33, 0x81, 0x92,
-	0x05, 0x87, 0x9c, 0x51, 0xfb, 0x43, 0xd0, 0x5a, 0x94, 0x0c, 0xc6, 0x81, 0x53, 0xa6, 0x02, 0x77,
-	0x15, 0xb4, 0x5e, 0x18, 0x9d, 0xf0, 0x43, 0x4a, 0xdb, 0xba, 0x53, 0x0f, 0xa3, 0x13, 0xcc, 0x59,
-	0xf6, 0xd7, 0x60, 0x54, 0xc3, 0x84, 0x74, 0x68, 0x9c, 0x8c, 0xd0, 0xbb, 0xa0, 0x1f, 0x85, 0x3d,
-	0xc2, 0x20, 0xe4, 0xb6, 0x4a, 0xdb, 0xff, 0x75, 0xc6, 0x22, 0x67, 0x97, 0xf1, 0xbd, 0x88, 0x26,
-	0x23, 0x2c, 0x74, 0xd6, 0xab, 0x00, 0x13, 0xe6, 0x82, 0x0c, 0x2a, 0x83, 0x7e, 0xea, 0xf7, 0x86,
-	0x44, 0xde, 0x0a, 0xfc, 0x88, 0x5a, 0x14, 0x90, 0x33, 0x2c, 0x04, 0x0f, 0xd5, 0x07, 0x8a, 0x7d,
-	0x0f, 0x56, 0xc6, 0x97, 0xd4, 0x59, 0x20, 0xcb, 0xa0, 0x87, 0x94, 0xf4, 0x33, 0x0c, 0x30, 0xc1,
-	0x80, 0x85, 0xc0, 0x3e, 0x06, 0xed, 0x09, 0x19, 0xa5, 0xe8, 0x9d, 0x59, 0xb4, 0xa6, 0xc3, 0xb8,
-	0x0b, 0x80, 0x3e, 0x78, 0x0d, 0xd0, 0xb5, 0x69, 0xa0, 0xc6, 0x34, 0xb8, 0x6f, 0x14, 0x80, 0x5a,
-	0x74, 0x1a, 0x52, 0xf2, 0x2c, 0x24, 0xcf, 0x17, 0xa5, 0xd0, 0x5c, 0x8d, 0x6c, 0x40, 0x21, 0xe4,
-	0xff, 0x48, 0x64, 0x91, 0xe8, 0xce, 0x7e, 0x4a, 0x12, 0x9c, 0x71, 0x91, 0x03, 0x5a, 0xe0, 0x53,
-	0x51, 0x13, 0xa5, 0xed, 0x75, 0x47, 0xd4, 0xae, 0x93, 0xd5, 0xae, 0xd3, 0xce, 0x6a, 0x17, 0x73,
-	0x3d, 0xfb, 0x3e, 0xac, 0x4e, 0x20, 0x70, 0x0f, 0x6d, 0xce, 0x7a, 0xa8, 0xe4, 0x4c, 0xe4, 0x99,
-	0x8b, 0xea, 0xb0, 0xea, 0x9d, 0x51, 0x92, 0x44, 0x7e, 0x4f, 0x08, 0xe7, 0xb0, 0x4b, 0x37, 0xa8,
-	0x13, 0x37, 0x58, 0xb3, 0xc8, 0x8d, 0x31, 0x64, 0xfb, 0x17, 0x05, 0x4a, 0xbb, 0x84, 0x04, 0x98,
-	0x7c, 0x39, 0x24, 0x29, 0x45, 0x57, 0x20, 0x4f, 0x79, 0x51, 0xc8, 0xf3, 0x24, 0xc5, 0xf8, 0xf1,
-	0xd1, 0x11, 0x2b, 0x1f, 0x71, 0xac, 0xa4, 0x98, 0x83, 0x7b, 0x61, 0x3f, 0x14, 0xf9, 0xaa, 0x63,
-	0x41, 0xa0, 0x5b, 0xa0, 0xb1, 0xb6, 0x24, 0x9b, 0xc3, 0x7f, 0x9c, 0xa9, 0x1b, 0x9c, 0xbd, 0x38,
-	0x20, 0x98, 0x8b, 0xed, 0xf7, 0x41, 0x63, 0x14, 0x02, 0xc8, 0x57, 0x1e, 0xe3, 0x66, 0xa3, 0x69,
-	0x2e, 0xa1, 0x15, 0x30, 0xdc, 0x46, 0xa3, 0xd9, 0x76, 0xdb, 0x5e, 0xd5, 0x54, 0x98, 0xa8, 0xd5,
-	0x76, 0x2b, 0x4f, 0x5a, 0xa6, 0x6a, 0x1f, 0x43, 0x91, 0x1d, 0x54, 0xa3, 0xa4, 0xcf, 0xee, 0x3d,
-	0x64, 0xc5, 0x25, 0x61, 0x0a, 0x62, 0x0a, 0xbd, 0x3a, 0x83, 0xde, 0x81, 0xc2, 0xc0, 0x1f, 0xf5,
-	0x62, 0x3f, 0x90, 0x91, 0x5b, 0x9b, 0x8b, 0x8d, 0x1b, 0x8d, 0x70, 0xa6, 0x64, 0x7f, 0x0a, 0xcb,
-	0xd9, 0x4d, 0x3c, 0x2c, 0x1b, 0xb3, 0x61, 0x31, 0x9c, 0x4c, 0x2a, 0x83, 0xf2, 0x16, 0xb5, 0xfc,
-	0x83, 0x02, 0xfa, 0x1e, 0x49, 0xba, 0xe4, 0x15, 0x26, 0x64, 0x39, 0xa4, 0xbe, 0x59, 0x0e, 0xb1,
-	0xfa, 0x1f, 0xa6, 0xe7, 0x33, 0x92, 0xb3, 0xd0, 0x4d, 0x28, 0x50, 0x3f, 0xe9, 0x12, 0x9a, 0x5a,
-	0xda, 0x79, 0xdc, 0x99, 0xe4, 0xa1, 0x6a, 0x29, 0xf6, 0xf7, 0x0a, 0xe4, 0x6b, 0xdd, 0x28, 0x4e,
-	0xfe, 0x06, 0x50, 0x9b, 0x90, 0x17, 0x57, 0xcb, 0x2a, 0x99, 0xc2, 0x24, 0x05, 0xf6, 0x0b, 0x05,
-	0xb4, 0xdd, 0x9e, 0xdf, 0xfd, 0x57, 0x80, 0xf9, 0x56, 0x01, 0xed, 0xe3, 0x38, 0x8c, 0x2e, 0x1e,
-	0xcc, 0x35, 0x56, 0x4a, 0x27, 0x24, 0x0b, 0x16, 0x6b, 0xe5, 0x27, 0x04, 0x0b, 0x9e, 0x7d, 0x02,
-	0x45, 0x37, 0x8a, 0xe2, 0x61, 0xd4, 0xb9, 0xf8, 0x18, 0xd9, 0xdf, 0x29, 0xa0, 0xd7, 0x89, 0x7f,
-	0x4a, 0xfe, 0x61, 0xa3, 0x5f, 0x2a, 0xa0, 0xb5, 0xc9, 0x19, 0xbd, 0x78, 0x18, 0x08, 0xb4, 0xc3,
-	0x38, 0x18, 0xf1, 0x34, 0x30, 0x30, 0xff, 0x46, 0xff, 0x87, 0x62, 0x27, 0xee, 0xf7, 0x49, 0x44,
-	0x53, 0x4b, 0xe7, 0xe8, 0x8a, 0x4e, 0x45, 0x30, 0xf0, 0x58, 0x32, 0x31, 0x20, 0xbf, 0xc0, 0x80,
-	0xdb, 0x50, 0x64, 0xf8, 0x79, 0x0f, 0xb9, 0x36, 0xdb, 0x43, 0x74, 0x87, 0x49, 0xb2, 0xa6, 0xfe,
-	0x2b, 0x4b, 0xf9, 0xb0, 0xc7, 0x1d, 0x1e, 0xb2, 0x39, 0xca, 0x2d, 0xd5, 0xb1, 0x20, 0xd0, 0x0d,
-	0xd0, 0xd8, 0xbc, 0x5b, 0x30, 0x6e, 0x39, 0x9f, 0x8d, 0x4b, 0x36, 0xf1, 0x53, 0x2b, 0x27, 0xc7,
-	0x25, 0x53, 0xe0, 0xab, 0x40, 0x36, 0x2e, 0xb9, 0x98, 0xcd, 0xf5, 0x09, 0xf3, 0x2f, 0xcf, 0xf5,
-	0x9f, 0x55, 0xd0, 0xf9, 0xd4, 0xfd, 0x93, 0x2e, 0x2c, 0xaa, 0x2a, 0xeb, 0xc2, 0x9c, 0xe2, 0x4b,
-	0x90, 0x4f, 0x7d, 0x0b, 0xe4, 0x12, 0xe4, 0x53, 0x7f, 0x1c, 0xc3, 0xdc, 0x5b, 0xc6, 0x50, 0x9b,
-	0x8f, 0xa1, 0x05, 0x85, 0x8e, 0x3f, 0xa0, 0x61, 0x1c, 0xf1, 0x7d, 0xd3, 0xc0, 0x19, 0xc9, 0x5c,
-	0x2f, 0xb6, 0x89, 0x2c, 0x46, 0x0c, 0xbd, 0x5c, 0x21, 0x66, 0xc2, 0x5c, 0x78, 0x7d, 0x98, 0x8b,
-	0xf3, 0x61, 0x66, 0x37, 0x8b, 0x41, 0x93, 0x5a, 0x06, 0x5f, 0x5e, 0x33, 0xd2, 0xbe, 0x03, 0x06,
-	0xf7, 0x14, 0xcf, 0x80, 0xeb, 0xb3, 0x19, 0x90, 0x17, 0xfb, 0x4c, 0x96, 0x02, 0x3f, 0x29, 0x50,
-	0x90, 0xf7, 0xce, 0x4d, 0xf4, 0x0b, 0xce, 0xf4, 0x49, 0x1b, 0xd4, 0x5f, 0xd1, 0x06, 0xf9, 0x98,
-	0xb8, 0x07, 0x25, 0x09, 0x90, 0x9b, 0x73, 0x63, 0xd6, 0x9c, 0x89, 0xd7, 0x04, 0x9b, 0xff, 0x85,
-	0x75, 0x4f, 0xe6, 0xa9, 0x8b, 0xb4, 0xe8, 0x0d, 0x9a, 0xf8, 0x6d, 0x28, 0x32, 0x14, 0x8b, 0xeb,
-	0x50, 0x44, 0x52, 0x04, 0xe1, 0xa5, 0x02, 0x2b, 0x6e, 0x87, 0x4f, 0xef, 0xfd, 0x01, 0xbf, 0xf8,
-	0x3c, 0xf0, 0xb5, 0xa9, 0xe5, 0x6a, 0x47, 0xb5, 0x14, 0x51, 0x38, 0xb7, 0xe5, 0x6b, 0x48, 0xbc,
-	0x2d, 0x2e, 0x3b, 0x33, 0x67, 0x4c, 0x3d, 0x8a, 0xec, 0xcf, 0x41, 0x63, 0x14, 0x32, 0x61, 0xb9,
-	0xfd, 0x18, 0x7b, 0x6e, 0xf5, 0xc0, 0xad, 0x56, 0xbd, 0xaa, 0xb9, 0x84, 0x10, 0xac, 0x4a, 0x0e,
-	0xf6, 0xf6, 0x9a, 0xcf, 0xf8, 0xf6, 0x73, 0x05, 0x90, 0x5b, 0xa9, 0x34, 0xf7, 0x1b, 0xed, 0x83,
-	0xa7, 0x9e, 0x87, 0xa5, 0xae, 0x8a, 0x2c, 0x58, 0x9b, 0xe1, 0x67, 0xff, 0xc8, 0xd9, 0xbf, 0x29,
-	0x50, 0x68, 0x0d, 0xfb, 0x7d, 0x3f, 0x19, 0xcd, 0x41, 0xb7, 0xa0, 0xe0, 0x07, 0x41, 0x42, 0xd2,
-	0x54, 0x16, 0x66, 0x46, 0xa2, 0xf7, 0x00, 0xf9, 0x02, 0xf1, 0xc1, 0x80, 0x90, 0xe4, 0x40, 0xec,
-	0x32, 0x62, 0xa5, 0x33, 0xa5, 0xe4, 0x29, 0x21, 0x49, 0x85, 0xaf, 0x35, 0x9b, 0xb0, 0x2c, 0xf2,
-	0x5b, 0xea, 0x69, 0x5c, 0xaf, 0x44, 0xe5, 0x63, 0x8a, 0xa9, 0x6c, 0x40, 0x89, 0x57, 0x97, 0xd4,
-	0xd0, 0xb9, 0x06, 0x70, 0x96, 0x50, 0xb8, 0x09, 0x2b, 0x9d, 0x38, 0xa2, 0x7e, 0x87, 0x4a, 0x95,
-	0x3c, 0x57, 0x59, 0x96, 0x4c, 0xae, 0x64, 0xff, 0xae, 0x40, 0xb1, 0x1e, 0x77, 0xeb, 0xe4, 0x94,
-	0xf4, 0xd0, 0x07, 0x50, 0x48, 0x47, 0xe9, 0x54, 0xe4, 0xae, 0x38, 0x99, 0xcc, 0x69, 0x09, 0x81,
-	0xe8, 0x75, 0x99, 0xda, 0xfa, 0x13, 0x58, 0x9e, 0x16, 0x2c, 0xe8, 0x77, 0xb7, 0xa6, 0xfb, 0x1d,
-	0x7b, 0xa0, 0x8e, 0x4f, 0xe4, 0xbf, 0xd3, 0x4d, 0xaf, 0xc1, 0x46, 0x22, 0xc3, 0xb1, 0x0c, 0xc5,
-	0x0a, 0xae, 0xb5, 0x6b, 0x15, 0xb7, 0x6e, 0x2e, 0xb1, 0xf7, 0x9e, 0x87, 0x71, 0x13, 0x9b, 0x0a,
-	0x2a, 0x41, 0xe1, 0x13, 0x17, 0x37, 0x6a, 0x8d, 0x47, 0xa6, 0xca, 0xf6, 0xd6, 0x46, 0xb3, 0x5d,
-	0xab, 0x78, 0x66, 0x8e, 0x3d, 0x17, 0x6b, 0x8d, 0xdd, 0xa6, 0xa9, 0x31, 0xed, 0xaa, 0xb7, 0xb3,
-	0xff, 0xc8, 0xd4, 0xed, 0x4d, 0x28, 0xb4, 0x28, 0x7b, 0xfc, 0xa6, 0xac, 0x5f, 0xf2, 0x7b, 0x84,
-	0x61, 0x06, 0x96, 0xd4, 0xce, 0x65, 0x58, 0x09, 0x63, 0x87, 0x92, 0x33, 0xca, 0xba, 0xf9, 0xe0,
-	0xf0, 0x33, 0x75, 0x70, 0x78, 0x98, 0xe7, 0x25, 0x72, 0xff, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xae, 0xbe, 0xa8, 0x37, 0x40, 0x10, 0x00, 0x00,
+	// 2351 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x38, 0xcb, 0x6f, 0xe3, 0xc6,
+	0xf9, 0x26, 0x45, 0xea, 0xf1, 0xc9, 0x0f, 0xee, 0xc4, 0x3f, 0x47, 0xeb, 0xec, 0x2f, 0xeb, 0x65,
+	0x92, 0xae, 0xd3, 0xa6, 0x4c, 0xe3, 0x34, 0x41, 0x90, 0x9e, 0x64, 0x89, 0xf6, 0xaa, 0x2b, 0x4b,
+	0xde, 0x91, 0xbc, 0x49, 0x7b, 0xa8, 0x40, 0x8b, 0x23, 0x99, 0xb5, 0x44, 0x2a, 0xe4, 0xc8, 0x6b,
+	0xf5, 0x50, 0xa0, 0x40, 0x7b, 0x09, 0x0a, 0x14, 0x3d, 0xf5, 0xd6, 0x9e, 0x0a, 0x14, 0x01, 0xfa,
+	0x2f, 0xe4, 0xd0, 0x63, 0x2f, 0x3d, 0x17, 0xe8, 0x3f, 0xd1, 0xff, 0xa0, 0xf8, 0x66, 0x86, 0x12,
+	0xe5, 0x47, 0x76, 0xb7, 0xc0, 0xb6, 0xbd, 0x10, 0xf3, 0x3d, 0x86, 0xf3, 0xbd, 0xe6, 0x7b, 0x0c,
+	0xc0, 0x45, 0xc0, 0x9e, 0x39, 0x93, 0x38, 0xe2, 0xd1, 0xf6, 0xdd, 0x61, 0x14, 0x0d, 0x47, 0xec,
+	0x7d, 0x01, 0x9d, 0x4e, 0x07, 0xef, 0x7b, 0xe1, 0x4c, 0x91, 0xee, 0x5f, 0x25, 0xf1, 0x60, 0xcc,
+	0x12, 0xee, 0x8d, 0x27, 0x8a, 0xa1, 0x3c, 0x8e, 0x7c, 0x36, 0x92, 0x80, 0xfd, 0x65, 0x0e, 0x36,
+	0xaa, 0xbe, 0xdf, 0x3d, 0x8b, 0x99, 0xe7, 0xd7, 0xa2, 0x70, 0x10, 0x0c, 0x89, 0x05, 0xb9, 0x73,
+	0x36, 0xab, 0x68, 0x3b, 0xda, 0x6e, 0x89, 0xe2, 0x92, 0x10, 0x30, 0x42, 0x6f, 0xcc, 0x2a, 0xba,
+	0x40, 0x89, 0x35, 0x79, 0x1f, 0xf2, 0x49, 0xff, 0x8c, 0x8d, 0xbd, 0x4a, 0x6e, 0x47, 0xdb, 0x2d,
+	0xef, 0xbd, 0xee, 0x5c, 0xf9, 0x8f, 0xd3, 0x11, 0x64, 0xaa, 0xd8, 0xc8, 0x0e, 0x18, 0x7c, 0x36,
+	0x61, 0x15, 0x63, 0x47, 0xdb, 0x5d, 0xdf, 0x5b, 0x75, 0x24, 0xaf, 0xd3, 0x9d, 0x4d, 0x18, 0x15,
+	0x14, 0xf2, 0x2e, 0x14, 0x92, 0x33, 0x2f, 0x0e, 0xc2, 0x61, 0xc5, 0x14, 0x4c, 0x1b, 0x29, 0x53,
+	0x47, 0xa2, 0x69, 0x4a, 0x27, 0xf7, 0xa0, 0xf4, 0xec, 0x2c, 0xe0, 0x6c, 0x14, 0x24, 0xbc, 0x92,
+	0xdf, 0xc9, 0xed, 0x96, 0xe8, 0x02, 0x41, 0x36, 0xc1, 0x1c, 0x44, 0x71, 0x9f, 0x55, 0x0a, 0x3b,
+	0xda, 0x6e, 0x91, 0x4a, 0x60, 0xfb, 0xf7, 0x1a, 0xe4, 0xa5, 0x4c, 0x64, 0x1d, 0xf4, 0xc0, 0x57,
+	0x1a, 0xea, 0x81, 0x8f, 0x0a, 0xfe, 0x34, 0x89, 0xc2, 0x54, 0x41, 0x5c, 0x93, 0x8f, 0x21, 0x3f,
+	0x89, 0x59, 0xc2, 0xb8, 0x50, 0x70, 0x7d, 0xef, 0xcd, 0x5b, 0x14, 0x74, 0x8e, 0x05, 0x17, 0x55,
+	0xdc, 0xf6, 0x27, 0x90, 0x97, 0x18, 0x52, 0x04, 0xa3, 0xd5, 0x6e, 0xb9, 0xd6, 0x0a, 0xae, 0xf6,
+	0x9b, 0xed, 0x7d, 0x4b, 0x23, 0x1b, 0x50, 0xae, 0x55, 0x8f, 0x5c, 0x5a, 0xed, 0xd1, 0x76, 0xb3,
+	0x69, 0xe9, 0xa4, 0x04, 0xe6, 0x91, 0x5b, 0x6f, 0x54, 0xad, 0x9c, 0xfd, 0x08, 0x8a, 0xfb, 0xa3,
+	0xa8, 0x7f, 0xfe, 0x34, 0xf8, 0x19, 0x4a, 0xe4, 0x47, 0x3c, 0x51, 0x32, 0x8a, 0x35, 0xaa, 0xd5,
+	0x8f, 0xa6, 0x21, 0x17, 0x62, 0x9a, 0x54, 0x02, 0xc2, 0x39, 0xec, 0x52, 0x4a, 0x89, 0xce, 0x61,
+	0x97, 0xdc, 0xfe, 0x87, 0x01, 0x65, 0x29, 0xea, 0x61, 0xec, 0x4d, 0xce, 0xc8, 0x16, 0xe4, 0xb9,
+	0x00, 0xd5, 0xff, 0x14, 0x44, 0x1e, 0x82, 0x19, 0x46, 0x3e, 0x4b, 0x2a, 0xfa, 0x4e, 0x6e, 0xb7,
+	0xbc, 0x77, 0xc7, 0xc9, 0x6c, 0x72, 0x5a, 0x91, 0xcf, 0xa8, 0xa4, 0x23, 0x23, 0xf3, 0x87, 0x2c,
+	0xa9, 0xe4, 0x6e, 0x60, 0x74, 0xfd, 0x21, 0xa3, 0x92, 0x4e, 0x2a, 0x50, 0xf0, 0xa6, 0xfc, 0x2c,
+	0x8a, 0x93, 0x8a, 0x21, 0xdc, 0x92, 0x82, 0x28, 0xfd, 0xc8, 0x0b, 0x59, 0x22, 0x7c, 0x6b, 0x52,
+	0x09, 0xcc, 0xa5, 0xcf, 0x2f, 0xa4, 0xdf, 0xfe, 0x4a, 0x07, 0x03, 0x0f, 0xbf, 0xe6, 0xa6, 0xb7,
+	0x55, 0x08, 0xe9, 0xc2, 0x21, 0x96, 0x23, 0xac, 0x25, 0xbf, 0x99, 0x30, 0xda, 0x82, 0xbc, 0x3c,
+	0x53, 0x99, 0x44, 0x41, 0xc4, 0x01, 0xc3, 0xf7, 0xb8, 0x0c, 0xc0, 0xf2, 0xde, 0xb6, 0x23, 0x2f,
+	0x8a, 0x93, 0x5e, 0x14, 0xa7, 0x9b, 0x5e, 0x14, 0x2a, 0xf8, 0x84, 0xb9, 0xf1, 0xd7, 0x42, 0xe0,
+	0x1c, 0x95, 0x00, 0xf9, 0x36, 0xe4, 0x13, 0xee, 0xf1, 0x69, 0x22, 0x44, 0x5e, 0xdf, 0x23, 0x59,
+	0x29, 0x3a, 0x82, 0x42, 0x15, 0x07, 0x2a, 0x87, 0x5a, 0x8a, 0x30, 0x34, 0xa9, 0x58, 0xe3, 0xed,
+	0x8a, 0xa3, 0x67, 0x95, 0xa2, 0x40, 0xe1, 0x12, 0xcf, 0x19, 0xb3, 0x78, 0xc8, 0x2a, 0x25, 0x19,
+	0xad, 0x02, 0xc0, 0xbd, 0x83, 0x28, 0x3e, 0xaf, 0x80, 0x40, 0x8a, 0x35, 0xe2, 0xce, 0xd0, 0x89,
+	0x65, 0x89, 0xc3, 0xf5, 0x76, 0x0c, 0x06, 0xda, 0x5f, 0xf0, 0xc7, 0xd1, 0x38, 0x0d, 0x18, 0x5c,
+	0xa3, 0xfd, 0x78, 0xa4, 0x82, 0x5a, 0xe7, 0x91, 0x30, 0x76, 0xe4, 0xb3, 0x79, 0xa8, 0xa0, 0x8d,
+	0x2b, 0x50, 0x88, 0xa6, 0x3c, 0x09, 0x7c, 0x69, 0x98, 0x22, 0x4d, 0x41, 0xa4, 0x8c, 0x83, 0x24,
+	0x49, 0xaf, 0x63, 0x91, 0xa6, 0xa0, 0xfd, 0xc7, 0x1c, 0x10, 0x19, 0x00, 0x4f, 0x59, 0x1c, 0x0c,
+	0x82, 0xbe, 0xc7, 0x83, 0x28, 0xbc, 0x35, 0xca, 0x6e, 0x8e, 0xdb, 0x0f, 0x20, 0x1f, 0x24, 0xc9,
+	0x74, 0x1e, 0x53, 0x77, 0x9d, 0xeb, 0xbf, 0x74, 0x1a, 0xc8, 0x41, 0x15, 0xe3, 0xf6, 0x9f, 0x75,
+	0x30, 0x05, 0x86, 0xec, 0xa9, 0x48, 0xd0, 0xd4, 0xd5, 0xbc, 0x6d, 0x6b, 0x36, 0xbd, 0xa4, 0xda,
+	0xeb, 0x19, 0xed, 0x37, 0xc1, 0x3c, 0x15, 0x3e, 0x96, 0x26, 0x91, 0x00, 0xfa, 0x68, 0x9c, 0x0c,
+	0x85, 0x3d, 0x4a, 0x14, 0x97, 0x64, 0x1b, 0x8a, 0x31, 0x9b, 0x78, 0x41, 0xcc, 0x7c, 0x65, 0x8c,
+	0x39, 0x6c, 0xff, 0x4e, 0x03, 0x03, 0x8f, 0x21, 0x16, 0xac, 0x1e, 0x35, 0x3a, 0x9d, 0x46, 0xeb,
+	0xb0, 0xd7, 0x6a, 0xd7, 0xf1, 0xde, 0x5b, 0xb0, 0xda, 0x68, 0x3d, 0xad, 0x36, 0x1b, 0x75, 0x89,
+	0xd1, 0xc8, 0x1d, 0x58, 0x4b, 0x31, 0xfb, 0xcd, 0x76, 0xed, 0xb1, 0xa5, 0x23, 0x2a, 0xdd, 0x26,
+	0x51, 0x39, 0x44, 0x1d, 0xbb, 0xad, 0xfa, 0x02, 0x65, 0x10, 0x02, 0xeb, 0x8d, 0x56, 0xdd, 0xfd,
+	0xbc, 0x77, 0xd4, 0xe8, 0x1c, 0x55, 0xbb, 0xb5, 0x47, 0x96, 0x99, 0x3d, 0xf0, 0xa0, 0xd1, 0x74,
+	0xad, 0x3c, 0x59, 0x85, 0xe2, 0x49, 0xeb, 0xb8, 0xd1, 0x6a, 0xb9, 0x75, 0xab, 0x60, 0x7f, 0x04,
+	0x46, 0x87, 0xb3, 0xc9, 0x3c, 0x7f, 0x6b, 0x99, 0xfc, 0x7d, 0x17, 0x8c, 0x51, 0x10, 0x9e, 0x0b,
+	0x6b, 0x94, 0xf7, 0x4c, 0xa7, 0x19, 0x84, 0xe7, 0x54, 0xa0, 0xec, 0x9f, 0x43, 0xa9, 0x1e, 0xc4,
+	0xac, 0xcf, 0xa3, 0x78, 0x46, 0xbe, 0x03, 0xe6, 0x20, 0x18, 0x31, 0xcc, 0x44, 0xe8, 0xa5, 0xff,
+	0x73, 0xe6, 0x24, 0xe7, 0x00, 0xf1, 0x6e, 0xc8, 0xe3, 0x19, 0x95, 0x3c, 0xdb, 0x75, 0x80, 0x05,
+	0xf2, 0x86, 0x42, 0xb2, 0x03, 0xe6, 0x85, 0x37, 0x9a, 0x32, 0x75, 0x2a, 0x88, 0x5f, 0x34, 0x42,
+	0x9f, 0x5d, 0x52, 0x49, 0xf8, 0x54, 0xff, 0x44, 0xb3, 0x3f, 0x80, 0xb5, 0xf9, 0x21, 0x4d, 0xcc,
+	0xe7, 0x3b, 0x60, 0x06, 0x9c, 0x8d, 0x53, 0x19, 0x60, 0x21, 0x03, 0x95, 0x04, 0xfb, 0x0c, 0x8c,
+	0xc7, 0x6c, 0x96, 0x90, 0x6f, 0x2d, 0x4b, 0x6b, 0x39, 0x88, 0xbd, 0x41, 0xd0, 0x4f, 0x9e, 0x23,
+	0xe8, 0x66, 0x56, 0xd0, 0x52, 0x56, 0xb8, 0xdf, 0xe8, 0xb0, 0x81, 0x5b, 0xf7, 0x3d, 0xde, 0x3f,
+	0x3b, 0x99, 0xa4, 0x99, 0x62, 0x18, 0x47, 0xd3, 0x89, 0xfa, 0x83, 0x04, 0x6e, 0xad, 0x9a, 0x32,
+	0x7b, 0xc8, 0xa2, 0xf2, 0xba, 0x73, 0xe5, 0x5f, 0xce, 0x95, 0x14, 0x72, 0x0f, 0x72, 0x7e, 0x10,
+	0xab, 0x9c, 0x95, 0x55, 0x1c, 0xd1, 0xe4, 0x5e, 0xaa, 0xae, 0x29, 0xe8, 0x79, 0xa9, 0xa9, 0x52,
+	0x12, 0xc5, 0x62, 0x71, 0x1c, 0xc5, 0x2a, 0xb9, 0x4a, 0xc0, 0x6e, 0x43, 0x5e, 0x9e, 0x41, 0x00,
+	0xf2, 0x4f, 0x4e, 0xdc, 0x13, 0xb7, 0x6e, 0xad, 0xe0, 0xfa, 0xa8, 0xd1, 0x6c, 0xba, 0x75, 0x4b,
+	0xc3, 0xb5, 0x0a, 0x21, 0x9d, 0x94, 0xa1, 0xd0, 0x79, 0xdc, 0x38, 0x3e, 0x76, 0xeb, 0x56, 0x0e,
+	0x6b, 0x95, 0x4b, 0x69, 0x9b, 0x5a, 0x06, 0x2e, 0xab, 0xf5, 0xba, 0x5b, 0xb7, 0x4c, 0xfb, 0x17,
+	0x1a, 0x40, 0x23, 0xbc, 0x08, 0x38, 0x7b, 0x1a, 0xb0, 0x67, 0x37, 0xd5, 0xd6, 0x6b, 0x66, 0xb8,
+	0x0f, 0x85, 0x40, 0xec, 0x88, 0x55, 0xf7, 0x60, 0x3a, 0x27, 0x09, 0x8b, 0x69, 0x8a, 0x7d, 0xd9,
+	0x5c, 0x6d, 0x7f, 0x08, 0xeb, 0x0b, 0x11, 0x44, 0xcc, 0x3c, 0x58, 0x8e, 0x99, 0xb2, 0xb3, 0xa0,
+	0xa7, 0x41, 0xd3, 0x84, 0x75, 0xf7, 0x92, 0xb3, 0x38, 0xf4, 0x46, 0x92, 0x78, 0x4d, 0x76, 0x15,
+	0x18, 0xfa, 0x22, 0x30, 0x2a, 0xcb, 0x92, 0x97, 0xe6, 0x22, 0xdb, 0x7f, 0xd3, 0xa0, 0x7c, 0xc0,
+	0x98, 0x4f, 0xd9, 0x17, 0x53, 0x96, 0xf0, 0x5b, 0xb3, 0xe1, 0x16, 0xe4, 0xa3, 0xc1, 0x00, 0xfb,
+	0x0a, 0xf9, 0x5b, 0x05, 0x89, 0xfa, 0x18, 0x8c, 0x03, 0x59, 0xc8, 0x4d, 0x2a, 0x01, 0xf2, 0x0e,
+	0x18, 0xd8, 0xaf, 0xa9, 0xae, 0xe9, 0x8e, 0x93, 0x39, 0xc1, 0x39, 0xc2, 0x02, 0x2d, 0xc8, 0x58,
+	0x19, 0x93, 0x28, 0xe6, 0x15, 0xf3, 0x7a, 0x65, 0xec, 0x44, 0x31, 0xa7, 0x82, 0x6a, 0x7f, 0x17,
+	0x0c, 0xdc, 0x83, 0x0e, 0xae, 0x3d, 0xa2, 0xed, 0x56, 0xdb, 0x5a, 0x21, 0x6b, 0x50, 0xaa, 0xb6,
+	0x5a, 0xed, 0x6e, 0xb5, 0x9b, 0xfa, 0xbe, 0xd3, 0xad, 0xd6, 0x1e, 0x77, 0x2c, 0xdd, 0x3e, 0x83,
+	0x22, 0x1e, 0xd7, 0xe0, 0x6c, 0xbc, 0x48, 0x94, 0x5a, 0x36, 0x51, 0x2e, 0x74, 0xd4, 0x97, 0x74,
+	0x74, 0xa0, 0x30, 0xf1, 0x66, 0xa3, 0xc8, 0xf3, 0x95, 0x7f, 0x37, 0xaf, 0x79, 0xb0, 0x1a, 0xce,
+	0x68, 0xca, 0x64, 0xff, 0x08, 0x56, 0xd3, 0x93, 0x84, 0xf3, 0xee, 0x2f, 0x3b, 0xaf, 0xe4, 0xa4,
+	0x54, 0xe5, 0xba, 0x97, 0x68, 0x85, 0x7e, 0xab, 0x81, 0x79, 0x24, 0x2a, 0xea, 0xcd, 0x2a, 0xa4,
+	0x91, 0xa6, 0xbf, 0x60, 0x57, 0x70, 0x17, 0x8c, 0x69, 0x72, 0x35, 0x6e, 0x05, 0x8a, 0xbc, 0x05,
+	0x05, 0xee, 0xc5, 0x43, 0xc6, 0x65, 0xef, 0xb3, 0x24, 0x77, 0x4a, 0xf9, 0x54, 0xaf, 0x68, 0xf6,
+	0xaf, 0x35, 0xc8, 0x37, 0x86, 0x61, 0x14, 0xff, 0x07, 0x84, 0x7a, 0x00, 0x79, 0x79, 0xb4, 0xba,
+	0x4b, 0x19, 0x99, 0x14, 0xc1, 0xfe, 0x52, 0x03, 0xe3, 0x60, 0xe4, 0x0d, 0xff, 0x27, 0x84, 0xf9,
+	0xa5, 0x06, 0xc6, 0x0f, 0xa3, 0x20, 0x7c, 0xf5, 0xc2, 0xbc, 0x81, 0x17, 0xee, 0x9c, 0xa5, 0xce,
+	0xc2, 0x12, 0x78, 0xce, 0xa8, 0xc4, 0xd9, 0xe7, 0x50, 0xac, 0x86, 0x61, 0x34, 0x0d, 0xfb, 0xaf,
+	0xde, 0x47, 0xf6, 0xaf, 0x34, 0x30, 0x9b, 0xcc, 0xbb, 0x60, 0xff, 0x65, 0xa5, 0xbf, 0xc6, 0x4e,
+	0x86, 0x5d, 0xf2, 0x57, 0x2f, 0x06, 0x01, 0xe3, 0x34, 0xf2, 0x67, 0xaa, 0xc5, 0x12, 0x6b, 0xf2,
+	0x36, 0x14, 0xfb, 0xd1, 0x78, 0xcc, 0x42, 0x8e, 0xf5, 0x0c, 0xa5, 0x2b, 0x3a, 0x35, 0x89, 0xa0,
+	0x73, 0xca, 0x42, 0x81, 0xfc, 0x0d, 0x0a, 0x3c, 0x84, 0x22, 0xca, 0x2f, 0x72, 0xc8, 0x1b, 0xcb,
+	0x39, 0xc4, 0x74, 0x90, 0x92, 0xa6, 0xfe, 0xaf, 0x30, 0xe4, 0x83, 0x91, 0x30, 0x78, 0x80, 0xfd,
+	0x87, 0xd0, 0xd4, 0xa4, 0x12, 0x20, 0x6f, 0x82, 0x81, 0x25, 0xf4, 0x86, 0x36, 0x45, 0xe0, 0xb1,
+	0xcd, 0xc0, 0x4e, 0x29, 0x6d, 0x5d, 0x2d, 0xc1, 0x20, 0x5a, 0xa8, 0xb4, 0xcd, 0x10, 0x64, 0xec,
+	0x87, 0x16, 0xc8, 0x7f, 0xbb, 0x1f, 0xfa, 0x93, 0x0e, 0xe6, 0x41, 0x5a, 0xd1, 0x6f, 0xc9, 0xc2,
+	0xf2, 0x56, 0xa5, 0x59, 0x58, 0x40, 0x62, 0x86, 0xf4, 0xb8, 0x57, 0x01, 0x35, 0x43, 0x7a, 0xdc,
+	0x9b, 0xfb, 0x30, 0xf7, 0x92, 0x3e, 0x34, 0xae, 0xfb, 0xb0, 0x02, 0x85, 0xbe, 0x37, 0xc1, 0x5e,
+	0x5b, 0x94, 0x9d, 0x12, 0x4d, 0x41, 0x34, 0xbd, 0x6c, 0x4b, 0x52, 0x1f, 0xa1, 0xf4, 0x69, 0x57,
+	0x92, 0x75, 0x73, 0xe1, 0xf9, 0x6e, 0x2e, 0x5e, 0x77, 0x33, 0x9e, 0x2c, 0x0b, 0x4d, 0x52, 0x29,
+	0xc9, 0x21, 0x53, 0x81, 0xf6, 0xbb, 0x50, 0x12, 0x96, 0x12, 0x11, 0x70, 0x6f, 0x39, 0x02, 0xe6,
+	0xdd, 0x91, 0x0c, 0x81, 0x3f, 0x68, 0x50, 0x50, 0xe7, 0x5e, 0xab, 0xfb, 0xaf, 0x38, 0xd2, 0x17,
+	0x69, 0xd0, 0xbc, 0x25, 0x0d, 0x8a, 0x32, 0xf1, 0x01, 0x94, 0x95, 0x80, 0x42, 0x9d, 0x37, 0x97,
+	0xd5, 0x59, 0x58, 0x4d, 0xa2, 0xc5, 0x16, 0xcc, 0x9e, 0x68, 0xa9, 0x57, 0xa9, 0xd1, 0x0b, 0x24,
+	0xf1, 0x87, 0x50, 0x44, 0x29, 0x6e, 0xbe, 0x87, 0xd2, 0x93, 0xd2, 0x09, 0x5f, 0x6b, 0xb0, 0x56,
+	0xed, 0x8b, 0xea, 0xad, 0x7a, 0xe9, 0xab, 0x82, 0x6f, 0x66, 0x5a, 0xb0, 0x7d, 0xbd, 0xa2, 0xc9,
+	0x8b, 0xf3, 0x50, 0xcd, 0x7f, 0xb2, 0x8b, 0x7e, 0xcd, 0x59, 0xfa, 0x47, 0x66, 0xe8, 0xb3, 0x7f,
+	0xb2, 0x98, 0xcd, 0xba, 0x8f, 0xa8, 0x5b, 0xad, 0xf7, 0x64, 0xdb, 0xba, 0x82, 0x03, 0x95, 0xc2,
+	0x50, 0xf7, 0xa8, 0xfd, 0x54, 0x74, 0x3f, 0x5b, 0x40, 0xaa, 0xb5, 0x5a, 0xfb, 0xa4, 0xd5, 0xed,
+	0x1d, 0xbb, 0x2e, 0x55, 0xbc, 0x3a, 0xa9, 0xc0, 0xe6, 0x12, 0x3e, 0xdd, 0x91, 0xb3, 0xff, 0xaa,
+	0x41, 0xa1, 0x33, 0x1d, 0x8f, 0xbd, 0x78, 0x76, 0x4d, 0x74, 0x7c, 0x0b, 0xf1, 0xfd, 0x98, 0x25,
+	0x89, 0xba, 0x98, 0x29, 0x48, 0xde, 0x03, 0xe2, 0x49, 0x89, 0x7b, 0x13, 0xc6, 0xe2, 0x9e, 0x58,
+	0xaa, 0xc6, 0xcf, 0x52, 0x94, 0x63, 0xc6, 0xe2, 0x1a, 0x2e, 0xc8, 0x03, 0x58, 0x95, 0xf1, 0xad,
+	0xf8, 0x0c, 0xc1, 0x57, 0xe6, 0xea, 0x2d, 0x0a, 0x59, 0xee, 0x43, 0x59, 0xdc, 0x2e, 0xc5, 0x21,
+	0x9f, 0x58, 0x40, 0xa0, 0x24, 0xc3, 0x5b, 0xb0, 0xd6, 0x8f, 0x42, 0xee, 0xf5, 0xb9, 0x62, 0xc9,
+	0x0b, 0x96, 0x55, 0x85, 0x14, 0x4c, 0xf6, 0x3f, 0x0d, 0x80, 0x27, 0x53, 0x36, 0x65, 0x38, 0x20,
+	0x88, 0x47, 0x9f, 0x2f, 0x10, 0x52, 0x53, 0xf6, 0x1d, 0x67, 0x41, 0x93, 0x4b, 0x2a, 0xe9, 0xb7,
+	0x74, 0x63, 0x1f, 0x41, 0x51, 0x0e, 0x31, 0x99, 0x11, 0x3f, 0xf3, 0x87, 0x8e, 0xa2, 0xc9, 0x84,
+	0x39, 0x67, 0x25, 0xef, 0x81, 0x89, 0x36, 0x49, 0x2b, 0xd4, 0x56, 0x76, 0x0f, 0xda, 0x44, 0x6d,
+	0x90, 0x4c, 0x68, 0xe3, 0x53, 0xaf, 0x7f, 0x1e, 0x0d, 0x06, 0x4a, 0xe9, 0x14, 0x24, 0x7b, 0x90,
+	0x8f, 0x46, 0x3e, 0x4b, 0xa4, 0xaa, 0xdf, 0x1c, 0xf3, 0x8a, 0x93, 0xfc, 0x3f, 0x80, 0x5c, 0xf5,
+	0xbc, 0xa1, 0x7c, 0xb6, 0xc9, 0xd1, 0x92, 0xc4, 0x54, 0xe5, 0x1b, 0x8b, 0x8f, 0xcd, 0xae, 0x7c,
+	0xbc, 0x11, 0x6b, 0xdc, 0x32, 0xf2, 0x12, 0xde, 0x93, 0x93, 0x56, 0x49, 0xf8, 0xb9, 0x84, 0x18,
+	0x17, 0x11, 0x64, 0x1f, 0x36, 0x16, 0xe4, 0x9e, 0xb8, 0x82, 0xf0, 0x5c, 0x71, 0xd6, 0xe6, 0xfb,
+	0xeb, 0x1e, 0x67, 0xdb, 0x3f, 0x80, 0xb5, 0x25, 0x63, 0x3d, 0x6f, 0x5e, 0x35, 0x33, 0xc5, 0x03,
+	0x27, 0xdd, 0x85, 0xd5, 0x5e, 0x66, 0xa7, 0xed, 0x83, 0x29, 0x4c, 0x8f, 0x37, 0x45, 0xbc, 0x42,
+	0xf4, 0x8e, 0xdc, 0x4e, 0xa7, 0x7a, 0xe8, 0x76, 0xac, 0x15, 0xf2, 0x1a, 0x6c, 0x48, 0x5c, 0xbd,
+	0xfd, 0x59, 0xab, 0xd9, 0xae, 0xd6, 0x3b, 0xf2, 0x71, 0x53, 0x22, 0x8f, 0xdb, 0x9d, 0x6e, 0x47,
+	0x3e, 0x6d, 0xd4, 0xaa, 0x07, 0x6e, 0x8f, 0xba, 0x4f, 0x4e, 0x5c, 0x44, 0xe5, 0xc8, 0x3a, 0x80,
+	0x40, 0x35, 0x5a, 0xfb, 0xed, 0xcf, 0x2d, 0x03, 0x27, 0xb7, 0x85, 0x83, 0x6f, 0x9e, 0xdc, 0x16,
+	0xf4, 0x34, 0x6d, 0xfc, 0x45, 0x83, 0xd5, 0x9a, 0x37, 0x60, 0x87, 0x35, 0xca, 0x26, 0x51, 0xcc,
+	0xc9, 0xf7, 0xa1, 0x90, 0x70, 0x2f, 0xe6, 0x4c, 0xde, 0xbf, 0x6f, 0x36, 0x6f, 0xca, 0x4a, 0x3e,
+	0x86, 0xe2, 0x20, 0x08, 0x83, 0xe4, 0x8c, 0xf9, 0x2f, 0x90, 0x18, 0xe7, 0xbc, 0x18, 0x74, 0x31,
+	0x1b, 0x47, 0x17, 0xcc, 0x57, 0x77, 0x36, 0x05, 0xc5, 0xcb, 0x73, 0xcc, 0x98, 0x2f, 0xee, 0x68,
+	0x8e, 0x4a, 0x60, 0x31, 0x88, 0x9b, 0xd9, 0x41, 0xfc, 0xef, 0x1a, 0x14, 0x9b, 0xd1, 0xb0, 0xc9,
+	0x2e, 0xd8, 0x88, 0x7c, 0x0f, 0x0a, 0xc9, 0x2c, 0xc9, 0xa8, 0xbd, 0xe5, 0xa4, 0x34, 0xa7, 0x23,
+	0x09, 0x32, 0xee, 0x53, 0xb6, 0xed, 0xc7, 0xb0, 0x9a, 0x25, 0xdc, 0xe0, 0xda, 0x77, 0xb2, 0xae,
+	0xc5, 0xd7, 0xf4, 0xf9, 0x1f, 0xc5, 0x37, 0xeb, 0xeb, 0x16, 0x98, 0x52, 0x8e, 0x55, 0x28, 0xd6,
+	0x68, 0xa3, 0xdb, 0xa8, 0x55, 0x9b, 0xd6, 0xca, 0x62, 0xe0, 0xd7, 0xf0, 0x21, 0xe0, 0xb3, 0x2a,
+	0x6d, 0x35, 0x5a, 0x87, 0x96, 0x8e, 0x53, 0x62, 0xab, 0xdd, 0x6d, 0xd4, 0x5c, 0x2b, 0x87, 0x6f,
+	0xdb, 0x8d, 0xd6, 0x41, 0x5b, 0xbe, 0x09, 0xd4, 0xdd, 0xfd, 0x93, 0x43, 0xcb, 0xb4, 0x1f, 0x40,
+	0xa1, 0xc3, 0xf1, 0xa5, 0x3e, 0xc1, 0xee, 0x44, 0x9c, 0x23, 0x15, 0x2b, 0x51, 0x05, 0xed, 0xbf,
+	0x06, 0x6b, 0x41, 0xe4, 0x70, 0x76, 0xc9, 0xb1, 0x77, 0x9a, 0x9c, 0xfe, 0x58, 0x9f, 0x9c, 0x9e,
+	0xe6, 0x85, 0xdd, 0x3f, 0xfc, 0xd7, 0x00, 0xc5, 0xe8, 0xf6, 0x7d, 0xed, 0x18, 0x00, 0x00,
 }
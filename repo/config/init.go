@@ -20,6 +20,7 @@ type Config struct {
 	IsMobile  bool         // local node is setup for mobile
 	IsServer  bool         // local node is setup for a server w/ a public IP
 	Cafe      Cafe         // local node cafe settings
+	Queues    Queues       // local node queue settings
 	Bots      []EnabledBot // local node enabled bots
 }
 
@@ -38,6 +39,7 @@ type Account struct {
 // Addresses stores the (string) bind addresses for the node.
 type Addresses struct {
 	API       string // bind address of the local REST API
+	GRPC      string // bind address of the local gRPC API, disabled if empty
 	CafeAPI   string // bind address of the cafe REST API
 	Gateway   string // bind address of the IPFS object gateway
 	Profiling string // bind address of the profiling API
@@ -59,7 +61,17 @@ type API struct {
 
 // Gateway settings
 type Gateway struct {
-	HTTPHeaders HTTPHeaders
+	HTTPHeaders   HTTPHeaders
+	PublicThreads []string // ids of PUBLIC or OPEN threads w/ a read-only web view at /threads/:id
+	Images        GatewayImages
+}
+
+// GatewayImages settings
+type GatewayImages struct {
+	Transforms   []string // allowed image transforms, e.g., "w=320" or "w=100,h=100,fit=cover"
+	CacheSize    int64    // maximum size of transformed images cached on disk in bytes
+	SourceSize   int64    // maximum size of a source image in bytes, zero uses the default
+	SourcePixels int64    // maximum width x height of a source image, zero uses the default
 }
 
 // Logs settings
@@ -74,10 +86,23 @@ type Cafe struct {
 
 // CafeHost settings
 type CafeHost struct {
-	Open        bool   // When true, other peers can register with this node for cafe services.
-	URL         string // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
-	NeighborURL string // Specifies the URL of a secondary cafe. Must return cafe info.
-	SizeLimit   int64  // Maximum file size limit to accept for POST requests in bytes.
+	Open                 bool     // When true, other peers can register with this node for cafe services.
+	URL                  string   // Override the resolved URL of this cafe, useful for load HTTPS and/or load balancers
+	NeighborURL          string   // Specifies the URL of a secondary cafe. Must return cafe info.
+	Neighbors            []string // Peer IDs of trusted cafes to replicate client data to and accept it from
+	SizeLimit            int64    // Maximum file size limit to accept for POST requests in bytes.
+	ClientByteQuota      int64    // Maximum bytes each client can store, zero for no limit
+	ClientObjectQuota    int64    // Maximum objects each client can store, zero for no limit
+	GCInterval           string   // How often to garbage collect objects no longer stored for clients, e.g., "24h", empty to disable
+	Metrics              bool     // When true, Prometheus metrics w/o client ids are served at /metrics on the cafe API
+	AllowPrivateWebhooks bool     // When true, client webhooks may post to loopback, private, and link-local addresses
+}
+
+// Queues settings
+type Queues struct {
+	BlockOutboxDebounce    string // how long to wait for more outbound block messages before flushing, e.g., "1s"
+	CafeOutboxDebounce     string // how long to wait for more cafe requests before flushing
+	BlockDownloadsDebounce string // how long to wait for more block downloads before flushing
 }
 
 // Init returns the default textile config
@@ -89,6 +114,7 @@ func Init() (*Config, error) {
 		},
 		Addresses: Addresses{
 			API:       "127.0.0.1:40600",
+			GRPC:      "127.0.0.1:40602",
 			CafeAPI:   "0.0.0.0:40601",
 			Gateway:   "127.0.0.1:5050",
 			Profiling: "127.0.0.1:6060",
@@ -150,18 +176,36 @@ func Init() (*Config, error) {
 					"*",
 				},
 			},
+			PublicThreads: []string{},
+			Images: GatewayImages{
+				Transforms:   []string{"w=100", "w=320", "w=800"},
+				CacheSize:    256 << 20,
+				SourceSize:   32 << 20,
+				SourcePixels: 50000000,
+			},
 		},
 		Logs: Logs{
 			LogToDisk: true,
 		},
 		Cafe: Cafe{
 			Host: CafeHost{
-				Open:        false,
-				URL:         "",
-				NeighborURL: "",
-				SizeLimit:   0,
+				Open:                 false,
+				URL:                  "",
+				NeighborURL:          "",
+				Neighbors:            []string{},
+				SizeLimit:            0,
+				ClientByteQuota:      0,
+				ClientObjectQuota:    0,
+				GCInterval:           "24h",
+				Metrics:              false,
+				AllowPrivateWebhooks: false,
 			},
 		},
+		Queues: Queues{
+			BlockOutboxDebounce:    "1s",
+			CafeOutboxDebounce:     "5s",
+			BlockDownloadsDebounce: "1s",
+		},
 		IsMobile: false,
 		IsServer: false,
 		Bots:     []EnabledBot{},
@@ -21,11 +21,15 @@ type Datastore interface {
 	CafeSessions() CafeSessionStore
 	CafeRequests() CafeRequestStore
 	CafeMessages() CafeMessageStore
+	DeadLetters() DeadLetterStore
 	CafeClientNonces() CafeClientNonceStore
 	CafeClients() CafeClientStore
 	CafeTokens() CafeTokenStore
 	CafeClientThreads() CafeClientThreadStore
 	CafeClientMessages() CafeClientMessageStore
+	CafeClientObjects() CafeClientObjectStore
+	CafeClientUsage() CafeClientUsageStore
+	ApiKeys() ApiKeyStore
 	Bots() Botstore
 	Ping() error
 	Close()
@@ -62,6 +66,8 @@ type PeerStore interface {
 	UpdateInboxes(id string, inboxes []*pb.Cafe) error
 	Delete(id string) error
 	DeleteByAddress(address string) error
+	AddReachability(id string, path pb.PeerReachability_Path, reached bool) error
+	GetReachability(id string) []*pb.PeerReachability
 }
 
 type Botstore interface {
@@ -93,6 +99,7 @@ type ThreadStore interface {
 	UpdateHead(id string, heads []string) error
 	UpdateName(id string, name string) error
 	UpdateSchema(id string, hash string) error
+	UpdateFork(id string, thread string, head string) error
 	Delete(id string) error
 }
 
@@ -116,8 +123,12 @@ type BlockStore interface {
 	Replace(block *pb.Block) error
 	Get(id string) *pb.Block
 	List(offset string, limit int, query string) *pb.BlockList
+	ListCausal(offset string, limit int, query string) *pb.BlockList
+	ListAfter(after string, limit int, query string) *pb.BlockList
 	Count(query string) int
-	AddAttempt(id string) error
+	Stats(status pb.Block_BlockStatus) *pb.QueueStats
+	AddAttempt(id string, retry time.Time) error
+	UpdateClock(id string, clock int64) error
 	Delete(id string) error
 	DeleteByThread(threadId string) error
 }
@@ -126,6 +137,7 @@ type BlockMessageStore interface {
 	Queryable
 	Add(msg *pb.BlockMessage) error
 	List(offset string, limit int) []pb.BlockMessage
+	Stats() *pb.QueueStats
 	Delete(id string) error
 }
 
@@ -174,7 +186,8 @@ type CafeRequestStore interface {
 	UpdateStatus(id string, status pb.CafeRequest_Status) error
 	UpdateGroupStatus(group string, status pb.CafeRequest_Status) error
 	UpdateGroupProgress(group string, transferred int64, total int64) error
-	AddAttempt(id string) error
+	Stats() *pb.QueueStats
+	AddAttempt(id string, retry time.Time) error
 	Delete(id string) error
 	DeleteByGroup(groupId string) error
 	DeleteBySyncGroup(syncGroupId string) error
@@ -186,10 +199,21 @@ type CafeMessageStore interface {
 	Queryable
 	Add(msg *pb.CafeMessage) error
 	List(offset string, limit int) []pb.CafeMessage
-	AddAttempt(id string) error
+	Stats() *pb.QueueStats
+	AddAttempt(id string, retry time.Time) error
 	Delete(id string) error
 }
 
+type DeadLetterStore interface {
+	Queryable
+	Add(letter *pb.DeadLetter) error
+	Get(id string) *pb.DeadLetter
+	List(offset string, limit int, query string) *pb.DeadLetterList
+	Count(query string) int
+	Delete(id string) error
+	DeleteByQueue(queue pb.DeadLetter_Queue) error
+}
+
 // Cafe host-side stores
 
 type CafeClientNonceStore interface {
@@ -205,6 +229,8 @@ type CafeClientStore interface {
 	List() []pb.CafeClient
 	ListByAddress(address string) []pb.CafeClient
 	UpdateLastSeen(id string, date time.Time) error
+	UpdateRevoked(id string, date time.Time) error
+	UpdateWebhook(id string, url string, secret string) error
 	Delete(id string) error
 }
 
@@ -223,9 +249,34 @@ type CafeClientMessageStore interface {
 	DeleteByClient(clientId string, limit int) error
 }
 
+type CafeClientObjectStore interface {
+	Add(obj *pb.CafeClientObject) error
+	Get(id string, clientId string) *pb.CafeClientObject
+	ListByClient(clientId string) []pb.CafeClientObject
+	Count(id string) int
+	Delete(id string, clientId string) error
+	DeleteByClient(clientId string) error
+}
+
+type CafeClientUsageStore interface {
+	Add(clientId string, bytes int64, objects int64) error
+	Get(clientId string) *pb.CafeClientUsage
+	List() []pb.CafeClientUsage
+	Delete(clientId string) error
+}
+
+type ApiKeyStore interface {
+	Add(key *pb.ApiKey) error
+	Get(id string) *pb.ApiKey
+	List() []pb.ApiKey
+	UpdateRevoked(id string, date time.Time) error
+	Delete(id string) error
+}
+
 type CafeTokenStore interface {
 	Add(token *pb.CafeToken) error
 	Get(id string) *pb.CafeToken
 	List() []pb.CafeToken
+	UpdateDisabled(id string, disabled bool) error
 	Delete(id string) error
 }
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type ApiKeyDB struct {
+	modelStore
+}
+
+func NewApiKeyStore(db *sql.DB, lock *sync.Mutex) repo.ApiKeyStore {
+	return &ApiKeyDB{modelStore{db, lock}}
+}
+
+func (c *ApiKeyDB) Add(key *pb.ApiKey) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stm := `insert into api_keys(id, value, name, scopes, threads, created, expires) values(?,?,?,?,?,?,?)`
+	stmt, err := tx.Prepare(stm)
+	if err != nil {
+		log.Errorf("error in tx prepare: %s", err)
+		return err
+	}
+	defer stmt.Close()
+	scopes := make([]string, len(key.Scopes))
+	for i, s := range key.Scopes {
+		scopes[i] = strconv.Itoa(int(s))
+	}
+	var expires int64
+	if key.Expires != nil {
+		expires = util.ProtoNanos(key.Expires)
+	}
+	_, err = stmt.Exec(
+		key.Id,
+		key.Value,
+		key.Name,
+		strings.Join(scopes, ","),
+		strings.Join(key.Threads, ","),
+		util.ProtoNanos(key.Created),
+		expires,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *ApiKeyDB) Get(id string) *pb.ApiKey {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from api_keys where id='" + id + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *ApiKeyDB) List() []pb.ApiKey {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from api_keys order by created desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *ApiKeyDB) UpdateRevoked(id string, date time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update api_keys set revoked=? where id=?", int64(date.UnixNano()), id)
+	return err
+}
+
+func (c *ApiKeyDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from api_keys where id=?", id)
+	return err
+}
+
+func (c *ApiKeyDB) handleQuery(stm string) []pb.ApiKey {
+	var list []pb.ApiKey
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var id, name, scopes, threads string
+		var value []byte
+		var createdInt, expiresInt, revokedInt int64
+		if err := rows.Scan(&id, &value, &name, &scopes, &threads, &createdInt, &expiresInt, &revokedInt); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		key := pb.ApiKey{
+			Id:      id,
+			Value:   value,
+			Name:    name,
+			Threads: util.SplitString(threads, ","),
+			Created: util.ProtoTs(createdInt),
+		}
+		for _, s := range util.SplitString(scopes, ",") {
+			i, err := strconv.Atoi(s)
+			if err != nil {
+				continue
+			}
+			key.Scopes = append(key.Scopes, pb.ApiKey_Scope(i))
+		}
+		if expiresInt > 0 {
+			key.Expires = util.ProtoTs(expiresInt)
+		}
+		if revokedInt > 0 {
+			key.Revoked = util.ProtoTs(revokedInt)
+		}
+		list = append(list, key)
+	}
+	return list
+}
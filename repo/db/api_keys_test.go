@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var apiKeyStore repo.ApiKeyStore
+
+func init() {
+	setupApiKeyDB()
+}
+
+func setupApiKeyDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	apiKeyStore = NewApiKeyStore(conn, new(sync.Mutex))
+}
+
+func TestApiKeyDB_Add(t *testing.T) {
+	expires, _ := ptypes.TimestampProto(time.Now().Add(time.Hour))
+	err := apiKeyStore.Add(&pb.ApiKey{
+		Id:      "key",
+		Value:   []byte("hash"),
+		Name:    "ci",
+		Scopes:  []pb.ApiKey_Scope{pb.ApiKey_READ, pb.ApiKey_THREAD_WRITE},
+		Threads: []string{"thread1", "thread2"},
+		Created: ptypes.TimestampNow(),
+		Expires: expires,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestApiKeyDB_Get(t *testing.T) {
+	key := apiKeyStore.Get("key")
+	if key == nil {
+		t.Error("get key failed")
+		return
+	}
+	if key.Name != "ci" || string(key.Value) != "hash" {
+		t.Error("wrong key values")
+	}
+	if len(key.Scopes) != 2 || key.Scopes[1] != pb.ApiKey_THREAD_WRITE {
+		t.Error("wrong key scopes")
+	}
+	if len(key.Threads) != 2 || key.Threads[0] != "thread1" {
+		t.Error("wrong key threads")
+	}
+	if key.Expires == nil || key.Revoked != nil {
+		t.Error("wrong key dates")
+	}
+}
+
+func TestApiKeyDB_List(t *testing.T) {
+	if len(apiKeyStore.List()) != 1 {
+		t.Error("list keys failed")
+	}
+}
+
+func TestApiKeyDB_UpdateRevoked(t *testing.T) {
+	if err := apiKeyStore.UpdateRevoked("key", time.Now()); err != nil {
+		t.Error(err)
+		return
+	}
+	if apiKeyStore.Get("key").Revoked == nil {
+		t.Error("key was not revoked")
+	}
+}
+
+func TestApiKeyDB_Delete(t *testing.T) {
+	if err := apiKeyStore.Delete("key"); err != nil {
+		t.Error(err)
+		return
+	}
+	if apiKeyStore.Get("key") != nil {
+		t.Error("delete key failed")
+	}
+}
@@ -70,6 +70,12 @@ func (c *BlockMessageDB) List(offset string, limit int) []pb.BlockMessage {
 	return c.handleQuery("select * from block_messages " + q + "order by date asc limit " + limits + ";")
 }
 
+func (c *BlockMessageDB) Stats() *pb.QueueStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return queueStats(c.db, "block_messages", "", "peerId", nil, false)
+}
+
 func (c *BlockMessageDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
@@ -29,8 +30,8 @@ func (c *BlockDB) Add(block *pb.Block) error {
 	}
 	stmt, err := tx.Prepare(`
         INSERT INTO blocks(
-    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts
-        ) VALUES (?,?,?,?,?,?,?,?,?,?,?)
+    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts, clock, retry
+        ) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?)
     `)
 	if err != nil {
 		return err
@@ -49,6 +50,8 @@ func (c *BlockDB) Add(block *pb.Block) error {
 		block.Data,
 		int32(block.Status),
 		block.Attempts,
+		block.Clock,
+		retryNanos(block.Retry),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -69,15 +72,17 @@ func (c *BlockDB) Replace(block *pb.Block) error {
 
 	stmt, err := tx.Prepare(`
         REPLACE INTO blocks(
-    	    id, threadId, authorId, type, date, parents, target, body, data, status, attempts
-        ) VALUES (?,?,?,?,?,?,?,?,?,?,coalesce((SELECT attempts FROM blocks WHERE id=?),?))
+    	    rowid, id, threadId, authorId, type, date, parents, target, body, data, status, attempts, clock, retry
+        ) VALUES ((SELECT rowid FROM blocks WHERE id=?),?,?,?,?,?,?,?,?,?,?,coalesce((SELECT attempts FROM blocks WHERE id=?),?),?,coalesce((SELECT retry FROM blocks WHERE id=?),?))
     `)
 	if err != nil {
 		return err
 	}
 	defer stmt.Close()
 
+	// keep the rowid so that index order is stable for ListAfter
 	_, err = stmt.Exec(
+		block.Id,
 		block.Id,
 		block.Thread,
 		block.Author,
@@ -90,6 +95,9 @@ func (c *BlockDB) Replace(block *pb.Block) error {
 		int32(block.Status),
 		block.Id,
 		block.Attempts,
+		block.Clock,
+		block.Id,
+		retryNanos(block.Retry),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -129,6 +137,41 @@ func (c *BlockDB) List(offset string, limit int, query string) *pb.BlockList {
 	return c.handleQuery(stm)
 }
 
+func (c *BlockDB) ListCausal(offset string, limit int, query string) *pb.BlockList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	limits := strconv.Itoa(limit)
+	stm := "SELECT * FROM blocks"
+	if offset != "" {
+		if query != "" {
+			query += " and "
+		}
+		clock := "(SELECT clock FROM blocks WHERE id='" + offset + "')"
+		stm += " WHERE " + query + "(clock<" + clock + " or (clock=" + clock + " and id<'" + offset + "'))"
+	} else if query != "" {
+		stm += " WHERE " + query
+	}
+	stm += " ORDER BY clock DESC, id DESC LIMIT " + limits + ";"
+
+	return c.handleQuery(stm)
+}
+
+// ListAfter lists blocks indexed after the block w/ id after, in index order
+func (c *BlockDB) ListAfter(after string, limit int, query string) *pb.BlockList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	limits := strconv.Itoa(limit)
+	stm := "SELECT * FROM blocks WHERE "
+	if query != "" {
+		stm += query + " and "
+	}
+	stm += "rowid>(SELECT rowid FROM blocks WHERE id='" + after + "') ORDER BY rowid ASC LIMIT " + limits + ";"
+
+	return c.handleQuery(stm)
+}
+
 func (c *BlockDB) Count(query string) int {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -146,6 +189,21 @@ func (c *BlockDB) Count(query string) int {
 	return count
 }
 
+func (c *BlockDB) Stats(status pb.Block_BlockStatus) *pb.QueueStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	where := "status=" + strconv.Itoa(int(status))
+
+	// pending downloads don't know their author yet
+	peerCol := "authorId"
+	if status == pb.Block_PENDING {
+		peerCol = ""
+	}
+	return queueStats(c.db, "blocks", where, peerCol, func(s int32) string {
+		return pb.Block_BlockStatus(s).String()
+	}, true)
+}
+
 func (c *BlockDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -162,11 +220,19 @@ func (c *BlockDB) DeleteByThread(threadId string) error {
 	return err
 }
 
-func (c *BlockDB) AddAttempt(id string) error {
+func (c *BlockDB) AddAttempt(id string, retry time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.db.Exec("UPDATE blocks SET attempts=attempts+1, retry=? WHERE id=?", retry.UnixNano(), id)
+	return err
+}
+
+func (c *BlockDB) UpdateClock(id string, clock int64) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	_, err := c.db.Exec("UPDATE blocks SET attempts=attempts+1 WHERE id=?", id)
+	_, err := c.db.Exec("UPDATE blocks SET clock=? WHERE id=?", clock, id)
 	return err
 }
 
@@ -182,9 +248,9 @@ func (c *BlockDB) handleQuery(stm string) *pb.BlockList {
 	for rows.Next() {
 		var id, threadId, authorId, parents, target, body, data string
 		var typeInt, statusInt, attempts int
-		var dateInt int64
+		var dateInt, clock, retry int64
 
-		err = rows.Scan(&id, &threadId, &authorId, &typeInt, &dateInt, &parents, &target, &body, &data, &statusInt, &attempts)
+		err = rows.Scan(&id, &threadId, &authorId, &typeInt, &dateInt, &parents, &target, &body, &data, &statusInt, &attempts, &clock, &retry)
 		if err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
@@ -202,6 +268,8 @@ func (c *BlockDB) handleQuery(stm string) *pb.BlockList {
 			Data:     data,
 			Status:   pb.Block_BlockStatus(statusInt),
 			Attempts: int32(attempts),
+			Clock:    clock,
+			Retry:    retryTs(retry),
 		})
 	}
 
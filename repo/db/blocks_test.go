@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -156,6 +157,109 @@ func TestBlockDB_List(t *testing.T) {
 	}
 }
 
+func TestBlockDB_ListCausal(t *testing.T) {
+	setupBlockDB()
+	for i, id := range []string{"abcde", "fghijk", "lmnop"} {
+		err := blockStore.Add(&pb.Block{
+			Id:      id,
+			Thread:  "thread_id",
+			Author:  "author_id",
+			Type:    pb.Block_TEXT,
+			Date:    util.ProtoTs(time.Now().Add(-time.Duration(i) * time.Minute).UnixNano()),
+			Parents: []string{"Qm123"},
+			Status:  pb.Block_READY,
+			Clock:   int64(i / 2),
+		})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	// clock desc, then id desc, regardless of date
+	all := blockStore.ListCausal("", -1, "threadId='thread_id'").Items
+	if len(all) != 3 {
+		t.Error("returned incorrect number of blocks")
+		return
+	}
+	if all[0].Id != "lmnop" || all[1].Id != "fghijk" || all[2].Id != "abcde" {
+		t.Error("returned blocks in wrong order")
+		return
+	}
+
+	offset := blockStore.ListCausal("fghijk", -1, "threadId='thread_id'").Items
+	if len(offset) != 1 || offset[0].Id != "abcde" {
+		t.Error("returned incorrect offset blocks")
+	}
+}
+
+func TestBlockDB_UpdateClock(t *testing.T) {
+	err := blockStore.UpdateClock("abcde", 5)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	block := blockStore.Get("abcde")
+	if block == nil || block.Clock != 5 {
+		t.Error("update clock failed")
+	}
+}
+
+func TestBlockDB_ListAfter(t *testing.T) {
+	// replacing a block keeps its place in index order
+	block := blockStore.Get("abcde")
+	block.Status = pb.Block_PENDING
+	err := blockStore.Replace(block)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	after := blockStore.ListAfter("abcde", -1, "threadId='thread_id'").Items
+	if len(after) != 2 || after[0].Id != "fghijk" || after[1].Id != "lmnop" {
+		t.Error("returned incorrect blocks after cursor")
+		return
+	}
+	if len(blockStore.ListAfter("lmnop", -1, "").Items) != 0 {
+		t.Error("returned blocks after last block")
+		return
+	}
+	if len(blockStore.ListAfter("unknown", -1, "").Items) != 0 {
+		t.Error("returned blocks after unknown cursor")
+	}
+}
+
+func TestBlockDB_Stats(t *testing.T) {
+	setupBlockDB()
+	for i, status := range []pb.Block_BlockStatus{pb.Block_QUEUED, pb.Block_PENDING, pb.Block_PENDING} {
+		err := blockStore.Add(&pb.Block{
+			Id:     "stats" + strconv.Itoa(i),
+			Thread: "thread_id",
+			Author: "author_id",
+			Date:   ptypes.TimestampNow(),
+			Status: status,
+		})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+	}
+
+	stats := blockStore.Stats(pb.Block_QUEUED)
+	if stats.Count != 1 || stats.Peers["author_id"] != 1 {
+		t.Errorf("wrong queued stats: %v", stats)
+	}
+
+	// pending downloads aren't broken down by peer
+	stats = blockStore.Stats(pb.Block_PENDING)
+	if stats.Count != 2 || stats.Statuses[pb.Block_PENDING.String()] != 2 {
+		t.Errorf("wrong pending stats: %v", stats)
+	}
+	if len(stats.Peers) != 0 {
+		t.Errorf("expected no peers, got %v", stats.Peers)
+	}
+}
+
 func TestBlockDB_Count(t *testing.T) {
 	setupBlockDB()
 	err := blockStore.Add(&pb.Block{
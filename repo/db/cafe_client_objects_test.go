@@ -0,0 +1,82 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientObjectStore repo.CafeClientObjectStore
+
+func init() {
+	setupCafeClientObjectDB()
+}
+
+func setupCafeClientObjectDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeClientObjectStore = NewCafeClientObjectStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientObjectDB_Add(t *testing.T) {
+	for _, obj := range []*pb.CafeClientObject{
+		{Id: "cid1", Client: "client", Size: 1024, Date: ptypes.TimestampNow()},
+		{Id: "cid2", Client: "client", Size: 512, Date: ptypes.TimestampNow()},
+		{Id: "cid1", Client: "other", Size: 1024, Date: ptypes.TimestampNow()},
+	} {
+		if err := cafeClientObjectStore.Add(obj); err != nil {
+			t.Error(err)
+			return
+		}
+	}
+	err := cafeClientObjectStore.Add(&pb.CafeClientObject{Id: "cid1", Client: "client", Date: ptypes.TimestampNow()})
+	if err == nil {
+		t.Error("duplicate object reference should fail")
+	}
+}
+
+func TestCafeClientObjectDB_Get(t *testing.T) {
+	obj := cafeClientObjectStore.Get("cid2", "client")
+	if obj == nil || obj.Size != 512 {
+		t.Error("get object failed")
+	}
+	if cafeClientObjectStore.Get("cid2", "other") != nil {
+		t.Error("object should not be referenced by other")
+	}
+}
+
+func TestCafeClientObjectDB_ListByClient(t *testing.T) {
+	if len(cafeClientObjectStore.ListByClient("client")) != 2 {
+		t.Error("list by client failed")
+	}
+}
+
+func TestCafeClientObjectDB_Count(t *testing.T) {
+	if cafeClientObjectStore.Count("cid1") != 2 {
+		t.Error("count failed")
+	}
+}
+
+func TestCafeClientObjectDB_Delete(t *testing.T) {
+	if err := cafeClientObjectStore.Delete("cid1", "other"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientObjectStore.Count("cid1") != 1 {
+		t.Error("delete failed")
+	}
+}
+
+func TestCafeClientObjectDB_DeleteByClient(t *testing.T) {
+	if err := cafeClientObjectStore.DeleteByClient("client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if len(cafeClientObjectStore.ListByClient("client")) != 0 {
+		t.Error("delete by client failed")
+	}
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type CafeClientUsageDB struct {
+	modelStore
+}
+
+func NewCafeClientUsageStore(db *sql.DB, lock *sync.Mutex) repo.CafeClientUsageStore {
+	return &CafeClientUsageDB{modelStore{db, lock}}
+}
+
+// Add adjusts a client's usage by the given deltas, which may be negative.
+// Usage never drops below zero.
+func (c *CafeClientUsageDB) Add(clientId string, bytes int64, objects int64) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	now := time.Now().UnixNano()
+	_, err = tx.Exec("insert or ignore into cafe_client_usage(clientId, bytes, objects, updated) values(?,0,0,?)", clientId, now)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	stm := `update cafe_client_usage set bytes=max(bytes+?, 0), objects=max(objects+?, 0), updated=? where clientId=?`
+	_, err = tx.Exec(stm, bytes, objects, now, clientId)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+func (c *CafeClientUsageDB) Get(clientId string) *pb.CafeClientUsage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	res := c.handleQuery("select * from cafe_client_usage where clientId='" + clientId + "';")
+	if len(res) == 0 {
+		return nil
+	}
+	return &res[0]
+}
+
+func (c *CafeClientUsageDB) List() []pb.CafeClientUsage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	stm := "select * from cafe_client_usage order by bytes desc;"
+	return c.handleQuery(stm)
+}
+
+func (c *CafeClientUsageDB) Delete(clientId string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("delete from cafe_client_usage where clientId=?", clientId)
+	return err
+}
+
+func (c *CafeClientUsageDB) handleQuery(stm string) []pb.CafeClientUsage {
+	var list []pb.CafeClientUsage
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return nil
+	}
+	for rows.Next() {
+		var clientId string
+		var bytes, objects, updated int64
+		if err := rows.Scan(&clientId, &bytes, &objects, &updated); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		list = append(list, pb.CafeClientUsage{
+			Id:      clientId,
+			Bytes:   bytes,
+			Objects: objects,
+			Updated: util.ProtoTs(updated),
+		})
+	}
+	return list
+}
@@ -0,0 +1,78 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/textileio/go-textile/repo"
+)
+
+var cafeClientUsageStore repo.CafeClientUsageStore
+
+func init() {
+	setupCafeClientUsageDB()
+}
+
+func setupCafeClientUsageDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	cafeClientUsageStore = NewCafeClientUsageStore(conn, new(sync.Mutex))
+}
+
+func TestCafeClientUsageDB_Add(t *testing.T) {
+	if err := cafeClientUsageStore.Add("client", 1024, 2); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := cafeClientUsageStore.Add("client", 512, 1); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := cafeClientUsageStore.Add("other", 64, 1); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCafeClientUsageDB_Get(t *testing.T) {
+	usage := cafeClientUsageStore.Get("client")
+	if usage == nil {
+		t.Error("get usage failed")
+		return
+	}
+	if usage.Bytes != 1536 || usage.Objects != 3 {
+		t.Errorf("bad usage: %d bytes, %d objects", usage.Bytes, usage.Objects)
+	}
+}
+
+func TestCafeClientUsageDB_AddNegative(t *testing.T) {
+	if err := cafeClientUsageStore.Add("other", -128, -2); err != nil {
+		t.Error(err)
+		return
+	}
+	usage := cafeClientUsageStore.Get("other")
+	if usage.Bytes != 0 || usage.Objects != 0 {
+		t.Error("usage should not drop below zero")
+	}
+}
+
+func TestCafeClientUsageDB_List(t *testing.T) {
+	list := cafeClientUsageStore.List()
+	if len(list) != 2 {
+		t.Error("list usage failed")
+		return
+	}
+	if list[0].Id != "client" {
+		t.Error("list should be ordered by bytes")
+	}
+}
+
+func TestCafeClientUsageDB_Delete(t *testing.T) {
+	if err := cafeClientUsageStore.Delete("client"); err != nil {
+		t.Error(err)
+		return
+	}
+	if cafeClientUsageStore.Get("client") != nil {
+		t.Error("delete usage failed")
+	}
+}
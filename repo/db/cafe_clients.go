@@ -86,6 +86,20 @@ func (c *CafeClientDB) UpdateLastSeen(id string, date time.Time) error {
 	return err
 }
 
+func (c *CafeClientDB) UpdateRevoked(id string, date time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set revoked=? where id=?", int64(date.UnixNano()), id)
+	return err
+}
+
+func (c *CafeClientDB) UpdateWebhook(id string, url string, secret string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update cafe_clients set webhookUrl=?, webhookSecret=? where id=?", url, secret, id)
+	return err
+}
+
 func (c *CafeClientDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -101,19 +115,25 @@ func (c *CafeClientDB) handleQuery(stm string) []pb.CafeClient {
 		return nil
 	}
 	for rows.Next() {
-		var id, address, tokenId string
-		var createdInt, lastSeenInt int64
-		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId); err != nil {
+		var id, address, tokenId, webhookUrl, webhookSecret string
+		var createdInt, lastSeenInt, revokedInt int64
+		if err := rows.Scan(&id, &address, &createdInt, &lastSeenInt, &tokenId, &revokedInt, &webhookUrl, &webhookSecret); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
-		list = append(list, pb.CafeClient{
-			Id:      id,
-			Address: address,
-			Created: util.ProtoTs(createdInt),
-			Seen:    util.ProtoTs(lastSeenInt),
-			Token:   tokenId,
-		})
+		client := pb.CafeClient{
+			Id:            id,
+			Address:       address,
+			Created:       util.ProtoTs(createdInt),
+			Seen:          util.ProtoTs(lastSeenInt),
+			Token:         tokenId,
+			WebhookUrl:    webhookUrl,
+			WebhookSecret: webhookSecret,
+		}
+		if revokedInt > 0 {
+			client.Revoked = util.ProtoTs(revokedInt)
+		}
+		list = append(list, client)
 	}
 	return list
 }
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
@@ -25,7 +26,7 @@ func (c *CafeMessageDB) Add(req *pb.CafeMessage) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into cafe_messages(id, peerId, date, attempts) values(?,?,?,?)`
+	stm := `insert into cafe_messages(id, peerId, date, attempts, retry) values(?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -37,6 +38,7 @@ func (c *CafeMessageDB) Add(req *pb.CafeMessage) error {
 		req.Peer,
 		util.ProtoNanos(req.Date),
 		req.Attempts,
+		retryNanos(req.Retry),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -57,13 +59,19 @@ func (c *CafeMessageDB) List(offset string, limit int) []pb.CafeMessage {
 	return c.handleQuery(stm)
 }
 
-func (c *CafeMessageDB) AddAttempt(id string) error {
+func (c *CafeMessageDB) AddAttempt(id string, retry time.Time) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
-	_, err := c.db.Exec("update cafe_messages set attempts=attempts+1 where id=?", id)
+	_, err := c.db.Exec("update cafe_messages set attempts=attempts+1, retry=? where id=?", retry.UnixNano(), id)
 	return err
 }
 
+func (c *CafeMessageDB) Stats() *pb.QueueStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return queueStats(c.db, "cafe_messages", "", "peerId", nil, true)
+}
+
 func (c *CafeMessageDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -80,9 +88,9 @@ func (c *CafeMessageDB) handleQuery(stm string) []pb.CafeMessage {
 	}
 	for rows.Next() {
 		var id, peerId string
-		var dateInt int64
+		var dateInt, retry int64
 		var attempts int
-		if err := rows.Scan(&id, &peerId, &dateInt, &attempts); err != nil {
+		if err := rows.Scan(&id, &peerId, &dateInt, &attempts, &retry); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
@@ -91,6 +99,7 @@ func (c *CafeMessageDB) handleQuery(stm string) []pb.CafeMessage {
 			Peer:     peerId,
 			Date:     util.ProtoTs(dateInt),
 			Attempts: int32(attempts),
+			Retry:    retryTs(retry),
 		})
 	}
 	return list
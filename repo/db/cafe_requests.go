@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"strconv"
 	"sync"
+	"time"
 
 	"github.com/textileio/go-textile/pb"
 	"github.com/textileio/go-textile/repo"
@@ -29,8 +30,8 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 	}
 	stmt, err := tx.Prepare(`
         INSERT INTO cafe_requests(
-    	    id, peerId, targetId, cafeId, cafe, groupId, syncGroupId, type, date, size, status, attempts, groupSize, groupTransferred
-        ) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?)
+    	    id, peerId, targetId, cafeId, cafe, groupId, syncGroupId, type, date, size, status, attempts, groupSize, groupTransferred, retry
+        ) VALUES (?,?,?,?,?,?,?,?,?,?,?,?,?,?,?)
     `)
 	if err != nil {
 		return err
@@ -57,6 +58,7 @@ func (c *CafeRequestDB) Add(req *pb.CafeRequest) error {
 		req.Attempts,
 		req.GroupSize,
 		req.GroupTransferred,
+		retryNanos(req.Retry),
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -284,14 +286,22 @@ func (c *CafeRequestDB) UpdateGroupProgress(groupId string, transferred int64, t
 	return err
 }
 
-func (c *CafeRequestDB) AddAttempt(id string) error {
+func (c *CafeRequestDB) AddAttempt(id string, retry time.Time) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	_, err := c.db.Exec("UPDATE cafe_requests SET attempts=attempts+1 WHERE id=?", id)
+	_, err := c.db.Exec("UPDATE cafe_requests SET attempts=attempts+1, retry=? WHERE id=?", retry.UnixNano(), id)
 	return err
 }
 
+func (c *CafeRequestDB) Stats() *pb.QueueStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return queueStats(c.db, "cafe_requests", "", "cafeId", func(s int32) string {
+		return pb.CafeRequest_Status(s).String()
+	}, true)
+}
+
 func (c *CafeRequestDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -360,7 +370,7 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 	for rows.Next() {
 		var id, peerId, targetId, cafeId, groupId, syncGroupId string
 		var typeInt, statusInt, attempts int
-		var dateInt, size, groupSize, groupTransferred int64
+		var dateInt, size, groupSize, groupTransferred, retry int64
 		var cafe []byte
 
 		err = rows.Scan(
@@ -377,7 +387,8 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 			&statusInt,
 			&attempts,
 			&groupSize,
-			&groupTransferred)
+			&groupTransferred,
+			&retry)
 		if err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
@@ -404,6 +415,7 @@ func (c *CafeRequestDB) handleQuery(stm string) *pb.CafeRequestList {
 			Attempts:         int32(attempts),
 			GroupSize:        groupSize,
 			GroupTransferred: groupTransferred,
+			Retry:            retryTs(retry),
 		})
 	}
 
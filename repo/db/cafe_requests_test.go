@@ -339,7 +339,8 @@ func TestCafeRequestDB_DeleteCompleteSyncGroupsAgain(t *testing.T) {
 }
 
 func TestCafeRequestDB_AddAttempt(t *testing.T) {
-	err := cafeRequestStore.AddAttempt("abcde")
+	retry := time.Now().Add(time.Minute)
+	err := cafeRequestStore.AddAttempt("abcde", retry)
 	if err != nil {
 		t.Error(err)
 		return
@@ -348,6 +349,32 @@ func TestCafeRequestDB_AddAttempt(t *testing.T) {
 	if req.Attempts != 1 {
 		t.Error("wrong attempts")
 	}
+	if util.ProtoNanos(req.Retry) != retry.UnixNano() {
+		t.Error("wrong retry")
+	}
+}
+
+func TestCafeRequestDB_Stats(t *testing.T) {
+	stats := cafeRequestStore.Stats()
+	if stats.Count == 0 || stats.Oldest == nil {
+		t.Error("stats missing requests")
+	}
+	if stats.Backoff != 1 {
+		t.Errorf("expected 1 request backing off, got %d", stats.Backoff)
+	}
+	var statuses, peers int32
+	for _, c := range stats.Statuses {
+		statuses += c
+	}
+	for _, c := range stats.Peers {
+		peers += c
+	}
+	if statuses != stats.Count || peers != stats.Count {
+		t.Error("stats breakdown does not match count")
+	}
+	if stats.Peers[testCafe.Peer] == 0 {
+		t.Error("stats missing cafe breakdown")
+	}
 }
 
 func TestCafeRequestDB_Delete(t *testing.T) {
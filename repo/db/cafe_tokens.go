@@ -60,6 +60,17 @@ func (c *CafeTokenDB) List() []pb.CafeToken {
 	return c.handleQuery(stm)
 }
 
+func (c *CafeTokenDB) UpdateDisabled(id string, disabled bool) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	var d int
+	if disabled {
+		d = 1
+	}
+	_, err := c.db.Exec("update cafe_tokens set disabled=? where id=?", d, id)
+	return err
+}
+
 func (c *CafeTokenDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -78,14 +89,16 @@ func (c *CafeTokenDB) handleQuery(stm string) []pb.CafeToken {
 		var id string
 		var token []byte
 		var dateInt int64
-		if err := rows.Scan(&id, &token, &dateInt); err != nil {
+		var disabledInt int
+		if err := rows.Scan(&id, &token, &dateInt, &disabledInt); err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
 		list = append(list, pb.CafeToken{
-			Id:    id,
-			Value: token,
-			Date:  util.ProtoTs(dateInt),
+			Id:       id,
+			Value:    token,
+			Date:     util.ProtoTs(dateInt),
+			Disabled: disabledInt == 1,
 		})
 	}
 	return list
@@ -7,9 +7,11 @@ import (
 	"sync"
 
 	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	logging "github.com/ipfs/go-log"
 	_ "github.com/mutecomm/go-sqlcipher"
 	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
 )
 
 var log = logging.Logger("tex-datastore")
@@ -35,11 +37,15 @@ type SQLiteDatastore struct {
 	cafeSessions       repo.CafeSessionStore
 	cafeRequests       repo.CafeRequestStore
 	cafeMessages       repo.CafeMessageStore
+	deadLetters        repo.DeadLetterStore
 	cafeClientNonces   repo.CafeClientNonceStore
 	cafeClients        repo.CafeClientStore
 	cafeTokens         repo.CafeTokenStore
 	cafeClientThreads  repo.CafeClientThreadStore
 	cafeClientMessages repo.CafeClientMessageStore
+	cafeClientObjects  repo.CafeClientObjectStore
+	cafeClientUsage    repo.CafeClientUsageStore
+	apiKeys            repo.ApiKeyStore
 	botsStore          repo.Botstore
 	db                 *sql.DB
 	lock               *sync.Mutex
@@ -73,11 +79,15 @@ func Create(repoPath, pin string) (*SQLiteDatastore, error) {
 		cafeSessions:       NewCafeSessionStore(conn, lock),
 		cafeRequests:       NewCafeRequestStore(conn, lock),
 		cafeMessages:       NewCafeMessageStore(conn, lock),
+		deadLetters:        NewDeadLetterStore(conn, lock),
 		cafeClientNonces:   NewCafeClientNonceStore(conn, lock),
 		cafeClients:        NewCafeClientStore(conn, lock),
 		cafeTokens:         NewCafeTokenStore(conn, lock),
 		cafeClientThreads:  NewCafeClientThreadStore(conn, lock),
 		cafeClientMessages: NewCafeClientMessageStore(conn, lock),
+		cafeClientObjects:  NewCafeClientObjectStore(conn, lock),
+		cafeClientUsage:    NewCafeClientUsageStore(conn, lock),
+		apiKeys:            NewApiKeyStore(conn, lock),
 		botsStore:          NewBotstore(conn, lock),
 		db:                 conn,
 		lock:               lock,
@@ -140,6 +150,10 @@ func (d *SQLiteDatastore) CafeMessages() repo.CafeMessageStore {
 	return d.cafeMessages
 }
 
+func (d *SQLiteDatastore) DeadLetters() repo.DeadLetterStore {
+	return d.deadLetters
+}
+
 func (d *SQLiteDatastore) CafeClientNonces() repo.CafeClientNonceStore {
 	return d.cafeClientNonces
 }
@@ -160,6 +174,18 @@ func (d *SQLiteDatastore) CafeClientMessages() repo.CafeClientMessageStore {
 	return d.cafeClientMessages
 }
 
+func (d *SQLiteDatastore) CafeClientObjects() repo.CafeClientObjectStore {
+	return d.cafeClientObjects
+}
+
+func (d *SQLiteDatastore) CafeClientUsage() repo.CafeClientUsageStore {
+	return d.cafeClientUsage
+}
+
+func (d *SQLiteDatastore) ApiKeys() repo.ApiKeyStore {
+	return d.apiKeys
+}
+
 func (d *SQLiteDatastore) Bots() repo.Botstore {
 	return d.botsStore
 }
@@ -208,6 +234,22 @@ func ConflictError(err error) bool {
 	return strings.Contains(err.Error(), "UNIQUE constraint failed")
 }
 
+// retryNanos returns zero for an unset retry time
+func retryNanos(ts *timestamp.Timestamp) int64 {
+	if ts == nil {
+		return 0
+	}
+	return util.ProtoNanos(ts)
+}
+
+// retryTs returns nil for a zero retry time
+func retryTs(nsec int64) *timestamp.Timestamp {
+	if nsec == 0 {
+		return nil
+	}
+	return util.ProtoTs(nsec)
+}
+
 func initDatabaseTables(db *sql.DB, pin string) error {
 	var sqlStmt string
 	if pin != "" {
@@ -220,12 +262,13 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index peer_address on peers (address);
     create index peer_username on peers (username);
     create index peer_updated on peers (updated);
+    create table peer_reachability (peerId text not null, path integer not null, successes integer not null, failures integer not null, lastSuccess integer not null, lastFailure integer not null, primary key (peerId, path));
 
     create table files (mill text not null, checksum text not null, source text not null, opts text not null, hash text not null, key text not null, media text not null, name text not null, size integer not null, added integer not null, meta blob, targets text, primary key (mill, checksum));
     create index file_hash on files (hash);
     create unique index file_mill_source_opts on files (mill, source, opts);
 
-    create table threads (id text primary key not null, key text not null, sk blob not null, name text not null, schema text not null, initiator text not null, type integer not null, state integer not null, head text not null, members text not null, sharing integer not null);
+    create table threads (id text primary key not null, key text not null, sk blob not null, name text not null, schema text not null, initiator text not null, type integer not null, state integer not null, head text not null, members text not null, sharing integer not null, forkThread text not null, forkHead text not null);
     create unique index thread_key on threads (key);
 
     create table thread_peers (id text not null, threadId text not null, welcomed integer not null, primary key (id, threadId));
@@ -233,10 +276,11 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index thread_peer_threadId on thread_peers (threadId);
     create index thread_peer_welcomed on thread_peers (welcomed);
 
-    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null);
+    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null, clock integer not null, retry integer not null);
     create index block_threadId on blocks (threadId);
     create index block_type on blocks (type);
     create index block_date on blocks (date);
+    create index block_clock on blocks (clock);
     create index block_target on blocks (target);
     create index block_data on blocks (data);
     create index block_status on blocks (status);
@@ -256,19 +300,23 @@ func initDatabaseTables(db *sql.DB, pin string) error {
 
     create table cafe_sessions (cafeId text primary key not null, access text not null, refresh text not null, expiry integer not null, cafe blob not null);
 
-    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null);
+    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null, retry integer not null);
     create index cafe_request_cafeId on cafe_requests (cafeId);
     create index cafe_request_groupId on cafe_requests (groupId);
     create index cafe_request_syncGroupId on cafe_requests (syncGroupId);
     create index cafe_request_date on cafe_requests (date);
     create index cafe_request_status on cafe_requests (status);
 
-    create table cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+    create table cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null, retry integer not null);
     create index cafe_message_date on cafe_messages (date);
 
+    create table dead_letters (id text primary key not null, queue integer not null, item blob not null, attempts integer not null, error text not null, date integer not null);
+    create index dead_letter_queue on dead_letters (queue);
+    create index dead_letter_date on dead_letters (date);
+
     create table cafe_client_nonces (value text primary key not null, address text not null, date integer not null);
 
-    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null);
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null, revoked integer not null default 0, webhookUrl text not null default '', webhookSecret text not null default '');
     create index cafe_client_address on cafe_clients (address);
     create index cafe_client_lastSeen on cafe_clients (lastSeen);
 
@@ -279,7 +327,14 @@ func initDatabaseTables(db *sql.DB, pin string) error {
     create index cafe_client_message_clientId on cafe_client_messages (clientId);
     create index cafe_client_message_date on cafe_client_messages (date);
 
-		create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+    create table cafe_client_objects (id text not null, clientId text not null, size integer not null, date integer not null, primary key (id, clientId));
+    create index cafe_client_object_clientId on cafe_client_objects (clientId);
+
+    create table cafe_client_usage (clientId text primary key not null, bytes integer not null, objects integer not null, updated integer not null);
+
+    create table api_keys (id text primary key not null, value blob not null, name text not null, scopes text not null, threads text not null, created integer not null, expires integer not null, revoked integer not null default 0);
+
+		create table cafe_tokens (id text primary key not null, token text not null, date integer not null, disabled integer not null default 0);
 		
 		create table bots_store (id text primary key not null, value blob, created integer not null, updated integer not null);
     `
@@ -0,0 +1,161 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+	"github.com/textileio/go-textile/util"
+)
+
+type DeadLetterDB struct {
+	modelStore
+}
+
+func NewDeadLetterStore(db *sql.DB, lock *sync.Mutex) repo.DeadLetterStore {
+	return &DeadLetterDB{modelStore{db, lock}}
+}
+
+func (c *DeadLetterDB) Add(letter *pb.DeadLetter) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	item, err := proto.Marshal(letter.Item)
+	if err != nil {
+		return err
+	}
+
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	stmt, err := tx.Prepare(`
+        INSERT OR REPLACE INTO dead_letters(id, queue, item, attempts, error, date) VALUES (?,?,?,?,?,?)
+    `)
+	if err != nil {
+		return err
+	}
+	defer stmt.Close()
+
+	_, err = stmt.Exec(
+		letter.Id,
+		int32(letter.Queue),
+		item,
+		letter.Attempts,
+		letter.Error,
+		util.ProtoNanos(letter.Date),
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (c *DeadLetterDB) Get(id string) *pb.DeadLetter {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	res := c.handleQuery("SELECT * FROM dead_letters WHERE id='" + id + "';")
+	if len(res.Items) == 0 {
+		return nil
+	}
+	return res.Items[0]
+}
+
+func (c *DeadLetterDB) List(offset string, limit int, query string) *pb.DeadLetterList {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stm := "SELECT * FROM dead_letters"
+	if offset != "" {
+		if query != "" {
+			query += " and "
+		}
+		stm += " WHERE " + query + "(date<(SELECT date FROM dead_letters WHERE id='" + offset + "'))"
+	} else if query != "" {
+		stm += " WHERE " + query
+	}
+	stm += " ORDER BY date DESC LIMIT " + strconv.Itoa(limit) + ";"
+
+	return c.handleQuery(stm)
+}
+
+func (c *DeadLetterDB) Count(query string) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stm := "SELECT COUNT(*) FROM dead_letters"
+	if query != "" {
+		stm += " WHERE " + query
+	}
+	stm += ";"
+
+	row := c.db.QueryRow(stm)
+	var count int
+	_ = row.Scan(&count)
+
+	return count
+}
+
+func (c *DeadLetterDB) Delete(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.db.Exec("DELETE FROM dead_letters WHERE id=?", id)
+	return err
+}
+
+func (c *DeadLetterDB) DeleteByQueue(queue pb.DeadLetter_Queue) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, err := c.db.Exec("DELETE FROM dead_letters WHERE queue=?", int32(queue))
+	return err
+}
+
+func (c *DeadLetterDB) handleQuery(stm string) *pb.DeadLetterList {
+	list := &pb.DeadLetterList{Items: make([]*pb.DeadLetter, 0)}
+
+	rows, err := c.db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+
+	for rows.Next() {
+		var id, lastErr string
+		var queueInt, attempts int
+		var dateInt int64
+		var itemb []byte
+
+		err = rows.Scan(&id, &queueInt, &itemb, &attempts, &lastErr, &dateInt)
+		if err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+
+		item := new(any.Any)
+		err = proto.Unmarshal(itemb, item)
+		if err != nil {
+			log.Errorf("error unmarshaling item: %s", err)
+			continue
+		}
+
+		list.Items = append(list.Items, &pb.DeadLetter{
+			Id:       id,
+			Queue:    pb.DeadLetter_Queue(queueInt),
+			Item:     item,
+			Attempts: int32(attempts),
+			Error:    lastErr,
+			Date:     util.ProtoTs(dateInt),
+		})
+	}
+
+	return list
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"database/sql"
+	"sync"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/repo"
+)
+
+var deadLetterStore repo.DeadLetterStore
+
+func init() {
+	setupDeadLetterDB()
+}
+
+func setupDeadLetterDB() {
+	conn, _ := sql.Open("sqlite3", ":memory:")
+	_ = initDatabaseTables(conn, "")
+	deadLetterStore = NewDeadLetterStore(conn, new(sync.Mutex))
+}
+
+func TestDeadLetterDB_Add(t *testing.T) {
+	item, err := ptypes.MarshalAny(&pb.CafeMessage{
+		Id:       "abcde",
+		Peer:     "peer",
+		Date:     ptypes.TimestampNow(),
+		Attempts: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = deadLetterStore.Add(&pb.DeadLetter{
+		Id:       "abcde",
+		Queue:    pb.DeadLetter_CAFE_INBOX,
+		Item:     item,
+		Attempts: 5,
+		Error:    "timeout",
+		Date:     ptypes.TimestampNow(),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	stmt, err := deadLetterStore.PrepareQuery("select id from dead_letters where id=?")
+	if err != nil {
+		t.Error(err)
+	}
+	defer stmt.Close()
+	var id string
+	err = stmt.QueryRow("abcde").Scan(&id)
+	if err != nil {
+		t.Error(err)
+	}
+	if id != "abcde" {
+		t.Errorf(`expected "abcde" got %s`, id)
+	}
+}
+
+func TestDeadLetterDB_Get(t *testing.T) {
+	letter := deadLetterStore.Get("abcde")
+	if letter == nil {
+		t.Fatal("could not get dead letter")
+	}
+	msg := new(pb.CafeMessage)
+	err := ptypes.UnmarshalAny(letter.Item, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if msg.Peer != "peer" || letter.Error != "timeout" {
+		t.Error("wrong dead letter")
+	}
+}
+
+func TestDeadLetterDB_List(t *testing.T) {
+	setupDeadLetterDB()
+	for i, queue := range []pb.DeadLetter_Queue{pb.DeadLetter_BLOCK_DOWNLOADS, pb.DeadLetter_CAFE_REQUESTS, pb.DeadLetter_CAFE_REQUESTS} {
+		item, err := ptypes.MarshalAny(&pb.Block{Id: string(rune('a' + i))})
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = deadLetterStore.Add(&pb.DeadLetter{
+			Id:    string(rune('a' + i)),
+			Queue: queue,
+			Item:  item,
+			Date:  ptypes.TimestampNow(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := deadLetterStore.List("", -1, "")
+	if len(list.Items) != 3 {
+		t.Error("returned incorrect number of dead letters")
+	}
+	if list.Items[0].Id != "c" {
+		t.Error("dead letters are not newest first")
+	}
+	list = deadLetterStore.List("c", -1, "queue=3")
+	if len(list.Items) != 1 {
+		t.Error("returned incorrect number of dead letters")
+	}
+}
+
+func TestDeadLetterDB_Count(t *testing.T) {
+	if deadLetterStore.Count("queue=3") != 2 {
+		t.Error("returned incorrect count of dead letters")
+	}
+}
+
+func TestDeadLetterDB_Delete(t *testing.T) {
+	err := deadLetterStore.Delete("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deadLetterStore.Get("a") != nil {
+		t.Error("delete failed")
+	}
+}
+
+func TestDeadLetterDB_DeleteByQueue(t *testing.T) {
+	err := deadLetterStore.DeleteByQueue(pb.DeadLetter_CAFE_REQUESTS)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deadLetterStore.Count("") != 0 {
+		t.Error("delete by queue failed")
+	}
+}
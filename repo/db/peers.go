@@ -242,6 +242,10 @@ func (c *PeerDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
 	_, err := c.db.Exec("delete from peers where id=?", id)
+	if err != nil {
+		return err
+	}
+	_, err = c.db.Exec("delete from peer_reachability where peerId=?", id)
 	return err
 }
 
@@ -252,6 +256,75 @@ func (c *PeerDB) DeleteByAddress(address string) error {
 	return err
 }
 
+// AddReachability records a delivery attempt to a peer over the given path
+func (c *PeerDB) AddReachability(id string, path pb.PeerReachability_Path, reached bool) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	now := time.Now().UnixNano()
+	var successes, failures int
+	var lastSuccess, lastFailure int64
+	if reached {
+		successes = 1
+		lastSuccess = now
+	} else {
+		failures = 1
+		lastFailure = now
+	}
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec("insert or ignore into peer_reachability(peerId, path, successes, failures, lastSuccess, lastFailure) values(?,?,0,0,0,0)", id, int32(path))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	stm := `update peer_reachability set successes=successes+?, failures=failures+?,
+		lastSuccess=max(lastSuccess, ?), lastFailure=max(lastFailure, ?) where peerId=? and path=?`
+	_, err = tx.Exec(stm, successes, failures, lastSuccess, lastFailure, id, int32(path))
+	if err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetReachability returns delivery history for a peer, one entry per path
+func (c *PeerDB) GetReachability(id string) []*pb.PeerReachability {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	list := make([]*pb.PeerReachability, 0)
+	rows, err := c.db.Query("select * from peer_reachability where peerId=? order by path asc;", id)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return list
+	}
+	for rows.Next() {
+		var peerId string
+		var path int32
+		var successes, failures int32
+		var lastSuccess, lastFailure int64
+		if err := rows.Scan(&peerId, &path, &successes, &failures, &lastSuccess, &lastFailure); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		item := &pb.PeerReachability{
+			Peer:      peerId,
+			Path:      pb.PeerReachability_Path(path),
+			Successes: successes,
+			Failures:  failures,
+		}
+		if lastSuccess > 0 {
+			item.LastSuccess = util.ProtoTs(lastSuccess)
+		}
+		if lastFailure > 0 {
+			item.LastFailure = util.ProtoTs(lastFailure)
+		}
+		list = append(list, item)
+	}
+	return list
+}
+
 func (c *PeerDB) handleQuery(stm string) []*pb.Peer {
 	list := make([]*pb.Peer, 0)
 	rows, err := c.db.Query(stm)
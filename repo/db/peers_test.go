@@ -248,6 +248,46 @@ func TestPeerDB_UpdateInboxes(t *testing.T) {
 	}
 }
 
+func TestPeerDB_AddReachability(t *testing.T) {
+	if err := peerStore.AddReachability("abcde", pb.PeerReachability_DIRECT, false); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := peerStore.AddReachability("abcde", pb.PeerReachability_DIRECT, false); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := peerStore.AddReachability("abcde", pb.PeerReachability_RELAY, true); err != nil {
+		t.Error(err)
+		return
+	}
+	if err := peerStore.AddReachability("abcde", pb.PeerReachability_DIRECT, true); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestPeerDB_GetReachability(t *testing.T) {
+	list := peerStore.GetReachability("abcde")
+	if len(list) != 2 {
+		t.Error("get reachability failed")
+		return
+	}
+	direct := list[0]
+	if direct.Path != pb.PeerReachability_DIRECT || direct.Successes != 1 || direct.Failures != 2 {
+		t.Error("direct reachability has bad counts")
+	}
+	if direct.LastSuccess == nil || direct.LastFailure == nil {
+		t.Error("direct reachability missing dates")
+	}
+	relay := list[1]
+	if relay.Path != pb.PeerReachability_RELAY || relay.Successes != 1 || relay.Failures != 0 {
+		t.Error("relay reachability has bad counts")
+	}
+	if relay.LastFailure != nil {
+		t.Error("relay reachability should not have a failure date")
+	}
+}
+
 func TestPeerDB_Delete(t *testing.T) {
 	err := peerStore.Delete("abcde")
 	if err != nil {
@@ -263,4 +303,7 @@ func TestPeerDB_Delete(t *testing.T) {
 	if err == nil {
 		t.Error("delete failed")
 	}
+	if len(peerStore.GetReachability("abcde")) != 0 {
+		t.Error("delete reachability failed")
+	}
 }
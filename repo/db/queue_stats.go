@@ -0,0 +1,78 @@
+package db
+
+import (
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/textileio/go-textile/pb"
+	"github.com/textileio/go-textile/util"
+)
+
+// queueStats summarizes the rows of a queue table matching the where clause.
+// Rows are counted by peerCol, if any, and, if statusName is not nil, by status.
+// A table w/ a retry column also reports how many rows are backing off.
+func queueStats(db *sql.DB, table string, where string, peerCol string, statusName func(int32) string, retry bool) *pb.QueueStats {
+	stats := &pb.QueueStats{
+		Statuses: make(map[string]int32),
+		Peers:    make(map[string]int32),
+	}
+	if where == "" {
+		where = "1=1"
+	}
+
+	var oldest int64
+	row := db.QueryRow("SELECT COUNT(*), COALESCE(MIN(date), 0) FROM " + table + " WHERE " + where + ";")
+	if err := row.Scan(&stats.Count, &oldest); err != nil {
+		log.Errorf("error in db scan: %s", err)
+		return stats
+	}
+	if stats.Count == 0 {
+		return stats
+	}
+	stats.Oldest = util.ProtoTs(oldest)
+	stats.OldestAge = int64(time.Since(time.Unix(0, oldest)).Seconds())
+
+	if retry {
+		row = db.QueryRow("SELECT COUNT(*) FROM "+table+" WHERE "+where+" AND retry>?;", time.Now().UnixNano())
+		if err := row.Scan(&stats.Backoff); err != nil {
+			log.Errorf("error in db scan: %s", err)
+		}
+	}
+
+	if peerCol != "" {
+		countBy(db, "SELECT "+peerCol+", COUNT(*) FROM "+table+" WHERE "+where+" GROUP BY "+peerCol+";",
+			func(key string, count int32) {
+				stats.Peers[key] = count
+			})
+	}
+	if statusName != nil {
+		countBy(db, "SELECT status, COUNT(*) FROM "+table+" WHERE "+where+" GROUP BY status;",
+			func(key string, count int32) {
+				status, _ := strconv.Atoi(key)
+				stats.Statuses[statusName(int32(status))] = count
+			})
+	}
+
+	return stats
+}
+
+// countBy calls fn w/ each row of a two column (key, count) query
+func countBy(db *sql.DB, stm string, fn func(key string, count int32)) {
+	rows, err := db.Query(stm)
+	if err != nil {
+		log.Errorf("error in db query: %s", err)
+		return
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var key string
+		var count int32
+		if err := rows.Scan(&key, &count); err != nil {
+			log.Errorf("error in db scan: %s", err)
+			continue
+		}
+		fn(key, count)
+	}
+}
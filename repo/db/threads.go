@@ -25,7 +25,7 @@ func (c *ThreadDB) Add(thread *pb.Thread) error {
 	if err != nil {
 		return err
 	}
-	stm := `insert into threads(id, key, sk, name, schema, initiator, type, state, head, members, sharing) values(?,?,?,?,?,?,?,?,?,?,?)`
+	stm := `insert into threads(id, key, sk, name, schema, initiator, type, state, head, members, sharing, forkThread, forkHead) values(?,?,?,?,?,?,?,?,?,?,?,?,?)`
 	stmt, err := tx.Prepare(stm)
 	if err != nil {
 		log.Errorf("error in tx prepare: %s", err)
@@ -44,6 +44,8 @@ func (c *ThreadDB) Add(thread *pb.Thread) error {
 		thread.Head,
 		strings.Join(thread.Whitelist, ","),
 		int(thread.Sharing),
+		thread.ForkThread,
+		thread.ForkHead,
 	)
 	if err != nil {
 		_ = tx.Rollback()
@@ -108,6 +110,13 @@ func (c *ThreadDB) UpdateSchema(id string, hash string) error {
 	return err
 }
 
+func (c *ThreadDB) UpdateFork(id string, thread string, head string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, err := c.db.Exec("update threads set forkThread=?, forkHead=? where id=?", thread, head, id)
+	return err
+}
+
 func (c *ThreadDB) Delete(id string) error {
 	c.lock.Lock()
 	defer c.lock.Unlock()
@@ -123,26 +132,28 @@ func (c *ThreadDB) handleQuery(stm string) *pb.ThreadList {
 		return list
 	}
 	for rows.Next() {
-		var id, key, name, schema, initiator, head, whitelist string
+		var id, key, name, schema, initiator, head, whitelist, forkThread, forkHead string
 		var skb []byte
 		var typeInt, stateInt, sharingInt int
-		err := rows.Scan(&id, &key, &skb, &name, &schema, &initiator, &typeInt, &stateInt, &head, &whitelist, &sharingInt)
+		err := rows.Scan(&id, &key, &skb, &name, &schema, &initiator, &typeInt, &stateInt, &head, &whitelist, &sharingInt, &forkThread, &forkHead)
 		if err != nil {
 			log.Errorf("error in db scan: %s", err)
 			continue
 		}
 		list.Items = append(list.Items, &pb.Thread{
-			Id:        id,
-			Key:       key,
-			Sk:        skb,
-			Name:      name,
-			Schema:    schema,
-			Initiator: initiator,
-			Type:      pb.Thread_Type(typeInt),
-			Sharing:   pb.Thread_Sharing(sharingInt),
-			Whitelist: util.SplitString(whitelist, ","),
-			State:     pb.Thread_State(stateInt),
-			Head:      head,
+			Id:         id,
+			Key:        key,
+			Sk:         skb,
+			Name:       name,
+			Schema:     schema,
+			Initiator:  initiator,
+			Type:       pb.Thread_Type(typeInt),
+			Sharing:    pb.Thread_Sharing(sharingInt),
+			Whitelist:  util.SplitString(whitelist, ","),
+			State:      pb.Thread_State(stateInt),
+			Head:       head,
+			ForkThread: forkThread,
+			ForkHead:   forkHead,
 		})
 	}
 	return list
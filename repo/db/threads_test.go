@@ -209,6 +209,22 @@ func TestThreadDB_UpdateSchema(t *testing.T) {
 	}
 }
 
+func TestThreadDB_UpdateFork(t *testing.T) {
+	err := threadStore.UpdateFork("Qmabc", "Qmorigin", "Qmhead")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	th := threadStore.Get("Qmabc")
+	if th == nil {
+		t.Error("could not get thread")
+		return
+	}
+	if th.ForkThread != "Qmorigin" || th.ForkHead != "Qmhead" {
+		t.Error("update fork failed")
+	}
+}
+
 func TestThreadDB_Delete(t *testing.T) {
 	setupThreadDB()
 	err := threadStore.Add(&pb.Thread{
@@ -0,0 +1,78 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt018(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null);
+    create index block_threadId on blocks (threadId);
+    `
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into blocks(id, threadId, authorId, type, date, parents, target, body, data, status, attempts) values(?,?,?,?,?,?,?,?,?,?,?)", "id", "thread", "author", 6, 0, "", "", "body", "", 0, 0)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test019(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt018(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor019
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new field
+	row := db.QueryRow("select Count(*) from blocks where clock=0;")
+	var count int
+	_ = row.Scan(&count)
+	if count != 1 {
+		t.Error("wrong number of blocks")
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "20" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
@@ -0,0 +1,61 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor020 struct{}
+
+func (Minor020) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	// add retry backoff columns and the dead letter table
+	query := `
+		alter table blocks add column retry integer not null default 0;
+		alter table cafe_requests add column retry integer not null default 0;
+		alter table cafe_messages add column retry integer not null default 0;
+		create table dead_letters (id text primary key not null, queue integer not null, item blob not null, attempts integer not null, error text not null, date integer not null);
+		create index dead_letter_queue on dead_letters (queue);
+		create index dead_letter_date on dead_letters (date);
+	`
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f21, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f21.Close()
+	if _, err = f21.Write([]byte("21")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor020) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor020) Major() bool {
+	return false
+}
@@ -0,0 +1,96 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt019(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table blocks (id text primary key not null, threadId text not null, authorId text not null, type integer not null, date integer not null, parents text not null, target text not null, body text not null, data text not null, status integer not null, attempts integer not null, clock integer not null);
+    create table cafe_requests (id text primary key not null, peerId text not null, targetId text not null, cafeId text not null, cafe blob not null, groupId text not null, syncGroupId text not null, type integer not null, date integer not null, size integer not null, status integer not null, attempts integer not null, groupSize integer not null, groupTransferred integer not null);
+    create table cafe_messages (id text primary key not null, peerId text not null, date integer not null, attempts integer not null);
+    `
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into blocks(id, threadId, authorId, type, date, parents, target, body, data, status, attempts, clock) values(?,?,?,?,?,?,?,?,?,?,?,?)", "id", "thread", "author", 6, 0, "", "", "body", "", 2, 1, 0)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_messages(id, peerId, date, attempts) values(?,?,?,?)", "id", "peer", 0, 1)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func Test020(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt019(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor020
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new fields
+	row := db.QueryRow("select Count(*) from blocks where retry=0;")
+	var count int
+	_ = row.Scan(&count)
+	if count != 1 {
+		t.Error("wrong number of blocks")
+		return
+	}
+	row = db.QueryRow("select Count(*) from cafe_messages where retry=0;")
+	_ = row.Scan(&count)
+	if count != 1 {
+		t.Error("wrong number of cafe messages")
+		return
+	}
+
+	// test new table
+	_, err = db.Exec("insert into dead_letters(id, queue, item, attempts, error, date) values(?,?,?,?,?,?)", "id", 0, []byte("item"), 5, "error", 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "21" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
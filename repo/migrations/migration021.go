@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor021 struct{}
+
+func (Minor021) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	// add the peer reachability table
+	query := `
+		create table peer_reachability (peerId text not null, path integer not null, successes integer not null, failures integer not null, lastSuccess integer not null, lastFailure integer not null, primary key (peerId, path));
+	`
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f22, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f22.Close()
+	if _, err = f22.Write([]byte("22")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor021) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor021) Major() bool {
+	return false
+}
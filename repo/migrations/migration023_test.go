@@ -0,0 +1,92 @@
+package migrations
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+)
+
+func initAt022(db *sql.DB, pin string) error {
+	var sqlStmt string
+	if pin != "" {
+		sqlStmt = "PRAGMA key = '" + pin + "';"
+	}
+	sqlStmt += `
+    create table cafe_clients (id text primary key not null, address text not null, created integer not null, lastSeen integer not null, tokenId text not null);
+    create table cafe_tokens (id text primary key not null, token text not null, date integer not null);
+    `
+	_, err := db.Exec(sqlStmt)
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_clients(id, address, created, lastSeen, tokenId) values(?,?,?,?,?)", "id", "address", 0, 0, "token")
+	if err != nil {
+		return err
+	}
+	_, err = db.Exec("insert into cafe_tokens(id, token, date) values(?,?,?)", "id", "token", 0)
+	return err
+}
+
+func Test023(t *testing.T) {
+	var dbPath string
+	_ = os.Mkdir("./datastore", os.ModePerm)
+	dbPath = path.Join("./", "datastore", "mainnet.db")
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if err := initAt022(db, ""); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// go up
+	var m Minor023
+	if err := m.Up("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+
+	// test new fields
+	row := db.QueryRow("select Count(*) from cafe_clients where revoked=0;")
+	var count int
+	_ = row.Scan(&count)
+	if count != 1 {
+		t.Error("wrong number of cafe clients")
+		return
+	}
+	row = db.QueryRow("select Count(*) from cafe_tokens where disabled=0;")
+	_ = row.Scan(&count)
+	if count != 1 {
+		t.Error("wrong number of cafe tokens")
+		return
+	}
+
+	// test new table
+	_, err = db.Exec("insert into cafe_client_objects(id, clientId, size, date) values(?,?,?,?)", "cid", "client", 1024, 0)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// ensure that version file was updated
+	version, err := ioutil.ReadFile("./repover")
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	if string(version) != "24" {
+		t.Error("failed to write new repo version")
+		return
+	}
+
+	if err := m.Down("./", "", false); err != nil {
+		t.Error(err)
+		return
+	}
+	_ = os.RemoveAll("./datastore")
+	_ = os.RemoveAll("./repover")
+}
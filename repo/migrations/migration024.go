@@ -0,0 +1,57 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor024 struct{}
+
+func (Minor024) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	// add client webhooks
+	query := `
+		alter table cafe_clients add column webhookUrl text not null default '';
+		alter table cafe_clients add column webhookSecret text not null default '';
+	`
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f25, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f25.Close()
+	if _, err = f25.Write([]byte("25")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor024) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor024) Major() bool {
+	return false
+}
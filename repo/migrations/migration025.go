@@ -0,0 +1,56 @@
+package migrations
+
+import (
+	"database/sql"
+	"os"
+	"path"
+
+	_ "github.com/mutecomm/go-sqlcipher"
+)
+
+type Minor025 struct{}
+
+func (Minor025) Up(repoPath string, pinCode string, testnet bool) error {
+	var dbPath string
+	if testnet {
+		dbPath = path.Join(repoPath, "datastore", "testnet.db")
+	} else {
+		dbPath = path.Join(repoPath, "datastore", "mainnet.db")
+	}
+	db, err := sql.Open("sqlite3", dbPath)
+	if err != nil {
+		return err
+	}
+	if pinCode != "" {
+		if _, err := db.Exec("pragma key='" + pinCode + "';"); err != nil {
+			return err
+		}
+	}
+
+	// add the api key table
+	query := `
+		create table api_keys (id text primary key not null, value blob not null, name text not null, scopes text not null, threads text not null, created integer not null, expires integer not null, revoked integer not null default 0);
+	`
+	if _, err := db.Exec(query); err != nil {
+		return err
+	}
+
+	// update version
+	f26, err := os.Create(path.Join(repoPath, "repover"))
+	if err != nil {
+		return err
+	}
+	defer f26.Close()
+	if _, err = f26.Write([]byte("26")); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (Minor025) Down(repoPath string, pinCode string, testnet bool) error {
+	return nil
+}
+
+func (Minor025) Major() bool {
+	return false
+}
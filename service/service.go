@@ -54,6 +54,16 @@ const (
 	PeerOffline PeerStatus = "offline"
 )
 
+// RemoteError is an error response returned by a peer, as opposed to a failure reaching it
+type RemoteError struct {
+	Code    uint32
+	Message string
+}
+
+func (e *RemoteError) Error() string {
+	return e.Message
+}
+
 // Handler is used to handle messages for a specific protocol
 type Handler interface {
 	Protocol() protocol.ID
@@ -319,7 +329,7 @@ func (srv *Service) handleError(env *pb.Envelope) error {
 		if err != nil {
 			return err
 		}
-		return fmt.Errorf(errMsg.Message)
+		return &RemoteError{Code: errMsg.Code, Message: errMsg.Message}
 	}
 }
 